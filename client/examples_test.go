@@ -174,6 +174,40 @@ func ExampleTxn_Query_variables() {
 	// Output: {"me":[{"name":"Alice"}]}
 }
 
+func ExampleDgraph_NewReadOnlyTxn() {
+	conn, err := grpc.Dial("127.0.0.1:9080", grpc.WithInsecure())
+	if err != nil {
+		log.Fatal("While trying to dial gRPC")
+	}
+	defer conn.Close()
+
+	dc := api.NewDgraphClient(conn)
+	dg := client.NewDgraphClient(dc)
+
+	ctx := context.Background()
+	mu := &api.Mutation{
+		CommitNow: true,
+		SetNquads: []byte(`_:sydney <city> "Sydney" .`),
+	}
+	assigned, err := dg.NewTxn().Mutate(ctx, mu)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	txn := dg.NewReadOnlyTxn()
+	q := fmt.Sprintf(`{ me(func: uid(%s)) { city } }`, assigned.Uids["sydney"])
+	resp, err := txn.Query(ctx, q)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(resp.Json))
+
+	_, err = txn.Mutate(ctx, mu)
+	fmt.Println(err)
+	// Output: {"me":[{"city":"Sydney"}]}
+	// Mutations aren't allowed in read-only transactions
+}
+
 func ExampleTxn_Mutate() {
 	type School struct {
 		Name string `json:"name,omitempty"`
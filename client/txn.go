@@ -29,6 +29,7 @@ import (
 
 var (
 	ErrFinished = errors.New("Transaction has already been committed or discarded")
+	ErrReadOnly = errors.New("Mutations aren't allowed in read-only transactions")
 )
 
 // Txn is a single atomic transaction.
@@ -46,8 +47,10 @@ var (
 type Txn struct {
 	context *api.TxnContext
 
-	finished bool
-	mutated  bool
+	finished   bool
+	mutated    bool
+	readOnly   bool
+	bestEffort bool
 
 	dg *Dgraph
 }
@@ -63,6 +66,24 @@ func (d *Dgraph) NewTxn() *Txn {
 	return txn
 }
 
+// NewReadOnlyTxn creates a new transaction which can only be queried. Instead of
+// leasing a timestamp from Zero for every transaction, the server shares a recently
+// leased one across read-only transactions.
+func (d *Dgraph) NewReadOnlyTxn() *Txn {
+	txn := d.NewTxn()
+	txn.readOnly = true
+	return txn
+}
+
+// BestEffort makes a read-only transaction read at the latest commit the server has heard
+// of, without asking Zero for a timestamp or waiting for the server to catch up with the
+// rest of the cluster. This is quicker, but the reads might not see the latest commits.
+func (txn *Txn) BestEffort() *Txn {
+	txn.readOnly = true
+	txn.bestEffort = true
+	return txn
+}
+
 // Query sends a query to one of the connected dgraph instances. If no
 // mutations need to be made in the same transaction, it's convenient to chain
 // the method, e.g. NewTxn().Query(ctx, "...").
@@ -78,10 +99,12 @@ func (txn *Txn) QueryWithVars(ctx context.Context, q string,
 		return nil, ErrFinished
 	}
 	req := &api.Request{
-		Query:   q,
-		Vars:    vars,
-		StartTs: txn.context.StartTs,
-		LinRead: txn.context.LinRead,
+		Query:      q,
+		Vars:       vars,
+		StartTs:    txn.context.StartTs,
+		LinRead:    txn.context.LinRead,
+		ReadOnly:   txn.readOnly && !txn.bestEffort,
+		BestEffort: txn.bestEffort,
 	}
 	dc := txn.dg.anyClient()
 	resp, err := dc.Query(ctx, req)
@@ -108,6 +131,8 @@ func (txn *Txn) mergeContext(src *api.TxnContext) error {
 		return errors.New("StartTs mismatch")
 	}
 	txn.context.Keys = append(txn.context.Keys, src.Keys...)
+	txn.context.ReadOnly = txn.context.ReadOnly || src.ReadOnly
+	txn.context.BestEffort = txn.context.BestEffort || src.BestEffort
 	return nil
 }
 
@@ -125,6 +150,9 @@ func (txn *Txn) Mutate(ctx context.Context, mu *api.Mutation) (*api.Assigned, er
 	if txn.finished {
 		return nil, ErrFinished
 	}
+	if txn.readOnly || txn.context.ReadOnly || txn.context.BestEffort {
+		return nil, ErrReadOnly
+	}
 
 	txn.mutated = true
 	mu.StartTs = txn.context.StartTs
@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package conn
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Secures the connections between the nodes of the cluster, when set.
+var clusterTLS *x.ClusterTLS
+
+// SetClusterTLS makes the pools dial peers with mutual TLS, and ServerOptions require it. It
+// must be called before any connection is made. The certificates are reloaded on SIGHUP.
+func SetClusterTLS(c *x.ClusterTLS) {
+	clusterTLS = c
+	if c == nil {
+		return
+	}
+	go func() {
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGHUP)
+		for range sigChan {
+			if err := c.Reload(); err != nil {
+				x.Printf("Error while reloading cluster TLS: %v. Using the current one.\n", err)
+				continue
+			}
+			x.Printf("Cluster TLS certificates and CAs reloaded\n")
+		}
+	}()
+}
+
+// ServerOptions returns the options for the gRPC servers taking connections from other nodes.
+func ServerOptions() []grpc.ServerOption {
+	if clusterTLS == nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(clusterTLS.ServerConfig()))}
+}
+
+// TransportOption returns the dial option securing a connection to another node with the
+// cluster TLS, if set.
+func TransportOption() grpc.DialOption {
+	if clusterTLS == nil {
+		return grpc.WithInsecure()
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(clusterTLS.ClientConfig()))
+}
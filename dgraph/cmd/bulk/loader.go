@@ -33,6 +33,7 @@ import (
 
 	"github.com/dgraph-io/badger"
 	bo "github.com/dgraph-io/badger/options"
+	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
@@ -83,7 +84,7 @@ type loader struct {
 }
 
 func newLoader(opt options) *loader {
-	zero, err := grpc.Dial(opt.ZeroAddr, grpc.WithInsecure())
+	zero, err := grpc.Dial(opt.ZeroAddr, conn.TransportOption())
 	x.Check(err)
 	st := &state{
 		opt:    opt,
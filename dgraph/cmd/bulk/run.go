@@ -29,6 +29,7 @@ import (
 	"runtime"
 	"strconv"
 
+	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/spf13/cobra"
 )
@@ -85,6 +86,8 @@ func init() {
 		"Number of reduce shards. This determines the number of dgraph instances in the final "+
 			"cluster. Increasing this potentially decreases the reduce stage runtime by using "+
 			"more parallelism, but increases memory usage.")
+	// Zero only takes connections with the cluster certificates when run with them.
+	x.RegisterClusterTLSFlags(flag)
 }
 
 func run() {
@@ -110,6 +113,9 @@ func run() {
 	if opt.Version {
 		x.PrintVersionOnly()
 	}
+	clusterTLS, err := x.LoadClusterTLS(Bulk.Conf)
+	x.Checkf(err, "Invalid cluster TLS options")
+	conn.SetClusterTLS(clusterTLS)
 	if opt.RDFDir == "" || opt.SchemaFile == "" {
 		flag.Usage()
 		fmt.Fprint(os.Stderr, "RDF and schema file(s) must be specified.\n")
@@ -0,0 +1,234 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package encrypt
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dgraph-io/badger"
+	"github.com/spf13/cobra"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var Encrypt x.SubCommand
+
+func init() {
+	Encrypt.Cmd = &cobra.Command{
+		Use:   "encrypt",
+		Short: "Encrypt, re-encrypt or decrypt a postings or WAL directory",
+		Long: `
+Copies a postings (p) or WAL (w) directory of a stopped server or zero into a
+new directory, encrypted with the last key of the key file. Encrypted input is
+decrypted with whichever key of the file it was written with, so running this
+after adding a key rotates the data to it. The older keys can be removed from
+the file once every directory was copied.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(Encrypt.Conf).Stop()
+			run()
+		},
+	}
+	Encrypt.EnvPrefix = "DGRAPH_ENCRYPT"
+
+	flag := Encrypt.Cmd.Flags()
+	flag.StringP("in", "i", "", "Directory to read.")
+	flag.StringP("out", "o", "", "New directory to write. It must not hold any data.")
+	flag.StringP("encryption_key_file", "k", "",
+		"File holding hex encoded AES-256 keys, one per line. The last one encrypts the output.")
+	flag.Bool("decrypt", false, "Write the output without encryption.")
+}
+
+func run() {
+	in := Encrypt.Conf.GetString("in")
+	out := Encrypt.Conf.GetString("out")
+	decrypt := Encrypt.Conf.GetBool("decrypt")
+	x.AssertTruefNoTrace(in != "" && out != "", "Both --in and --out must be set")
+
+	var keys *x.KeyRing
+	if keyFile := Encrypt.Conf.GetString("encryption_key_file"); keyFile != "" {
+		var err error
+		keys, err = x.LoadKeyRing(keyFile)
+		x.Checkf(err, "Error while loading encryption keys")
+	}
+	encrypted := x.IsEncryptedDir(in)
+	if encrypted {
+		x.Check(x.CheckEncryptedDir(in, keys))
+	}
+	x.AssertTruefNoTrace(decrypt || keys != nil,
+		"An --encryption_key_file is needed to encrypt the output")
+	if _, err := os.Stat(filepath.Join(out, "MANIFEST")); err == nil {
+		x.Fatalf("Directory %s already holds data", out)
+	}
+	x.Check(os.MkdirAll(out, 0700))
+
+	src := openStore(in)
+	defer src.Close()
+	dst := openStore(out)
+	defer dst.Close()
+
+	read := func(val []byte) ([]byte, error) {
+		if encrypted {
+			return keys.Decrypt(val)
+		}
+		return append([]byte{}, val...), nil
+	}
+	write := func(val []byte) []byte {
+		if decrypt {
+			return val
+		}
+		return keys.Encrypt(val)
+	}
+	count, err := copyStore(src, dst, read, write)
+	x.Checkf(err, "Error while copying %s", in)
+	if !decrypt {
+		x.Check(x.WriteEncryptedMarker(out, keys))
+	}
+	fmt.Printf("Copied %d values from %s to %s\n", count, in, out)
+}
+
+func openStore(dir string) *badger.ManagedDB {
+	opt := badger.DefaultOptions
+	opt.SyncWrites = false
+	opt.Dir = dir
+	opt.ValueDir = dir
+	db, err := badger.OpenManaged(opt)
+	x.Checkf(err, "Error while opening %s", dir)
+	return db
+}
+
+// copyStore writes every version of the keys of src into dst, along with the deletions between
+// them.
+func copyStore(src, dst *badger.ManagedDB, read func([]byte) ([]byte, error),
+	write func([]byte) []byte) (int, error) {
+	txn := src.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := txn.NewIterator(iterOpts)
+	defer it.Close()
+
+	var hasError uint32
+	var wg sync.WaitGroup
+	pending := make(chan struct{}, 1000)
+	var count int
+	commit := func(wtxn *badger.Txn, version uint64) error {
+		pending <- struct{}{}
+		wg.Add(1)
+		err := wtxn.CommitAt(version, func(err error) {
+			if err != nil {
+				atomic.StoreUint32(&hasError, 1)
+			}
+			<-pending
+			wg.Done()
+		})
+		wtxn.Discard()
+		if count++; count%100000 == 0 {
+			fmt.Printf("Copied %d values\n", count)
+		}
+		return err
+	}
+
+	var lastKey []byte
+	// Deletions newer than the version being copied, and older than this, weren't copied yet.
+	var above uint64
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		if !bytes.Equal(item.Key(), lastKey) {
+			lastKey = append(lastKey[:0], item.Key()...)
+			above = math.MaxUint64
+		}
+		// The iterator skips deletions, so they are looked for between the versions it shows.
+		del, err := deletedAt(src, lastKey, item.Version(), above)
+		if err != nil {
+			return count, err
+		}
+		above = item.Version() - 1
+		if del > 0 {
+			wtxn := dst.NewTransactionAt(math.MaxUint64, true)
+			if err := wtxn.Delete(append([]byte{}, lastKey...)); err != nil {
+				return count, err
+			}
+			if err := commit(wtxn, del); err != nil {
+				return count, err
+			}
+		}
+
+		val, err := item.Value()
+		if err != nil {
+			return count, err
+		}
+		if val, err = read(val); err != nil {
+			return count, x.Wrapf(err, "While reading key %x", item.Key())
+		}
+		wtxn := dst.NewTransactionAt(math.MaxUint64, true)
+		if err := wtxn.SetWithMeta(append([]byte{}, lastKey...), write(val),
+			item.UserMeta()); err != nil {
+			return count, err
+		}
+		if err := commit(wtxn, item.Version()); err != nil {
+			return count, err
+		}
+	}
+	wg.Wait()
+	if hasError > 0 {
+		return count, x.Errorf("Error while writing values")
+	}
+	return count, nil
+}
+
+// deletedAt returns the version at which key was deleted after the given version, but no later
+// than above, or zero if it wasn't. No other version of key is in between.
+func deletedAt(db *badger.ManagedDB, key []byte, version, above uint64) (uint64, error) {
+	if above <= version {
+		return 0, nil
+	}
+	visible := func(readTs uint64) (bool, error) {
+		txn := db.NewTransactionAt(readTs, false)
+		defer txn.Discard()
+		_, err := txn.Get(key)
+		if err == badger.ErrKeyNotFound {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	if ok, err := visible(above); ok || err != nil {
+		return 0, err
+	}
+	// The key is visible up to the deletion, and not after it.
+	lo, hi := version+1, above
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		ok, err := visible(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
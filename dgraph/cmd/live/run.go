@@ -59,6 +59,8 @@ type options struct {
 	numRdf              int
 	clientDir           string
 	ignoreIndexConflict bool
+	// Decrypts the files ending in .enc.
+	keys *x.KeyRing
 }
 
 var opt options
@@ -89,6 +91,8 @@ func init() {
 	flag.StringP("xidmap", "x", "x", "Directory to store xid to uid mapping")
 	flag.BoolP("ignore_index_conflict", "i", true,
 		"Ignores conflicts on index keys during transaction")
+	flag.String("encryption_key_file", "",
+		"Key file of the server which wrote encrypted exports, for the files ending in .enc.")
 
 	// TLS configuration
 	x.RegisterTLSFlags(flag)
@@ -124,12 +128,11 @@ func processSchemaFile(ctx context.Context, file string, dgraphClient *client.Dg
 	x.Check(err)
 	defer f.Close()
 
-	var reader io.Reader
+	var reader io.Reader = f
+	file, reader = decryptedReader(file, reader)
 	if strings.HasSuffix(strings.ToLower(file), ".gz") {
-		reader, err = gzip.NewReader(f)
+		reader, err = gzip.NewReader(reader)
 		x.Check(err)
-	} else {
-		reader = f
 	}
 
 	b, err := ioutil.ReadAll(reader)
@@ -158,16 +161,30 @@ func (l *loader) uid(val string) string {
 	return fmt.Sprintf("%#x", uint64(uid))
 }
 
+// decryptedReader decrypts files ending in .enc, and returns their name without the suffix.
+func decryptedReader(file string, r io.Reader) (string, io.Reader) {
+	if !strings.HasSuffix(file, x.EncryptedFileSuffix) {
+		return file, r
+	}
+	if opt.keys == nil {
+		x.Fatalf("File %s is encrypted. Set --encryption_key_file to load it", file)
+	}
+	dr, err := opt.keys.NewReader(r)
+	x.Checkf(err, "While decrypting %s", file)
+	return strings.TrimSuffix(file, x.EncryptedFileSuffix), dr
+}
+
 func fileReader(file string) (io.Reader, *os.File) {
 	f, err := os.Open(file)
 	x.Check(err)
 
-	var r io.Reader
+	var r io.Reader = f
+	file, r = decryptedReader(file, r)
 	if filepath.Ext(file) == ".gz" {
-		r, err = gzip.NewReader(f)
+		r, err = gzip.NewReader(r)
 		x.Check(err)
 	} else {
-		r = bufio.NewReader(f)
+		r = bufio.NewReader(r)
 	}
 	return r, f
 }
@@ -319,6 +336,11 @@ func run() {
 		clientDir:           Live.Conf.GetString("xidmap"),
 		ignoreIndexConflict: Live.Conf.GetBool("ignore_index_conflict"),
 	}
+	if keyFile := Live.Conf.GetString("encryption_key_file"); keyFile != "" {
+		var err error
+		opt.keys, err = x.LoadKeyRing(keyFile)
+		x.Checkf(err, "Error while loading encryption keys")
+	}
 	x.LoadTLSConfig(&tlsConf, Live.Conf)
 	tlsConf.Insecure = Live.Conf.GetBool("tls_insecure")
 	tlsConf.RootCACerts = Live.Conf.GetString("tls_ca_certs")
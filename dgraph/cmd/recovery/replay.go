@@ -0,0 +1,327 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package recovery
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/client"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// A drop_all is proposed to every group. The ones archived this close together are taken to
+// be the same, so skipping the entry of one group skips it in all of them.
+const dropAllWindow = time.Second
+
+type options struct {
+	// The read timestamp of the restored backup. Whatever was committed up to it is in there.
+	sinceTs uint64
+	// When the backup was started, to tell whether the operations without a timestamp came
+	// after it. Older backups don't have it.
+	sinceTime  time.Time
+	untilTs    uint64
+	untilTime  time.Time
+	untilIndex map[uint32]uint64
+	skip       map[entryId]bool
+}
+
+// cutOff returns whether the entry comes after the index or time to replay up to.
+func (opt *options) cutOff(gid uint32, e *raftwal.ArchivedEntry) bool {
+	if until, ok := opt.untilIndex[gid]; ok && e.Index > until {
+		return true
+	}
+	return !opt.untilTime.IsZero() && e.Time.After(opt.untilTime)
+}
+
+// event is a transaction or an operation to replay. Transactions are ordered by their commit
+// timestamp and schema changes by their start timestamp. Operations without a timestamp, like
+// drop_all, take the last commit timestamp their group saw before them, and go after the
+// transaction committed at it.
+type event struct {
+	ts uint64
+	// Set when ts is the last commit timestamp seen before the operation.
+	afterTs bool
+	op      *api.Operation
+	mu      *api.Mutation
+	id      entryId
+	time    time.Time
+	uids    []uint64
+	// Set on the skipped drop_all entries, to skip the ones of the other groups.
+	skipped bool
+}
+
+func (ev *event) String() string {
+	var what string
+	switch {
+	case ev.mu != nil:
+		what = fmt.Sprintf("txn: %d set: %d del: %d", ev.ts, len(ev.mu.Set), len(ev.mu.Del))
+	case ev.op.DropAll:
+		what = "drop_all"
+	case len(ev.op.DropAttr) > 0:
+		what = "drop_attr: " + ev.op.DropAttr
+	case len(ev.op.RenameAttr) > 0:
+		what = fmt.Sprintf("rename: %s to %s", ev.op.RenameAttr, ev.op.RenameTo)
+	default:
+		what = "schema: " + strings.TrimSpace(ev.op.Schema)
+	}
+	return fmt.Sprintf("[%s %s] %s", ev.id, ev.time.Format(time.RFC3339), what)
+}
+
+func (ev *event) replay(ctx context.Context, dc *client.Dgraph) error {
+	if ev.op != nil {
+		return dc.Alter(ctx, ev.op)
+	}
+	_, err := dc.NewTxn().Mutate(ctx, ev.mu)
+	return err
+}
+
+type txnEdges struct {
+	edges []*intern.DirectedEdge
+	first entryId
+	time  time.Time
+}
+
+// collect goes over the archived entries of every group, and returns the transactions and
+// operations to replay in order. Transactions committed up to opt.sinceTs are left out, along
+// with those whose commit, in the entries of zero, is cut off or skipped.
+func collect(entries map[uint32][]*raftwal.ArchivedEntry, opt options) ([]*event, error) {
+	commits := make(map[uint64]uint64)
+	txns := make(map[uint64]*txnEdges)
+	var ops []*event
+	gids := make([]uint32, 0, len(entries))
+	for gid := range entries {
+		gids = append(gids, gid)
+	}
+	// Keeps the edges of transactions spanning groups in the same order.
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	for _, gid := range gids {
+		es := entries[gid]
+		// The last commit timestamp seen by the group.
+		var lastTs uint64
+		for _, e := range es {
+			if opt.cutOff(gid, e) {
+				continue
+			}
+			id := entryId{gid: gid, index: e.Index}
+			skipped := opt.skip[id]
+			if skipped && gid == 0 {
+				continue
+			}
+			if gid == 0 {
+				var p intern.ZeroProposal
+				if err := p.Unmarshal(e.Data); err != nil {
+					return nil, x.Wrapf(err, "While reading entry %s", id)
+				}
+				if p.Txn != nil && !p.Txn.Aborted && p.Txn.CommitTs > 0 {
+					commits[p.Txn.StartTs] = p.Txn.CommitTs
+				}
+				continue
+			}
+
+			var p intern.Proposal
+			if err := p.Unmarshal(e.Data); err != nil {
+				return nil, x.Wrapf(err, "While reading entry %s", id)
+			}
+			newOp := func(op *api.Operation) {
+				ops = append(ops, &event{ts: lastTs, afterTs: true, op: op, id: id, time: e.Time,
+					skipped: skipped})
+			}
+			if skipped {
+				if p.Mutations != nil && p.Mutations.DropAll {
+					newOp(&api.Operation{DropAll: true})
+				}
+				continue
+			}
+			switch {
+			case p.TxnContext != nil:
+				if ts := p.TxnContext.CommitTs; ts > lastTs {
+					lastTs = ts
+				}
+			case p.Rename != nil:
+				newOp(&api.Operation{RenameAttr: p.Rename.Predicate,
+					RenameTo: p.Rename.NewName})
+			case p.Mutations == nil:
+				// Predicate moves and membership updates don't change the data.
+			case p.Mutations.DropAll:
+				newOp(&api.Operation{DropAll: true})
+			case len(p.Mutations.Schema) > 0:
+				var buf bytes.Buffer
+				for _, su := range p.Mutations.Schema {
+					buf.WriteString(worker.SchemaString(su))
+				}
+				ops = append(ops, &event{ts: p.Mutations.StartTs, id: id, time: e.Time,
+					op: &api.Operation{Schema: buf.String()}})
+			case p.Mutations.StartTs == 0:
+				// Deleting a predicate is the one mutation done outside a transaction.
+				for _, edge := range p.Mutations.Edges {
+					if edge.Entity == 0 && bytes.Equal(edge.Value, []byte(x.Star)) {
+						newOp(&api.Operation{DropAttr: edge.Attr})
+					}
+				}
+			default:
+				t := txns[p.Mutations.StartTs]
+				if t == nil {
+					t = &txnEdges{first: id, time: e.Time}
+					txns[p.Mutations.StartTs] = t
+				}
+				t.edges = append(t.edges, p.Mutations.Edges...)
+			}
+		}
+	}
+
+	events := dedupDropAll(ops)
+	for startTs, t := range txns {
+		commitTs, ok := commits[startTs]
+		if !ok {
+			continue
+		}
+		ev := &event{ts: commitTs, id: t.first, time: t.time, mu: &api.Mutation{CommitNow: true}}
+		for _, edge := range t.edges {
+			nq, ok := toNQuad(edge)
+			if !ok {
+				continue
+			}
+			if edge.Op == intern.DirectedEdge_DEL {
+				ev.mu.Del = append(ev.mu.Del, nq)
+			} else {
+				ev.mu.Set = append(ev.mu.Set, nq)
+			}
+			ev.uids = append(ev.uids, edge.Entity, edge.ValueId)
+		}
+		if len(ev.mu.Set) > 0 || len(ev.mu.Del) > 0 {
+			events = append(events, ev)
+		}
+	}
+
+	out := events[:0]
+	for _, ev := range events {
+		if opt.untilTs > 0 && (ev.ts > opt.untilTs || (ev.afterTs && ev.ts == opt.untilTs)) {
+			continue
+		}
+		if ev.afterTs && !opt.sinceTime.IsZero() {
+			if !ev.time.After(opt.sinceTime) {
+				continue
+			}
+		} else if ev.ts <= opt.sinceTs {
+			continue
+		}
+		out = append(out, ev)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].ts != out[j].ts {
+			return out[i].ts < out[j].ts
+		}
+		// An operation taking the commit timestamp of a transaction comes after it.
+		if (out[i].op == nil) != (out[j].op == nil) {
+			return out[i].op == nil
+		}
+		if out[i].id.gid != out[j].id.gid {
+			return out[i].id.gid < out[j].id.gid
+		}
+		return out[i].id.index < out[j].id.index
+	})
+	return out, nil
+}
+
+// dedupDropAll keeps one of the drop_all proposals of the groups, with the highest timestamp
+// among them. None are kept if one of them was skipped.
+func dedupDropAll(ops []*event) []*event {
+	var out, drops []*event
+	for _, ev := range ops {
+		if ev.op.DropAll {
+			drops = append(drops, ev)
+		} else {
+			out = append(out, ev)
+		}
+	}
+	sort.Slice(drops, func(i, j int) bool { return drops[i].time.Before(drops[j].time) })
+	for i := 0; i < len(drops); {
+		cur := drops[i]
+		skipped := cur.skipped
+		for i++; i < len(drops) && drops[i].time.Sub(cur.time) <= dropAllWindow; i++ {
+			if drops[i].ts > cur.ts {
+				cur.ts = drops[i].ts
+			}
+			skipped = skipped || drops[i].skipped
+		}
+		if !skipped {
+			out = append(out, cur)
+		}
+	}
+	return out
+}
+
+// toNQuad returns the edge as set in a mutation. The edges dgraph adds on its own, and those
+// which expired already, are left out.
+func toNQuad(edge *intern.DirectedEdge) (*api.NQuad, bool) {
+	if edge.Attr == "_predicate_" {
+		return nil, false
+	}
+	nq := &api.NQuad{
+		Subject:   fmt.Sprintf("%#x", edge.Entity),
+		Predicate: edge.Attr,
+		Label:     edge.Label,
+		Lang:      edge.Lang,
+		Facets:    edge.Facets,
+		Inc:       edge.Op == intern.DirectedEdge_INC,
+	}
+	if edge.ExpiresAt > 0 {
+		now := uint64(time.Now().Unix())
+		if edge.ExpiresAt <= now {
+			return nil, false
+		}
+		nq.Ttl = edge.ExpiresAt - now
+	}
+	if edge.ValueId != 0 {
+		nq.ObjectId = fmt.Sprintf("%#x", edge.ValueId)
+		return nq, true
+	}
+	tid := types.TypeID(edge.ValueType)
+	src := types.Val{Tid: types.BinaryID, Value: edge.Value}
+	if dst, err := types.Convert(src, tid); err == nil {
+		if v, err := types.ObjectValue(tid, dst.Value); err == nil {
+			nq.ObjectValue = v
+			return nq, true
+		}
+	}
+	nq.ObjectValue = &api.Value{Val: &api.Value_BytesVal{BytesVal: edge.Value}}
+	return nq, true
+}
+
+func maxUid(events []*event) uint64 {
+	var max uint64
+	for _, ev := range events {
+		for _, uid := range ev.uids {
+			if uid > max {
+				max = uid
+			}
+		}
+	}
+	return max
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/raftwal"
+)
+
+var start = time.Date(2017, 12, 1, 14, 0, 0, 0, time.UTC)
+
+func archived(t *testing.T, index uint64, min int, p proto.Marshaler) *raftwal.ArchivedEntry {
+	data, err := p.Marshal()
+	require.NoError(t, err)
+	return &raftwal.ArchivedEntry{
+		Entry: raftpb.Entry{Index: index, Data: data},
+		Time:  start.Add(time.Duration(min) * time.Minute),
+	}
+}
+
+func mutation(startTs uint64, attr string, uid, vid uint64) *intern.Proposal {
+	edge := &intern.DirectedEdge{Entity: uid, Attr: attr, ValueId: vid}
+	return &intern.Proposal{Mutations: &intern.Mutations{StartTs: startTs,
+		Edges: []*intern.DirectedEdge{edge}}}
+}
+
+func commit(startTs, commitTs uint64) *intern.ZeroProposal {
+	return &intern.ZeroProposal{Txn: &api.TxnContext{StartTs: startTs, CommitTs: commitTs}}
+}
+
+func groupCommit(startTs, commitTs uint64) *intern.Proposal {
+	return &intern.Proposal{TxnContext: &api.TxnContext{StartTs: startTs, CommitTs: commitTs}}
+}
+
+func dropAll() *intern.Proposal {
+	return &intern.Proposal{Mutations: &intern.Mutations{DropAll: true}}
+}
+
+func testEntries(t *testing.T) map[uint32][]*raftwal.ArchivedEntry {
+	return map[uint32][]*raftwal.ArchivedEntry{
+		0: {
+			archived(t, 1, 0, commit(10, 11)),
+			archived(t, 2, 1, commit(12, 13)),
+			archived(t, 3, 2, &intern.ZeroProposal{Txn: &api.TxnContext{StartTs: 14,
+				Aborted: true}}),
+			archived(t, 4, 6, commit(20, 21)),
+		},
+		1: {
+			archived(t, 1, 0, mutation(10, "name", 1, 2)),
+			archived(t, 2, 0, groupCommit(10, 11)),
+			archived(t, 3, 1, mutation(12, "friend", 1, 3)),
+			archived(t, 4, 1, groupCommit(12, 13)),
+			archived(t, 5, 2, mutation(14, "friend", 1, 4)),
+			archived(t, 6, 5, dropAll()),
+			archived(t, 7, 6, mutation(20, "friend", 5, 6)),
+		},
+		2: {
+			archived(t, 1, 1, mutation(12, "age", 1, 7)),
+			archived(t, 2, 1, groupCommit(12, 13)),
+			archived(t, 3, 5, dropAll()),
+		},
+	}
+}
+
+func describe(events []*event) []string {
+	var out []string
+	for _, ev := range events {
+		switch {
+		case ev.op != nil && ev.op.DropAll:
+			out = append(out, "drop_all")
+		case ev.mu != nil:
+			var preds []string
+			for _, nq := range ev.mu.Set {
+				preds = append(preds, nq.Predicate)
+			}
+			out = append(out, ev.id.String()+" "+ev.mu.Set[0].Subject+" "+
+				ev.mu.Set[0].ObjectId+" "+preds[len(preds)-1])
+		}
+	}
+	return out
+}
+
+func TestCollect(t *testing.T) {
+	events, err := collect(testEntries(t), options{sinceTs: 11})
+	require.NoError(t, err)
+	// The transaction committed at 11 is in the backup, and the one at 14 aborted.
+	require.Equal(t, []string{"1:3 0x1 0x3 age", "drop_all", "1:7 0x5 0x6 friend"},
+		describe(events))
+	require.Len(t, events[0].mu.Set, 2)
+	require.Equal(t, uint64(7), maxUid(events))
+
+	// Skipping the drop_all of one group skips it in the others too.
+	events, err = collect(testEntries(t), options{sinceTs: 11,
+		skip: map[entryId]bool{{gid: 2, index: 3}: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1:3 0x1 0x3 age", "1:7 0x5 0x6 friend"}, describe(events))
+
+	// Stop before the drop_all.
+	events, err = collect(testEntries(t), options{sinceTs: 11,
+		untilTime: start.Add(4 * time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1:3 0x1 0x3 age"}, describe(events))
+
+	events, err = collect(testEntries(t), options{untilTs: 13,
+		untilIndex: map[uint32]uint64{0: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1:1 0x1 0x2 name"}, describe(events))
+
+	// The drop_all came after the backup was started, even though no commit did.
+	events, err = collect(testEntries(t), options{sinceTs: 13,
+		sinceTime: start.Add(3 * time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"drop_all", "1:7 0x5 0x6 friend"}, describe(events))
+}
+
+func TestParseEntryIds(t *testing.T) {
+	ids, err := parseEntryIds("1:20, 0:5")
+	require.NoError(t, err)
+	require.Equal(t, []entryId{{gid: 1, index: 20}, {gid: 0, index: 5}}, ids)
+
+	_, err = parseEntryIds("1")
+	require.Error(t, err)
+}
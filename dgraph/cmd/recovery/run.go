@@ -0,0 +1,254 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package recovery
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/dgraph-io/dgraph/client"
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var Recover x.SubCommand
+
+func init() {
+	Recover.Cmd = &cobra.Command{
+		Use:   "recover",
+		Short: "Replay archived raft entries on top of a restored backup",
+		Long: `
+Replays the transactions and schema changes archived by servers and zero run with
+--wal_archive, which were committed after the backup was taken, to a cluster
+started on the postings directories written by dgraph restore. The replay can stop
+at a commit timestamp, a point in time or a raft index, and skip chosen entries,
+like a bad drop_all.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(Recover.Conf).Stop()
+			run()
+		},
+	}
+	Recover.EnvPrefix = "DGRAPH_RECOVER"
+
+	flag := Recover.Cmd.Flags()
+	flag.StringP("archive", "a", "",
+		"Comma separated WAL archive folders, of zero and of a server of every group.")
+	flag.StringP("location", "l", "backup", "Folder holding the backups.")
+	flag.StringP("name", "n", "",
+		"Name of the restored backup. Defaults to the latest one.")
+	flag.Uint64("until_ts", 0, "Replay the transactions committed up to this timestamp.")
+	flag.String("until_time", "",
+		"Replay the entries archived up to this time, in RFC3339 format.")
+	flag.String("until_index", "",
+		"Comma separated <group>:<index> pairs, replaying the entries of the group up to the "+
+			"raft index. Zero is group 0.")
+	flag.String("skip", "",
+		"Comma separated <group>:<index> pairs of raft entries to leave out.")
+	flag.StringP("dgraph", "d", "127.0.0.1:9080", "Dgraph gRPC server address")
+	flag.StringP("zero", "z", "localhost:7080",
+		"gRPC address of Zero, which leases the uids used by the replayed transactions.")
+	flag.Bool("dry_run", false, "Print the entries to replay, without sending them.")
+	flag.StringP("encryption_key_file", "k", "",
+		"Key file to decrypt an encrypted archive with.")
+	// Zero and the server only take connections with the cluster certificates when run
+	// with them.
+	x.RegisterClusterTLSFlags(flag)
+}
+
+// entryId identifies a raft entry of a group.
+type entryId struct {
+	gid   uint32
+	index uint64
+}
+
+func (e entryId) String() string {
+	return fmt.Sprintf("%d:%d", e.gid, e.index)
+}
+
+func parseEntryIds(s string) ([]entryId, error) {
+	var ids []entryId
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			return nil, x.Errorf("Invalid entry %q, expected <group>:<index>", part)
+		}
+		gid, err := strconv.ParseUint(kv[0], 10, 32)
+		if err != nil {
+			return nil, x.Errorf("Invalid group in %q", part)
+		}
+		index, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			return nil, x.Errorf("Invalid index in %q", part)
+		}
+		ids = append(ids, entryId{gid: uint32(gid), index: index})
+	}
+	return ids, nil
+}
+
+func run() {
+	var opt options
+	clusterTLS, err := x.LoadClusterTLS(Recover.Conf)
+	x.Checkf(err, "Invalid cluster TLS options")
+	conn.SetClusterTLS(clusterTLS)
+	if keyFile := Recover.Conf.GetString("encryption_key_file"); keyFile != "" {
+		keys, err := x.LoadKeyRing(keyFile)
+		x.Checkf(err, "Error while loading encryption keys")
+		x.SetValueEncryption(keys)
+	}
+	chain, err := worker.BackupChain(Recover.Conf.GetString("location"),
+		Recover.Conf.GetString("name"))
+	x.Check(err)
+	last := chain[len(chain)-1]
+	opt.sinceTs, opt.sinceTime = last.ReadTs, last.Time
+	opt.untilTs = uint64(Recover.Conf.GetInt64("until_ts"))
+	if s := Recover.Conf.GetString("until_time"); s != "" {
+		opt.untilTime, err = time.Parse(time.RFC3339, s)
+		x.Checkf(err, "Invalid --until_time")
+	}
+	untilIndex, err := parseEntryIds(Recover.Conf.GetString("until_index"))
+	x.Checkf(err, "Invalid --until_index")
+	opt.untilIndex = make(map[uint32]uint64)
+	for _, id := range untilIndex {
+		opt.untilIndex[id.gid] = id.index
+	}
+	skip, err := parseEntryIds(Recover.Conf.GetString("skip"))
+	x.Checkf(err, "Invalid --skip")
+	opt.skip = make(map[entryId]bool)
+	for _, id := range skip {
+		opt.skip[id] = true
+	}
+
+	dirs := strings.Split(Recover.Conf.GetString("archive"), ",")
+	x.AssertTruefNoTrace(len(dirs[0]) > 0, "--archive is required")
+	entries, err := readArchives(dirs)
+	x.Checkf(err, "Error while reading the archive")
+	_, hasZero := entries[0]
+	x.AssertTruefNoTrace(hasZero, "The archive of zero, holding the commits, is missing")
+	events, err := collect(entries, opt)
+	x.Check(err)
+	fmt.Printf("Replaying %d entries on top of backup %s, taken at timestamp %d\n",
+		len(events), last.Name, last.ReadTs)
+
+	if Recover.Conf.GetBool("dry_run") {
+		for _, ev := range events {
+			fmt.Println(ev)
+		}
+		return
+	}
+	if maxUid := maxUid(events); maxUid > last.MaxUid {
+		leaseUids(Recover.Conf.GetString("zero"), maxUid)
+	}
+	cc, err := grpc.Dial(Recover.Conf.GetString("dgraph"),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(x.GrpcMaxSize),
+			grpc.MaxCallSendMsgSize(x.GrpcMaxSize)),
+		conn.TransportOption())
+	x.Checkf(err, "Error while connecting to dgraph")
+	defer cc.Close()
+	dc := client.NewDgraphClient(api.NewDgraphClient(cc))
+	for i, ev := range events {
+		x.Checkf(ev.replay(context.Background(), dc), "Error while replaying %s", ev)
+		if (i+1)%1000 == 0 {
+			fmt.Printf("Replayed %d entries\n", i+1)
+		}
+	}
+	fmt.Printf("Replayed %d entries\n", len(events))
+}
+
+// readArchives returns the entries of every group found in the archive folders, in the order
+// of their index. Replicas of a group archive the same entries, so those are only kept once.
+func readArchives(dirs []string) (map[uint32][]*raftwal.ArchivedEntry, error) {
+	entries := make(map[uint32][]*raftwal.ArchivedEntry)
+	for _, dir := range dirs {
+		gids, err := raftwal.ArchivedGroups(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, gid := range gids {
+			r, err := raftwal.OpenArchiveReader(dir, gid)
+			if err != nil {
+				return nil, err
+			}
+			var es []*raftwal.ArchivedEntry
+			for {
+				e, err := r.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					r.Close()
+					return nil, x.Wrapf(err, "While reading group %d in %s", gid, dir)
+				}
+				es = append(es, e)
+			}
+			r.Close()
+			entries[gid] = mergeEntries(entries[gid], es)
+		}
+	}
+	return entries, nil
+}
+
+// mergeEntries merges two lists of entries sorted by index, keeping one of each index.
+func mergeEntries(a, b []*raftwal.ArchivedEntry) []*raftwal.ArchivedEntry {
+	out := make([]*raftwal.ArchivedEntry, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		switch {
+		case len(b) == 0 || (len(a) > 0 && a[0].Index < b[0].Index):
+			out, a = append(out, a[0]), a[1:]
+		case len(a) == 0 || b[0].Index < a[0].Index:
+			out, b = append(out, b[0]), b[1:]
+		default:
+			out, a, b = append(out, a[0]), a[1:], b[1:]
+		}
+	}
+	return out
+}
+
+// leaseUids makes Zero lease the uids used by the replayed transactions, so that new nodes
+// don't get them.
+func leaseUids(addr string, maxUid uint64) {
+	cc, err := grpc.Dial(addr, conn.TransportOption())
+	x.Checkf(err, "Error while connecting to zero")
+	defer cc.Close()
+	zc := intern.NewZeroClient(cc)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := zc.AssignUids(ctx, &intern.Num{Val: maxUid})
+		cancel()
+		if err == nil {
+			return
+		}
+		x.Printf("error communicating with dgraph zero, retrying: %v", err)
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,377 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package restore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var Restore x.SubCommand
+
+func init() {
+	Restore.Cmd = &cobra.Command{
+		Use:   "restore",
+		Short: "Rebuild postings directories from a binary backup",
+		Long: `
+Writes the postings (p) directories of a new cluster from a backup taken with
+/admin/backup. An incremental backup is restored along with the full backup and
+the incremental backups it builds on. The predicates are spread over --shards
+directories, one for each group of the new cluster, by their size in the backup.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(Restore.Conf).Stop()
+			run()
+		},
+	}
+	Restore.EnvPrefix = "DGRAPH_RESTORE"
+
+	flag := Restore.Cmd.Flags()
+	flag.StringP("location", "l", "backup",
+		"Folder holding the backups, with the files of all the groups.")
+	flag.StringP("name", "n", "", "Name of the backup to restore. Defaults to the latest one.")
+	flag.String("out", "out",
+		"Location to write the postings directories to, as <out>/<shard>/p.")
+	flag.Int("shards", 1, "Number of groups in the new cluster.")
+	flag.String("layout", "",
+		"JSON file mapping predicates to the shard, from 0, to put them in. The other "+
+			"predicates are spread by size.")
+	flag.StringP("zero", "z", "localhost:7080",
+		"gRPC address of the Zero of the new cluster, which leases the timestamps and uids.")
+	flag.StringP("encryption_key_file", "k", "",
+		"Key file to decrypt an encrypted backup with. The restored data is encrypted with it.")
+	// Zero only takes connections with the cluster certificates when run with them.
+	x.RegisterClusterTLSFlags(flag)
+}
+
+type options struct {
+	location string
+	backup   string
+	out      string
+	shards   int
+	layout   map[string]int
+	zero     string
+}
+
+func run() {
+	opt := options{
+		location: Restore.Conf.GetString("location"),
+		backup:   Restore.Conf.GetString("name"),
+		out:      Restore.Conf.GetString("out"),
+		shards:   Restore.Conf.GetInt("shards"),
+		zero:     Restore.Conf.GetString("zero"),
+	}
+	x.AssertTruefNoTrace(opt.shards > 0, "--shards must be positive")
+	clusterTLS, err := x.LoadClusterTLS(Restore.Conf)
+	x.Checkf(err, "Invalid cluster TLS options")
+	conn.SetClusterTLS(clusterTLS)
+	if file := Restore.Conf.GetString("layout"); file != "" {
+		data, err := ioutil.ReadFile(file)
+		x.Checkf(err, "Error while reading layout")
+		x.Checkf(json.Unmarshal(data, &opt.layout), "Error while reading layout")
+	}
+	var keys *x.KeyRing
+	if keyFile := Restore.Conf.GetString("encryption_key_file"); keyFile != "" {
+		var err error
+		keys, err = x.LoadKeyRing(keyFile)
+		x.Checkf(err, "Error while loading encryption keys")
+		x.SetValueEncryption(keys)
+	}
+
+	chain, err := worker.BackupChain(opt.location, opt.backup)
+	x.Check(err)
+	backups := make([][]*worker.GroupBackup, len(chain))
+	for i, m := range chain {
+		x.AssertTruefNoTrace(!m.Encrypted || keys != nil,
+			"Backup %s is encrypted, it needs an --encryption_key_file", m.Name)
+		for _, gid := range m.Groups {
+			g, err := worker.ReadGroupBackup(filepath.Join(opt.location, m.Name), gid)
+			x.Checkf(err, "Backup %s is missing the files of group %d", m.Name, gid)
+			backups[i] = append(backups[i], g)
+		}
+		if split := splitPredicates(backups[i]); len(split) > 0 {
+			x.Fatalf("Predicates %v were split across groups in backup %s, which can't be"+
+				" restored. Use an export instead.", split, m.Name)
+		}
+	}
+	sizes := predicateSizes(backups)
+	shardOf, err := assignShards(sizes, opt.shards, opt.layout)
+	x.Check(err)
+
+	dbs := make([]*badger.ManagedDB, opt.shards)
+	for i := range dbs {
+		dir := filepath.Join(opt.out, strconv.Itoa(i), "p")
+		if _, err := os.Stat(filepath.Join(dir, "MANIFEST")); err == nil {
+			x.Fatalf("Directory %s already holds data", dir)
+		}
+		x.Check(os.MkdirAll(dir, 0700))
+		dbs[i] = openStore(dir)
+		defer dbs[i].Close()
+		if keys != nil {
+			x.Check(x.WriteEncryptedMarker(dir, keys))
+		}
+	}
+
+	last := chain[len(chain)-1]
+	startTs := lease(opt.zero, uint64(len(chain)), last.MaxUid)
+	r := &restorer{dbs: dbs, shardOf: shardOf, schema: make(map[string]*intern.KV)}
+	for i, m := range chain {
+		fmt.Printf("Restoring backup %s\n", m.Name)
+		for _, g := range backups[i] {
+			x.Checkf(r.restore(filepath.Join(opt.location, m.Name), g, startTs+uint64(i)),
+				"Error while restoring group %d of backup %s", g.GroupId, m.Name)
+		}
+	}
+	x.Checkf(r.writeSchema(startTs+uint64(len(chain)-1)), "Error while writing schema")
+
+	for i := range dbs {
+		var preds []string
+		for pred, shard := range shardOf {
+			if shard == i {
+				preds = append(preds, pred)
+			}
+		}
+		sort.Strings(preds)
+		fmt.Printf("Shard %d: %v\n", i, preds)
+	}
+	fmt.Printf("Restored %d keys of backup %s to %s\n", r.count, last.Name, opt.out)
+}
+
+func openStore(dir string) *badger.ManagedDB {
+	opt := badger.DefaultOptions
+	opt.SyncWrites = false
+	opt.Dir = dir
+	opt.ValueDir = dir
+	db, err := badger.OpenManaged(opt)
+	x.Checkf(err, "Error while opening %s", dir)
+	return db
+}
+
+// lease gets a timestamp for each backup to restore from Zero, and the uids used by the data.
+func lease(addr string, numTs, maxUid uint64) uint64 {
+	cc, err := grpc.Dial(addr, conn.TransportOption())
+	x.Checkf(err, "Error while connecting to zero")
+	defer cc.Close()
+	client := intern.NewZeroClient(cc)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		ts, err := client.Timestamps(ctx, &intern.Num{Val: numTs})
+		if err == nil && maxUid > 0 {
+			_, err = client.AssignUids(ctx, &intern.Num{Val: maxUid})
+		}
+		cancel()
+		if err == nil {
+			return ts.StartId
+		}
+		x.Printf("error communicating with dgraph zero, retrying: %v", err)
+		time.Sleep(time.Second)
+	}
+}
+
+// predicateSizes returns the size in the backups of the predicates in the last one. The others
+// were dropped in between.
+func predicateSizes(backups [][]*worker.GroupBackup) map[string]int64 {
+	sizes := make(map[string]int64)
+	for _, g := range backups[len(backups)-1] {
+		for pred := range g.Predicates {
+			sizes[pred] = 0
+		}
+	}
+	for _, groups := range backups {
+		for _, g := range groups {
+			for pred, sz := range g.Predicates {
+				if _, ok := sizes[pred]; ok {
+					sizes[pred] += sz
+				}
+			}
+		}
+	}
+	return sizes
+}
+
+// splitPredicates returns the predicates backed up by more than one group, as they were split
+// by uid. The indexes of each group only cover its own uids, so they can't be put together.
+func splitPredicates(groups []*worker.GroupBackup) []string {
+	seen := make(map[string]bool)
+	var split []string
+	for _, g := range groups {
+		for pred := range g.Predicates {
+			if seen[pred] {
+				split = append(split, pred)
+			}
+			seen[pred] = true
+		}
+	}
+	sort.Strings(split)
+	return split
+}
+
+// assignShards puts the predicates pinned by the layout in their shard, then the others, from
+// the largest, in the shard holding the least data so far.
+func assignShards(sizes map[string]int64, shards int, layout map[string]int) (map[string]int,
+	error) {
+	shardOf := make(map[string]int, len(sizes))
+	total := make([]int64, shards)
+	var rest []string
+	for pred, sz := range sizes {
+		shard, ok := layout[pred]
+		if !ok {
+			rest = append(rest, pred)
+			continue
+		}
+		if shard < 0 || shard >= shards {
+			return nil, x.Errorf("Layout puts predicate %s in shard %d, out of %d shards",
+				pred, shard, shards)
+		}
+		shardOf[pred] = shard
+		total[shard] += sz
+	}
+	sort.Slice(rest, func(i, j int) bool {
+		if sizes[rest[i]] != sizes[rest[j]] {
+			return sizes[rest[i]] > sizes[rest[j]]
+		}
+		return rest[i] < rest[j]
+	})
+	for _, pred := range rest {
+		min := 0
+		for i := range total {
+			if total[i] < total[min] {
+				min = i
+			}
+		}
+		shardOf[pred] = min
+		total[min] += sizes[pred]
+	}
+	return shardOf, nil
+}
+
+type restorer struct {
+	dbs     []*badger.ManagedDB
+	shardOf map[string]int
+	// The latest schema of each predicate, written once all the data is.
+	schema map[string]*intern.KV
+	count  int
+}
+
+func (r *restorer) restore(dir string, g *worker.GroupBackup, ts uint64) error {
+	br, err := worker.OpenBackup(dir, g)
+	if err != nil {
+		return err
+	}
+	defer br.Close()
+
+	txns := make([]*badger.Txn, len(r.dbs))
+	defer func() {
+		for _, txn := range txns {
+			if txn != nil {
+				txn.Discard()
+			}
+		}
+	}()
+	for {
+		kv, err := br.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		pk := x.Parse(kv.Key)
+		if pk == nil {
+			continue
+		}
+		shard, ok := r.shardOf[pk.Attr]
+		if !ok {
+			continue
+		}
+		if pk.IsSchema() {
+			r.schema[pk.Attr] = kv
+			continue
+		}
+		if txns[shard] == nil {
+			txns[shard] = r.dbs[shard].NewTransactionAt(math.MaxUint64, true)
+		}
+		err = setKV(txns[shard], kv)
+		if err == badger.ErrTxnTooBig {
+			if err := txns[shard].CommitAt(ts, nil); err != nil {
+				return err
+			}
+			txns[shard] = r.dbs[shard].NewTransactionAt(math.MaxUint64, true)
+			err = setKV(txns[shard], kv)
+		}
+		if err != nil {
+			return err
+		}
+		if r.count++; r.count%100000 == 0 {
+			fmt.Printf("Restored %d keys\n", r.count)
+		}
+	}
+	for _, txn := range txns {
+		if txn == nil {
+			continue
+		}
+		if err := txn.CommitAt(ts, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setKV writes the KV, or deletes its key if it has no value.
+func setKV(txn *badger.Txn, kv *intern.KV) error {
+	if len(kv.Val) == 0 {
+		return txn.Delete(kv.Key)
+	}
+	if len(kv.UserMeta) == 0 {
+		return x.Errorf("Key %x has no user meta in the backup", kv.Key)
+	}
+	return txn.SetWithMeta(kv.Key, x.EncryptValue(kv.Val), kv.UserMeta[0])
+}
+
+func (r *restorer) writeSchema(ts uint64) error {
+	for pred, kv := range r.schema {
+		txn := r.dbs[r.shardOf[pred]].NewTransactionAt(math.MaxUint64, true)
+		err := setKV(txn, kv)
+		if err == nil {
+			err = txn.CommitAt(ts, nil)
+		}
+		txn.Discard()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+func TestPredicateSizes(t *testing.T) {
+	backups := [][]*worker.GroupBackup{
+		{
+			{GroupId: 1, Predicates: map[string]int64{"name": 10, "dropped": 50}},
+			{GroupId: 2, Predicates: map[string]int64{"friend": 20}},
+		},
+		{
+			{GroupId: 1, Predicates: map[string]int64{"name": 5, "friend": 1}},
+		},
+	}
+	require.Equal(t, map[string]int64{"name": 15, "friend": 21}, predicateSizes(backups))
+}
+
+func TestSplitPredicates(t *testing.T) {
+	groups := []*worker.GroupBackup{
+		{GroupId: 1, Predicates: map[string]int64{"name": 10, "follows": 50}},
+		{GroupId: 2, Predicates: map[string]int64{"friend": 20, "follows": 40}},
+	}
+	require.Equal(t, []string{"follows"}, splitPredicates(groups))
+	require.Empty(t, splitPredicates(groups[1:]))
+}
+
+func TestAssignShards(t *testing.T) {
+	sizes := map[string]int64{"a": 100, "b": 60, "c": 50, "d": 10, "e": 5}
+	shardOf, err := assignShards(sizes, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"a": 0, "b": 1, "c": 1, "d": 0, "e": 0}, shardOf)
+
+	shardOf, err = assignShards(sizes, 2, map[string]int{"a": 1, "b": 1})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"a": 1, "b": 1, "c": 0, "d": 0, "e": 0}, shardOf)
+
+	_, err = assignShards(sizes, 2, map[string]int{"a": 2})
+	require.Error(t, err)
+}
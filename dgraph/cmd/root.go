@@ -22,7 +22,10 @@ import (
 	"os"
 
 	"github.com/dgraph-io/dgraph/dgraph/cmd/bulk"
+	"github.com/dgraph-io/dgraph/dgraph/cmd/encrypt"
 	"github.com/dgraph-io/dgraph/dgraph/cmd/live"
+	"github.com/dgraph-io/dgraph/dgraph/cmd/recovery"
+	"github.com/dgraph-io/dgraph/dgraph/cmd/restore"
 	"github.com/dgraph-io/dgraph/dgraph/cmd/server"
 	"github.com/dgraph-io/dgraph/dgraph/cmd/zero"
 	"github.com/dgraph-io/dgraph/x"
@@ -67,7 +70,8 @@ func init() {
 	rootConf.BindPFlags(RootCmd.PersistentFlags())
 
 	var subcommands = []*x.SubCommand{
-		&bulk.Bulk, &live.Live, &server.Server, &zero.Zero,
+		&bulk.Bulk, &live.Live, &server.Server, &zero.Zero, &encrypt.Encrypt, &restore.Restore,
+		&recovery.Recover,
 	}
 	for _, sc := range subcommands {
 		RootCmd.AddCommand(sc.Cmd)
@@ -19,12 +19,13 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/posting"
@@ -32,18 +33,13 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 )
 
-// handlerInit does some standard checks. Returns false if something is wrong.
+// handlerInit does some standard checks. Returns false if something is wrong. Who can call
+// the admin endpoints is checked by adminConf.AdminHandler.
 func handlerInit(w http.ResponseWriter, r *http.Request) bool {
 	if r.Method != http.MethodGet {
 		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
 		return false
 	}
-
-	ip, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err != nil || !net.ParseIP(ip).IsLoopback() {
-		x.SetStatus(w, x.ErrorUnauthorized, fmt.Sprintf("Request from IP: %v", ip))
-		return false
-	}
 	return true
 }
 
@@ -62,18 +58,118 @@ func shutdownServer() {
 	sdCh <- os.Interrupt
 }
 
+// namespaceContext returns a context for the namespace given in the query, if any.
+func namespaceContext(w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	ctx := context.Background()
+	ns := r.URL.Query().Get("namespace")
+	if ns == "" {
+		return ctx, true
+	}
+	id, err := strconv.ParseUint(ns, 10, 64)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid namespace: "+ns)
+		return nil, false
+	}
+	return x.WithNamespace(ctx, id), true
+}
+
+// exportHandler exports all the groups at one timestamp. The format (rdf or json), a comma
+// separated list of predicates to export, the directory to write to and the namespace are taken
+// from the query.
 func exportHandler(w http.ResponseWriter, r *http.Request) {
 	if !handlerInit(w, r) {
 		return
 	}
-	ctx := context.Background()
+	ctx, ok := namespaceContext(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	opts := worker.ExportOptions{
+		Format: q.Get("format"),
+		Dir:    q.Get("dir"),
+	}
+	if preds := q.Get("predicates"); preds != "" {
+		for _, pred := range strings.Split(preds, ",") {
+			if pred = strings.TrimSpace(pred); pred != "" {
+				opts.Predicates = append(opts.Predicates, pred)
+			}
+		}
+	}
 	// Export logic can be moved to dgraphzero.
-	if err := worker.ExportOverNetwork(ctx); err != nil {
+	m, err := worker.ExportOverNetwork(ctx, opts)
+	if err != nil {
 		x.SetStatus(w, err.Error(), "Export failed.")
 		return
 	}
+	resp, err := json.Marshal(map[string]interface{}{
+		"code":    "Success",
+		"message": "Export completed.",
+		"export":  m,
+	})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// backupHandler writes a binary backup of all the groups. With incremental=true, it only holds
+// the changes since the latest backup in the backup folder. With namespace set, it only holds the
+// predicates of that namespace.
+func backupHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r) {
+		return
+	}
+	ctx, ok := namespaceContext(w, r)
+	if !ok {
+		return
+	}
+	incremental, _ := strconv.ParseBool(r.URL.Query().Get("incremental"))
+	m, err := worker.BackupOverNetwork(ctx, incremental)
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Backup failed.")
+		return
+	}
+	resp, err := json.Marshal(map[string]interface{}{
+		"code":    "Success",
+		"message": "Backup completed.",
+		"backup":  m,
+	})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// replicationHandler returns whether this server is a standby, and its lag behind the primary.
+func replicationHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r) {
+		return
+	}
+	resp, err := json.Marshal(edgraph.GetReplicationStatus())
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// promoteHandler stops a standby from replicating, and lets it take writes.
+func promoteHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r) {
+		return
+	}
+	if err := edgraph.PromoteStandby(); err != nil {
+		x.SetStatus(w, err.Error(), "Promotion failed.")
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
-	w.Write([]byte(`{"code": "Success", "message": "Export completed."}`))
+	w.Write([]byte(`{"code": "Success", "message": "Standby promoted."}`))
 }
 
 func memoryLimitHandler(w http.ResponseWriter, r *http.Request) {
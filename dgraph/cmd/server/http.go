@@ -26,12 +26,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/query"
-	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -59,6 +59,20 @@ func extractStartTs(urlPath string) (uint64, error) {
 	return 0, nil
 }
 
+// withClient passes who sent the request on to edgraph.Server. The access token sent in the
+// X-Dgraph-AccessToken header is checked when access control is enabled, and the remote address
+// is written to the audit log.
+func withClient(ctx context.Context, r *http.Request) context.Context {
+	ctx = context.WithValue(ctx, "clientaddr", r.RemoteAddr)
+	if ns := r.Header.Get("X-Dgraph-Namespace"); ns != "" {
+		ctx = context.WithValue(ctx, "namespace", ns)
+	}
+	if token := r.Header.Get("X-Dgraph-AccessToken"); token != "" {
+		return context.WithValue(ctx, "accesstoken", token)
+	}
+	return ctx
+}
+
 // This method should just build the request and proxy it to the Query method of dgraph.Server.
 // It can then encode the response as appropriate before sending it back to the user.
 func queryHandler(w http.ResponseWriter, r *http.Request) {
@@ -105,6 +119,34 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Either a timestamp, or a time in RFC3339 format.
+	if asOf := r.Header.Get("X-Dgraph-AsOf"); asOf != "" {
+		if ts, err := strconv.ParseUint(asOf, 0, 64); err == nil {
+			req.AsOfTs = ts
+		} else if t, err := time.Parse(time.RFC3339, asOf); err == nil {
+			req.AsOfTime = t.Unix()
+		} else {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while parsing AsOf header as a timestamp or an RFC3339 time")
+			return
+		}
+	}
+
+	if readOnly := r.Header.Get("X-Dgraph-ReadOnly"); readOnly != "" {
+		if req.ReadOnly, err = strconv.ParseBool(readOnly); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while parsing ReadOnly header as bool")
+			return
+		}
+	}
+	if bestEffort := r.Header.Get("X-Dgraph-BestEffort"); bestEffort != "" {
+		if req.BestEffort, err = strconv.ParseBool(bestEffort); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while parsing BestEffort header as bool")
+			return
+		}
+	}
+
 	defer r.Body.Close()
 	q, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -115,12 +157,22 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 
 	d := r.URL.Query().Get("debug")
 	ctx := context.WithValue(context.Background(), "debug", d)
-	resp, err := (&edgraph.Server{}).Query(ctx, &req)
+	resp, err := (&edgraph.Server{}).Query(withClient(ctx, r), &req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
 
+	js, err := queryResponse(resp)
+	if err != nil {
+		x.SetStatusWithData(w, x.Error, err.Error())
+		return
+	}
+	w.Write(js)
+}
+
+// queryResponse returns the JSON sent back over HTTP for a query response.
+func queryResponse(resp *api.Response) ([]byte, error) {
 	response := map[string]interface{}{}
 
 	e := query.Extensions{
@@ -136,8 +188,7 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		})
 		js, err := json.Marshal(resp.Schema)
 		if err != nil {
-			x.SetStatusWithData(w, x.Error, "Unable to marshal schema")
-			return
+			return nil, x.Errorf("Unable to marshal schema")
 		}
 		mp := map[string]interface{}{}
 		mp["schema"] = json.RawMessage(string(js))
@@ -146,11 +197,141 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		response["data"] = json.RawMessage(string(resp.Json))
 	}
 
-	if js, err := json.Marshal(response); err == nil {
-		w.Write(js)
-	} else {
-		x.SetStatusWithData(w, x.Error, "Unable to marshal response")
+	js, err := json.Marshal(response)
+	if err != nil {
+		return nil, x.Errorf("Unable to marshal response")
+	}
+	return js, nil
+}
+
+// liveQueryHandler streams the results of a live query as server-sent events, one event
+// every time the result changes. The query is read from the request body, or from the q
+// parameter so that it can be used with EventSource.
+func liveQueryHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+
+	if r.Method != http.MethodGet && !allowed(r.Method) {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		x.SetStatus(w, x.Error, "Streaming isn't supported")
+		return
+	}
+
+	req := api.Request{Query: r.URL.Query().Get("q")}
+	if vars := r.Header.Get("X-Dgraph-Vars"); vars != "" {
+		req.Vars = map[string]string{}
+		if err := json.Unmarshal([]byte(vars), &req.Vars); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while unmarshalling Vars header into map")
+			return
+		}
+	}
+	if r.Method != http.MethodGet {
+		defer r.Body.Close()
+		q, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		req.Query = string(q)
+	}
+
+	var streaming bool
+	send := func(resp *api.Response) error {
+		js, err := queryResponse(resp)
+		if err != nil {
+			return err
+		}
+		if !streaming {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			streaming = true
+		}
+		fmt.Fprintf(w, "data: %s\n\n", js)
+		flusher.Flush()
+		return nil
+	}
+	err := (&edgraph.Server{}).LiveQuery(withClient(r.Context(), r), &req, send)
+	if err == nil || r.Context().Err() != nil {
+		return
+	}
+	if !streaming {
+		w.Header().Set("Content-Type", "application/json")
+		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	fmt.Fprint(w, "event: error\ndata: ")
+	x.SetStatus(w, x.Error, err.Error())
+	fmt.Fprint(w, "\n\n")
+	flusher.Flush()
+}
+
+// deleteHandler runs a delete by query. The body holds the api.DeleteByQueryRequest as JSON,
+// and the progress is streamed back as server-sent events.
+func deleteHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+
+	if !allowed(r.Method) {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		x.SetStatus(w, x.Error, "Streaming isn't supported")
+		return
+	}
+
+	var req api.DeleteByQueryRequest
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Error while unmarshalling request: "+err.Error())
+		return
+	}
+
+	var streaming bool
+	send := func(p *api.DeleteProgress) error {
+		js, err := json.Marshal(map[string]interface{}{
+			"matched": p.Matched,
+			"deleted": p.Deleted,
+			"batches": p.Batches,
+			"done":    p.Done,
+		})
+		if err != nil {
+			return err
+		}
+		if !streaming {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			streaming = true
+		}
+		fmt.Fprintf(w, "data: %s\n\n", js)
+		flusher.Flush()
+		return nil
+	}
+	err := (&edgraph.Server{}).BulkDelete(withClient(r.Context(), r), &req, send)
+	if err == nil || r.Context().Err() != nil {
+		return
 	}
+	if !streaming {
+		w.Header().Set("Content-Type", "application/json")
+		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	fmt.Fprint(w, "event: error\ndata: ")
+	x.SetStatus(w, x.Error, err.Error())
+	fmt.Fprint(w, "\n\n")
+	flusher.Flush()
 }
 
 func mutationHandler(w http.ResponseWriter, r *http.Request) {
@@ -202,6 +383,34 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 		mu.IgnoreIndexConflict = ignore
 	}
 
+	if deleteIncoming := r.Header.Get("X-Dgraph-DeleteIncoming"); deleteIncoming != "" {
+		if mu.DeleteIncoming, err = strconv.ParseBool(deleteIncoming); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while parsing DeleteIncoming header as bool")
+			return
+		}
+	}
+
+	// Set by clients whose txn context came back read_only or best_effort.
+	if readOnly := r.Header.Get("X-Dgraph-ReadOnly"); readOnly != "" {
+		if mu.ReadOnly, err = strconv.ParseBool(readOnly); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while parsing ReadOnly header as bool")
+			return
+		}
+	}
+
+	// A Go duration, like 24h, after which the edges set by the mutation expire.
+	if ttl := r.Header.Get("X-Dgraph-TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil || d < time.Second {
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Error while parsing TTL header as a duration of at least 1s")
+			return
+		}
+		mu.Ttl = uint64(d / time.Second)
+	}
+
 	ts, err := extractStartTs(r.URL.Path)
 	if err != nil {
 		x.SetStatus(w, err.Error(), x.ErrorInvalidRequest)
@@ -209,14 +418,15 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	mu.StartTs = ts
 
-	resp, err := (&edgraph.Server{}).Mutate(context.Background(), mu)
+	resp, err := (&edgraph.Server{}).Mutate(withClient(context.Background(), r), mu)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
 
 	e := query.Extensions{
-		Txn: resp.Context,
+		Txn:      resp.Context,
+		Warnings: resp.Warnings,
 	}
 
 	// Don't send keys array which is part of txn context if its commit immediately.
@@ -288,12 +498,12 @@ func commitHandler(w http.ResponseWriter, r *http.Request) {
 
 	tc.Keys = encodedKeys
 
-	cts, err := worker.CommitOverNetwork(context.Background(), tc)
+	tctx, err := (&edgraph.Server{}).CommitOrAbort(withClient(context.Background(), r), tc)
 	if err != nil {
 		x.SetStatus(w, x.Error, err.Error())
 		return
 	}
-	resp.Context.CommitTs = cts
+	resp.Context.CommitTs = tctx.CommitTs
 
 	e := query.Extensions{
 		Txn: resp.Context,
@@ -346,8 +556,9 @@ func abortHandler(w http.ResponseWriter, r *http.Request) {
 	tc.StartTs = ts
 	tc.Aborted = true
 
-	_, aerr := worker.CommitOverNetwork(context.Background(), tc)
-	if aerr != nil {
+	// Zero answers an abort with the transaction aborted.
+	tctx, err := (&edgraph.Server{}).CommitOrAbort(withClient(context.Background(), r), tc)
+	if err != nil && !tctx.Aborted {
 		x.SetStatus(w, x.Error, err.Error())
 		return
 	}
@@ -392,7 +603,7 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 		op.Schema = string(b)
 	}
 
-	_, err = (&edgraph.Server{}).Alter(context.Background(), op)
+	payload, err := (&edgraph.Server{}).Alter(withClient(context.Background(), r), op)
 	if err != nil {
 		x.SetStatus(w, x.Error, err.Error())
 		return
@@ -403,6 +614,53 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 	data["code"] = x.Success
 	data["message"] = "Done"
 	res["data"] = data
+	if len(payload.Warnings) > 0 {
+		res["extensions"] = query.Extensions{Warnings: payload.Warnings}
+	}
+
+	js, err := json.Marshal(res)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Write(js)
+}
+
+// loginHandler takes the userid and password as JSON, and returns an access token to send in
+// the X-Dgraph-AccessToken header of later requests.
+func loginHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	req := &api.LoginRequest{}
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Error while unmarshalling login request")
+		return
+	}
+
+	resp, err := (&edgraph.Server{}).Login(withClient(context.Background(), r), req)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+
+	res := map[string]interface{}{}
+	data := map[string]interface{}{}
+	data["accessToken"] = resp.AccessToken
+	data["expiresAt"] = resp.ExpiresAt
+	res["data"] = data
 
 	js, err := json.Marshal(res)
 	if err != nil {
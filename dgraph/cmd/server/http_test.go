@@ -1,14 +1,17 @@
 package server
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/dgraph-io/dgraph/query"
@@ -177,3 +180,30 @@ func TestTransactionBasic(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, `{"data":{"balances":[{"uid":"0x1","name":"Bob","balance":"110"}]}}`, data)
 }
+
+func TestLiveQuery(t *testing.T) {
+	require.NoError(t, runMutation(`{ set { <0x3001> <live> "one" . } }`))
+
+	srv := httptest.NewServer(http.HandlerFunc(liveQueryHandler))
+	defer srv.Close()
+	q := `{ me(func: uid(0x3001)) { live } }`
+	resp, err := http.Get(srv.URL + "?q=" + url.QueryEscape(q))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	r := bufio.NewReader(resp.Body)
+	next := func() string {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		// Each event ends with an empty line.
+		_, err = r.ReadString('\n')
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(line, "data: "))
+		return line
+	}
+	require.Contains(t, next(), `{"me":[{"live":"one"}]}`)
+
+	require.NoError(t, runMutation(`{ set { <0x3001> <live> "two" . } }`))
+	require.Contains(t, next(), `{"me":[{"live":"two"}]}`)
+}
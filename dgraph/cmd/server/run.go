@@ -36,6 +36,7 @@ import (
 	"golang.org/x/net/trace"
 	"google.golang.org/grpc"
 
+	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/api"
@@ -51,6 +52,9 @@ var (
 	config  edgraph.Options
 	tlsConf x.TLSHelperConfig
 	uiDir   string
+
+	// Guards the /admin endpoints.
+	adminConf x.AdminConfig
 )
 
 var Server x.SubCommand
@@ -82,6 +86,16 @@ func init() {
 
 	flag.String("export", defaults.ExportPath,
 		"Folder in which to store exports.")
+	flag.String("backup", defaults.BackupPath,
+		"Folder in which to store binary backups.")
+	flag.String("cdc", defaults.CdcPath,
+		"Folder in which to write committed changes as rotating JSON-lines files. Subscriptions "+
+			"resume from it once the changes are no longer buffered. Empty disables it.")
+	flag.String("wal_archive", defaults.WalArchivePath,
+		"Folder in which to archive the applied raft entries, for point-in-time recovery. "+
+			"Empty disables it.")
+	flag.Duration("wal_archive_retention", defaults.WalArchiveRetention,
+		"Delete the WAL archive files last written to longer ago than this. Zero keeps them.")
 	flag.Int("pending_proposals", defaults.NumPendingProposals,
 		"Number of pending mutation proposals. Useful for rate limiting.")
 	flag.Float64("trace", defaults.Tracing,
@@ -97,6 +111,39 @@ func init() {
 	flag.Bool("expand_edge", defaults.ExpandEdge,
 		"Enables the expand() feature. This is very expensive for large data loads because it"+
 			" doubles the number of mutations going on in the system.")
+	flag.Duration("history_retention", defaults.HistoryRetention,
+		"How long to keep older versions of data around for queries in the past, e.g. 24h."+
+			" Zero disables reads in the past.")
+	flag.Duration("ttl_purge_interval", defaults.TTLPurgeInterval,
+		"How often the group leader deletes edges whose TTL has run out. Expired edges are"+
+			" hidden from reads right away. Zero disables the purge.")
+	flag.String("acl_secret_file", defaults.AclSecretFile,
+		"File holding the secret (at least 32 bytes) that signs access tokens. Setting it"+
+			" enables access control lists. All servers in the cluster must use the same secret.")
+	flag.String("acl_password_file", defaults.AclPasswordFile,
+		"File holding the password the groot user is created with, when access control is"+
+			" first enabled. Required with --acl_secret_file.")
+	flag.Duration("acl_access_ttl", defaults.AclAccessTtl,
+		"How long the access tokens issued by login are valid for.")
+	flag.String("encryption_key_file", defaults.EncryptionKeyFile,
+		"File holding hex encoded AES-256 keys, one per line, encrypting the postings and WAL."+
+			" The last key encrypts new data. Older ones are kept to read data written with them.")
+	flag.Bool("encrypt_exports", defaults.EncryptExports,
+		"Encrypt exports and backups with the active key of --encryption_key_file.")
+	flag.Bool("standby", defaults.Standby,
+		"Run as a read-only standby of another cluster until promoted via /admin/promote.")
+	flag.String("replicate_from", defaults.ReplicateFrom,
+		"Comma separated gRPC addresses of primary servers whose committed changes this server"+
+			" applies to its cluster. The next one is used when one fails. Implies --standby.")
+	flag.Uint64("replicate_since_ts", defaults.ReplicateSinceTs,
+		"Read timestamp of the backup the standby was restored from. Replication starts with"+
+			" the changes committed after it.")
+	flag.Duration("replication_max_lag", defaults.ReplicationMaxLag,
+		"Fail queries while the standby is further behind its primary. Zero disables it.")
+	flag.String("replication_user", defaults.ReplicationUser,
+		"User the standby logs in to the primary as, when it has access control enabled.")
+	flag.String("replication_password_file", defaults.ReplicationPasswordFile,
+		"File holding the password of --replication_user.")
 
 	flag.Float64("memory_mb", defaults.AllottedMemory,
 		"Estimated memory the process can take. "+
@@ -119,6 +166,9 @@ func init() {
 	flag.String("tls_client_auth", "", "Enable TLS client authentication")
 	flag.String("tls_ca_certs", "", "CA Certs file path.")
 	tlsConf.ConfigType = x.TLSServerConfig
+	x.RegisterAdminFlags(flag)
+	x.RegisterClusterTLSFlags(flag)
+	x.RegisterAuditFlags(flag)
 
 	//Custom plugins.
 	flag.String("custom_tokenizers", "",
@@ -208,6 +258,7 @@ func serveGRPC(l net.Listener, wg *sync.WaitGroup) {
 func serveHTTP(l net.Listener, wg *sync.WaitGroup) {
 	defer wg.Done()
 	srv := &http.Server{
+		Handler:      adminConf.GuardDebug(http.DefaultServeMux),
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 600 * time.Second,
 		IdleTimeout:  2 * time.Minute,
@@ -245,17 +296,24 @@ func setupServer() {
 
 	http.HandleFunc("/query", queryHandler)
 	http.HandleFunc("/query/", queryHandler)
+	http.HandleFunc("/live", liveQueryHandler)
 	http.HandleFunc("/mutate", mutationHandler)
 	http.HandleFunc("/mutate/", mutationHandler)
+	http.HandleFunc("/delete", deleteHandler)
 	http.HandleFunc("/commit/", commitHandler)
 	http.HandleFunc("/abort/", abortHandler)
 	http.HandleFunc("/alter", alterHandler)
+	http.HandleFunc("/login", loginHandler)
 	http.HandleFunc("/health", healthCheck)
 	http.HandleFunc("/share", shareHandler)
+	// Guarded along with the rest of /debug/ by serveHTTP.
 	http.HandleFunc("/debug/store", storeStatsHandler)
-	http.HandleFunc("/admin/shutdown", shutDownHandler)
-	http.HandleFunc("/admin/export", exportHandler)
-	http.HandleFunc("/admin/config/memory_mb", memoryLimitHandler)
+	http.HandleFunc("/admin/shutdown", adminConf.AdminHandler(shutDownHandler))
+	http.HandleFunc("/admin/export", adminConf.AdminHandler(exportHandler))
+	http.HandleFunc("/admin/backup", adminConf.AdminHandler(backupHandler))
+	http.HandleFunc("/admin/replication", adminConf.AdminHandler(replicationHandler))
+	http.HandleFunc("/admin/promote", adminConf.AdminHandler(promoteHandler))
+	http.HandleFunc("/admin/config/memory_mb", adminConf.AdminHandler(memoryLimitHandler))
 
 	// UI related API's.
 	// Share urls have a hex string as the shareId. So if
@@ -287,26 +345,54 @@ var sdCh chan os.Signal
 
 func run() {
 	config := edgraph.Options{
-		PostingDir:          Server.Conf.GetString("postings"),
-		PostingTables:       Server.Conf.GetString("posting_tables"),
-		WALDir:              Server.Conf.GetString("wal"),
-		Nomutations:         Server.Conf.GetBool("nomutations"),
-		AllottedMemory:      Server.Conf.GetFloat64("memory_mb"),
-		ExportPath:          Server.Conf.GetString("export"),
-		NumPendingProposals: Server.Conf.GetInt("pending_proposals"),
-		Tracing:             Server.Conf.GetFloat64("trace"),
-		MyAddr:              Server.Conf.GetString("my"),
-		ZeroAddr:            Server.Conf.GetString("zero"),
-		RaftId:              uint64(Server.Conf.GetInt("idx")),
-		MaxPendingCount:     uint64(Server.Conf.GetInt("sc")),
-		ExpandEdge:          Server.Conf.GetBool("expand_edge"),
-		DebugMode:           Server.Conf.GetBool("debugmode"),
+		PostingDir:              Server.Conf.GetString("postings"),
+		PostingTables:           Server.Conf.GetString("posting_tables"),
+		WALDir:                  Server.Conf.GetString("wal"),
+		Nomutations:             Server.Conf.GetBool("nomutations"),
+		AllottedMemory:          Server.Conf.GetFloat64("memory_mb"),
+		ExportPath:              Server.Conf.GetString("export"),
+		BackupPath:              Server.Conf.GetString("backup"),
+		CdcPath:                 Server.Conf.GetString("cdc"),
+		WalArchivePath:          Server.Conf.GetString("wal_archive"),
+		WalArchiveRetention:     Server.Conf.GetDuration("wal_archive_retention"),
+		NumPendingProposals:     Server.Conf.GetInt("pending_proposals"),
+		Tracing:                 Server.Conf.GetFloat64("trace"),
+		MyAddr:                  Server.Conf.GetString("my"),
+		ZeroAddr:                Server.Conf.GetString("zero"),
+		RaftId:                  uint64(Server.Conf.GetInt("idx")),
+		MaxPendingCount:         uint64(Server.Conf.GetInt("sc")),
+		ExpandEdge:              Server.Conf.GetBool("expand_edge"),
+		HistoryRetention:        Server.Conf.GetDuration("history_retention"),
+		TTLPurgeInterval:        Server.Conf.GetDuration("ttl_purge_interval"),
+		AclSecretFile:           Server.Conf.GetString("acl_secret_file"),
+		AclPasswordFile:         Server.Conf.GetString("acl_password_file"),
+		AclAccessTtl:            Server.Conf.GetDuration("acl_access_ttl"),
+		EncryptionKeyFile:       Server.Conf.GetString("encryption_key_file"),
+		EncryptExports:          Server.Conf.GetBool("encrypt_exports"),
+		Standby:                 Server.Conf.GetBool("standby"),
+		ReplicateFrom:           Server.Conf.GetString("replicate_from"),
+		ReplicateSinceTs:        uint64(Server.Conf.GetInt64("replicate_since_ts")),
+		ReplicationMaxLag:       Server.Conf.GetDuration("replication_max_lag"),
+		ReplicationUser:         Server.Conf.GetString("replication_user"),
+		ReplicationPasswordFile: Server.Conf.GetString("replication_password_file"),
+		DebugMode:               Server.Conf.GetBool("debugmode"),
 	}
 	x.Config.PortOffset = Server.Conf.GetInt("port_offset")
 	bindall = Server.Conf.GetBool("bindall")
 	x.LoadTLSConfig(&tlsConf, Server.Conf)
 	tlsConf.ClientAuth = Server.Conf.GetString("tls_client_auth")
 	tlsConf.ClientCACerts = Server.Conf.GetString("tls_ca_certs")
+	x.Checkf(x.LoadAdminConfig(&adminConf, Server.Conf), "Invalid admin options")
+	x.AssertTruefNoTrace(!adminConf.RequireClientCert ||
+		(tlsConf.CertRequired && tlsConf.ClientAuth != ""),
+		"Admin client certificates (--admin_client_cert) need --tls_on and --tls_client_auth")
+	clusterTLS, err := x.LoadClusterTLS(Server.Conf)
+	x.Checkf(err, "Invalid cluster TLS options")
+	conn.SetClusterTLS(clusterTLS)
+	auditLog, err := x.LoadAuditLog(Server.Conf)
+	x.Checkf(err, "Invalid audit log options")
+	edgraph.SetAuditLog(auditLog)
+	adminConf.Audit = auditLog
 	uiDir = Server.Conf.GetString("ui")
 
 	edgraph.SetConfiguration(config)
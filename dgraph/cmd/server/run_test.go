@@ -119,6 +119,7 @@ func prepare() (dir1, dir2 string, rerr error) {
 	worker.Config.ZeroAddr = "localhost:7080"
 	x.Config.PortOffset = 1
 	worker.Config.RaftId = 1
+	worker.Config.TTLPurgeInterval = time.Second
 	go worker.RunServer(false)
 	worker.StartRaftNodes(edgraph.State.WALstore, false)
 	return dir1, dir2, nil
@@ -1194,6 +1195,380 @@ func TestListTypeSchemaChange(t *testing.T) {
 
 }
 
+func TestSchemaConstraint(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`
+			level: int @constraint(min: 1, max: 10) .
+			state: string @enum("open", "closed") .
+	`))
+
+	err := runMutation(`{ set { <0x90> <level> "11" . } }`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Value 11 for predicate level is out of range")
+	err = runMutation(`{ set { <0x90> <state> "pending" . } }`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Value "pending" for predicate state is not one of @enum`)
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0x90> <level> "3" .
+				<0x90> <state> "open" .
+			}
+		}
+	`))
+
+	res, err := runQuery(`schema(pred: [level, state]) {}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"schema":[
+		{"predicate":"level","type":"int","constraint":"min: 1, max: 10"},
+		{"predicate":"state","type":"string","enum":["open","closed"]}]}}`, res)
+
+	// The existing data doesn't match, so the constraint can't be added.
+	err = alterSchema(`state: string @pattern("^[A-Z]") .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Existing data for uid 0x90 violates the new schema")
+	require.NoError(t, alterSchemaWithRetry(`state: string @pattern("^[a-z]") .`))
+}
+
+func TestSchemaTypeMigration(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`rank: string @index(exact) .`))
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0x9a> <rank> "1" .
+				<0x9b> <rank> "20" .
+				<0x9c> <rank> "first" .
+			}
+		}
+	`))
+
+	// The change is rejected, and nothing converted, while some values can't be converted.
+	err := alterSchema(`rank: int @index(int) .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		`Cannot convert 1 values of predicate rank to int, including [0x9c: "first"]`)
+	res, err := runQuery(`{ me(func: eq(rank, "first")) { rank } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"rank":"first"}]}}`, res)
+
+	// The values dropped are reported.
+	payload, err := (&edgraph.Server{}).Alter(defaultContext(), &api.Operation{
+		Schema:      `rank: int @index(int) .`,
+		DropInvalid: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`Dropped 1 values of predicate rank that can't be converted to` +
+		` int, including [0x9c: "first"].`}, payload.Warnings)
+
+	res, err = runQuery(`{ me(func: ge(rank, 10)) { rank } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"rank":20}]}}`, res)
+	res, err = runQuery(`{ me(func: uid(0x9a, 0x9c)) { rank } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"rank":1}]}}`, res)
+}
+
+func renamePredicate(from, to string) error {
+	op, err := json.Marshal(map[string]string{"rename_attr": from, "rename_to": to})
+	if err != nil {
+		return err
+	}
+	return alterSchema(string(op))
+}
+
+func TestRenamePredicate(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`nick: string @index(exact) .`))
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0xa0> <nick> "ace" .
+				<0xa0> <friend> <0xa1> .
+			}
+		}
+	`))
+
+	resp, err := (&edgraph.Server{}).Query(defaultContext(), &api.Request{
+		Query: `{ me(func: uid(0xa0)) { nick } }`,
+	})
+	require.NoError(t, err)
+	beforeTs := resp.Txn.StartTs
+	require.NoError(t, runMutation(`{ set { <0xa0> <nick> "ace2" . } }`))
+
+	require.NoError(t, renamePredicate("nick", "handle"))
+	res, err := runQuery(`{ me(func: eq(handle, "ace2")) { handle } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"handle":"ace2"}]}}`, res)
+	// Older versions are renamed too.
+	resp, err = (&edgraph.Server{}).Query(defaultContext(), &api.Request{
+		Query:   `{ me(func: eq(handle, "ace")) { handle } }`,
+		StartTs: beforeTs,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"me":[{"handle":"ace"}]}`, string(resp.Json))
+	res, err = runQuery(`{ me(func: uid(0xa0)) { nick expand(_all_) { uid } } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"handle":"ace2","friend":[{"uid":"0xa1"}]}]}}`, res)
+	res, err = runQuery(`schema(pred: [nick, handle]) { type index tokenizer }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"schema":[
+		{"predicate":"handle","type":"string","index":true,"tokenizer":["exact"]}]}}`, res)
+
+	err = renamePredicate("handle", "friend")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Predicate friend already exists")
+	err = renamePredicate("handle", "_predicate_")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Predicate _predicate_ can't be renamed")
+}
+
+func TestEdgeTTL(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`ttl_token: string @index(exact) @ttl(2s) .`))
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0xb0> <ttl_token> "abc" .
+				<0xb0> <ttl_name> "kept" .
+			}
+		}
+	`))
+	_, err := (&edgraph.Server{}).Mutate(defaultContext(), &api.Mutation{
+		SetNquads: []byte(`<0xb0> <ttl_friend> <0xb1> .`),
+		CommitNow: true,
+		Ttl:       2,
+	})
+	require.NoError(t, err)
+
+	q := `{ me(func: uid(0xb0)) { ttl_token ttl_name ttl_friend { uid } } }`
+	res, err := runQuery(q)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"ttl_token":"abc","ttl_name":"kept",
+		"ttl_friend":[{"uid":"0xb1"}]}]}}`, res)
+	res, err = runQuery(`schema(pred: [ttl_token]) { ttl }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"schema":[{"predicate":"ttl_token","ttl":"2s"}]}}`, res)
+
+	time.Sleep(3 * time.Second)
+	res, err = runQuery(q)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"ttl_name":"kept"}]}}`, res)
+
+	// The purge removes the index entries too.
+	for i := 0; ; i++ {
+		res, err = runQuery(`{ me(func: eq(ttl_token, "abc")) { uid } }`)
+		require.NoError(t, err)
+		if !strings.Contains(res, "0xb0") {
+			break
+		}
+		require.True(t, i < 20, "Expired value still indexed: %s", res)
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+func TestIncrement(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`
+		likes: int @index(int) .
+		rating: float .
+		nick: string .
+	`))
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0xd0> <likes> "10" .
+				<0xd0> <rating> "1.5" .
+			}
+		}
+	`))
+
+	s := &edgraph.Server{}
+	inc := func(pred string, v *api.Value, startTs uint64) (*api.TxnContext, error) {
+		resp, err := s.Mutate(defaultContext(), &api.Mutation{
+			Set: []*api.NQuad{{
+				Subject:     "0xd0",
+				Predicate:   pred,
+				ObjectValue: v,
+				Inc:         true,
+			}},
+			StartTs: startTs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Context, nil
+	}
+	intVal := &api.Value{Val: &api.Value_IntVal{IntVal: 1}}
+
+	// Open all txns before committing any, so that they overlap.
+	var txns []*api.TxnContext
+	for i := 0; i < 5; i++ {
+		tc, err := inc("likes", intVal, 0)
+		require.NoError(t, err)
+		txns = append(txns, tc)
+	}
+	tc, err := inc("rating", &api.Value{Val: &api.Value_DoubleVal{DoubleVal: 0.25}}, 0)
+	require.NoError(t, err)
+	txns = append(txns, tc)
+	for _, tc := range txns {
+		_, err := s.CommitOrAbort(defaultContext(), tc)
+		require.NoError(t, err)
+	}
+
+	res, err := runQuery(`{ me(func: uid(0xd0)) { likes rating } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"likes":15,"rating":1.75}]}}`, res)
+	// The index follows the increments.
+	res, err = runQuery(`{
+		me(func: eq(likes, 15)) { uid }
+		old(func: eq(likes, [10, 11, 12, 13, 14])) { uid }
+	}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"uid":"0xd0"}], "old":[]}}`, res)
+
+	// A set still conflicts with an increment committed after its start.
+	set, err := s.Mutate(defaultContext(), &api.Mutation{
+		SetNquads: []byte(`<0xd0> <likes> "0" .`),
+	})
+	require.NoError(t, err)
+	tc, err = inc("likes", intVal, 0)
+	require.NoError(t, err)
+	_, err = s.CommitOrAbort(defaultContext(), tc)
+	require.NoError(t, err)
+	_, err = s.CommitOrAbort(defaultContext(), set.Context)
+	require.Error(t, err)
+
+	_, err = inc("nick", intVal, 0)
+	require.Error(t, err)
+	res, err = runQuery(`{ me(func: eq(likes, 16)) { likes } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"likes":16}]}}`, res)
+}
+
+func TestDeleteByQuery(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`expires: int @index(int) .`))
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0xe0> <expires> "1" .
+				<0xe0> <name> "a" .
+				<0xe1> <expires> "2" .
+				<0xe1> <name> "b" .
+				<0xe2> <expires> "3" .
+				<0xe2> <name> "c" .
+				<0xe3> <expires> "20" .
+				<0xe3> <name> "d" .
+			}
+		}
+	`))
+
+	var progress []api.DeleteProgress
+	send := func(p *api.DeleteProgress) error {
+		progress = append(progress, *p)
+		return nil
+	}
+	req := &api.DeleteByQueryRequest{
+		Query:   `{ v as var(func: lt(expires, 10)) }`,
+		Deletes: []*api.VarDelete{{Var: "v"}},
+		DryRun:  true,
+	}
+	s := &edgraph.Server{}
+	require.NoError(t, s.BulkDelete(defaultContext(), req, send))
+	require.Equal(t, []api.DeleteProgress{{Matched: 3, Done: true}}, progress)
+
+	q := `{ me(func: uid(0xe0, 0xe1, 0xe2, 0xe3)) { name expires } }`
+	res, err := runQuery(q)
+	require.NoError(t, err)
+	require.Contains(t, res, `"name":"a"`)
+
+	progress = nil
+	req.DryRun = false
+	req.BatchSize = 2
+	require.NoError(t, s.BulkDelete(defaultContext(), req, send))
+	require.Equal(t, []api.DeleteProgress{
+		{Matched: 3},
+		{Matched: 3, Deleted: 2, Batches: 1},
+		{Matched: 3, Deleted: 3, Batches: 2, Done: true},
+	}, progress)
+	res, err = runQuery(q)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"name":"d","expires":20}]}}`, res)
+
+	// S P * deletes only the listed predicates.
+	progress = nil
+	req = &api.DeleteByQueryRequest{
+		Query:   `{ v as var(func: ge(expires, 10)) }`,
+		Deletes: []*api.VarDelete{{Var: "v", Predicates: []string{"expires"}}},
+	}
+	require.NoError(t, s.BulkDelete(defaultContext(), req, send))
+	require.Equal(t, api.DeleteProgress{Matched: 1, Deleted: 1, Batches: 1, Done: true},
+		progress[len(progress)-1])
+	res, err = runQuery(q)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"name":"d"}]}}`, res)
+
+	req.Deletes[0].Var = "w"
+	require.Error(t, s.BulkDelete(defaultContext(), req, send))
+}
+
+func TestDeleteIncoming(t *testing.T) {
+	schema.ParseBytes([]byte(""), 1)
+	require.NoError(t, alterSchemaWithRetry(`
+		follows: uid @reverse .
+		blocks: uid @count .
+	`))
+	require.NoError(t, runMutation(`
+		{
+			set {
+				<0xc0> <name> "gone" .
+				<0xc0> <follows> <0xc3> .
+				<0xc1> <follows> <0xc0> .
+				<0xc1> <follows> <0xc3> .
+				<0xc2> <blocks> <0xc0> .
+				<0xc4> <blocks> <0xc5> .
+			}
+		}
+	`))
+
+	s := &edgraph.Server{}
+	_, err := s.Mutate(defaultContext(), &api.Mutation{
+		DeleteJson:     []byte(`{"uid": "0xc0"}`),
+		CommitNow:      true,
+		DeleteIncoming: true,
+	})
+	require.NoError(t, err)
+	resp, err := s.Mutate(defaultContext(), &api.Mutation{
+		DelNquads:      []byte(`<0xc5> * * .`),
+		CommitNow:      true,
+		DeleteIncoming: true,
+	})
+	require.NoError(t, err)
+	// blocks has no @reverse, so it had to be scanned, unlike follows.
+	warnings := strings.Join(resp.Warnings, "\n")
+	require.Contains(t, warnings, "Predicate blocks has")
+	require.NotContains(t, warnings, "Predicate follows has")
+
+	res, err := runQuery(`
+	{
+		me(func: uid(0xc0, 0xc1, 0xc2, 0xc4)) {
+			uid
+			name
+			follows { uid }
+			blocks { uid }
+		}
+		rev(func: uid(0xc3)) { ~follows { uid } }
+		cnt(func: eq(count(blocks), 1)) { uid }
+	}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {
+		"me":[{"uid":"0xc0"},{"uid":"0xc1","follows":[{"uid":"0xc3"}]},{"uid":"0xc2"},
+			{"uid":"0xc4"}],
+		"rev":[{"~follows":[{"uid":"0xc1"}]}],
+		"cnt":[]}}`, res)
+}
+
 func TestDeleteAllSP2(t *testing.T) {
 	var m = `
 	{
@@ -1350,6 +1725,61 @@ func TestIllegalCountInQueryFn(t *testing.T) {
 	require.Contains(t, err.Error(), "zero")
 }
 
+func TestReadOnlyQuery(t *testing.T) {
+	require.NoError(t, runMutation(`{ set { <0x4001> <readonly> "one" . } }`))
+
+	q := `{ me(func: uid(0x4001)) { readonly } }`
+	s := &edgraph.Server{}
+	ctx := defaultContext()
+	resp, err := s.Query(ctx, &api.Request{Query: q, ReadOnly: true})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"me":[{"readonly":"one"}]}`, string(resp.Json))
+	require.True(t, resp.Txn.ReadOnly)
+	readTs := resp.Txn.StartTs
+
+	// Without any commits in between, the timestamp gets reused.
+	resp, err = s.Query(ctx, &api.Request{Query: q, ReadOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, readTs, resp.Txn.StartTs)
+
+	_, err = s.Mutate(ctx, &api.Mutation{
+		StartTs:   readTs,
+		SetNquads: []byte(`<0x4001> <readonly> "two" .`),
+	})
+	require.Error(t, err)
+
+	require.NoError(t, runMutation(`{ set { <0x4001> <readonly> "two" . } }`))
+	resp, err = s.Query(ctx, &api.Request{Query: q, ReadOnly: true})
+	require.NoError(t, err)
+	require.True(t, resp.Txn.StartTs > readTs)
+	require.JSONEq(t, `{"me":[{"readonly":"two"}]}`, string(resp.Json))
+
+	// Other servers don't know the timestamp, but reject the mutations of marked txns.
+	_, err = s.Mutate(ctx, &api.Mutation{
+		ReadOnly:  true,
+		SetNquads: []byte(`<0x4001> <readonly> "three" .`),
+	})
+	require.Error(t, err)
+	_, err = s.CommitOrAbort(ctx, &api.TxnContext{StartTs: readTs + 1, ReadOnly: true})
+	require.Error(t, err)
+
+	// Best effort reads are at the latest commit this server heard of, which isn't any txn's
+	// start.
+	resp, err = s.Query(ctx, &api.Request{Query: q, BestEffort: true})
+	require.NoError(t, err)
+	require.True(t, resp.Txn.BestEffort)
+	require.NotZero(t, resp.Txn.StartTs)
+	require.True(t, resp.Txn.StartTs <= posting.Oracle().MaxAssigned())
+	_, err = s.Mutate(ctx, &api.Mutation{
+		StartTs:   resp.Txn.StartTs,
+		SetNquads: []byte(`<0x4001> <readonly> "three" .`),
+	})
+	require.Error(t, err)
+
+	_, err = s.Query(ctx, &api.Request{Query: q, ReadOnly: true, BestEffort: true})
+	require.Error(t, err)
+}
+
 func TestMain(m *testing.M) {
 	dc := edgraph.DefaultConfig
 	dc.AllottedMemory = 2048.0
@@ -69,7 +69,7 @@ func shareHandler(w http.ResponseWriter, r *http.Request) {
 		Set:       NewSharedQueryNQuads(rawQuery),
 		CommitNow: true,
 	}
-	resp, err := (&edgraph.Server{}).Mutate(context.Background(), mu)
+	resp, err := (&edgraph.Server{}).Mutate(withClient(ctx, r), mu)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
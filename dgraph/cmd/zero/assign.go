@@ -30,6 +30,7 @@ import (
 var (
 	emptyNum         intern.Num
 	emptyAssignedIds api.AssignedIds
+	emptyPayload     api.Payload
 )
 
 const (
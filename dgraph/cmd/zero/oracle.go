@@ -20,6 +20,8 @@ package zero
 import (
 	"errors"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/api"
@@ -33,6 +35,14 @@ type syncMark struct {
 	ts    uint64
 }
 
+const (
+	// How often the leader records the latest timestamp handed out.
+	tsMarkInterval = time.Second
+	// The marks kept in the membership state. Once there are more, the older ones are thinned
+	// out, so that the history keeps going back as far, less precisely.
+	maxTsMarks = 1 << 16
+)
+
 type Oracle struct {
 	x.SafeMutex
 	commits map[uint64]uint64 // start -> commit
@@ -48,6 +58,7 @@ type Oracle struct {
 	updates     chan *intern.OracleDelta
 	doneUntil   x.WaterMark
 	syncMarks   []syncMark
+	lastTs      uint64 // The latest timestamp handed out, for the next mark.
 }
 
 func (o *Oracle) Init() {
@@ -73,6 +84,10 @@ func (o *Oracle) hasConflict(src *api.TxnContext) bool {
 		return true
 	}
 	for _, k := range src.Keys {
+		if strings.HasPrefix(k, x.IncrementKeyPrefix) {
+			// Increments commute with each other and apply on top of whatever was committed.
+			continue
+		}
 		if last := o.rowCommit[k]; last > src.StartTs {
 			return true
 		}
@@ -115,6 +130,7 @@ func (o *Oracle) commit(src *api.TxnContext) error {
 		return errConflict
 	}
 	for _, k := range src.Keys {
+		k = strings.TrimPrefix(k, x.IncrementKeyPrefix)
 		o.rowCommit[k] = src.CommitTs // CommitTs is handed out before calling this func.
 	}
 	return nil
@@ -256,6 +272,67 @@ func (o *Oracle) storePending(ids *api.AssignedIds) {
 	}
 }
 
+func (o *Oracle) recordTs(ts uint64) {
+	o.Lock()
+	defer o.Unlock()
+	if o.lastTs < ts {
+		o.lastTs = ts
+	}
+}
+
+func (o *Oracle) latestTs() uint64 {
+	o.RLock()
+	defer o.RUnlock()
+	return o.lastTs
+}
+
+// markTimestamps records in the membership state the latest timestamp handed out by the
+// leader, every tsMarkInterval, so that every Zero can map time to timestamps, also after
+// the leader changes.
+func (s *Server) markTimestamps() {
+	ticker := time.NewTicker(tsMarkInterval)
+	defer ticker.Stop()
+
+	for {
+		<-ticker.C
+		if !s.Node.AmLeader() {
+			continue
+		}
+		ts := s.orc.latestTs()
+		if ts == 0 || ts <= s.lastTsMark() {
+			continue
+		}
+		p := &intern.ZeroProposal{TsMark: &intern.TsMark{Unix: time.Now().Unix(), Ts: ts}}
+		if err := s.Node.proposeAndWait(context.Background(), p); err != nil {
+			x.Printf("Error while recording the latest timestamp: %v\n", err)
+		}
+	}
+}
+
+func (s *Server) lastTsMark() uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	if n := len(s.state.TsMarks); n > 0 {
+		return s.state.TsMarks[n-1].Ts
+	}
+	return 0
+}
+
+// tsAt returns the latest timestamp handed out at or before the given Unix time, or zero
+// if the history doesn't go back that far.
+func (s *Server) tsAt(unix int64) uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	marks := s.state.TsMarks
+	idx := sort.Search(len(marks), func(i int) bool {
+		return marks[i].Unix > unix
+	})
+	if idx == 0 {
+		return 0
+	}
+	return marks[idx-1].Ts
+}
+
 var errConflict = errors.New("Transaction conflict")
 
 func (s *Server) proposeTxn(ctx context.Context, src *api.TxnContext) error {
@@ -416,7 +493,18 @@ func (s *Server) Timestamps(ctx context.Context, num *intern.Num) (*api.Assigned
 	reply, err := s.lease(ctx, num, true)
 	if err == nil {
 		s.orc.doneUntil.Done(reply.EndId)
+		s.orc.recordTs(reply.EndId)
 		go s.orc.storePending(reply)
 	}
 	return reply, err
 }
+
+// TimestampAt maps a Unix time to the latest timestamp handed out at or before it. The history
+// is kept in the membership state, so any Zero can answer, up to tsMarkInterval behind the
+// leader.
+func (s *Server) TimestampAt(ctx context.Context, num *intern.Num) (*intern.Num, error) {
+	if ctx.Err() != nil {
+		return &emptyNum, ctx.Err()
+	}
+	return &intern.Num{Val: s.tsAt(int64(num.Val))}, nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+func TestTsMarks(t *testing.T) {
+	s := testServer(t)
+	require.Equal(t, uint64(0), s.tsAt(100))
+
+	applyTsMark(s.state, &intern.TsMark{Unix: 100, Ts: 10})
+	applyTsMark(s.state, &intern.TsMark{Unix: 100, Ts: 12})
+	applyTsMark(s.state, &intern.TsMark{Unix: 102, Ts: 20})
+	// Marks which don't move forward are dropped.
+	applyTsMark(s.state, &intern.TsMark{Unix: 103, Ts: 20})
+	applyTsMark(s.state, &intern.TsMark{Unix: 101, Ts: 30})
+	require.Len(t, s.state.TsMarks, 2)
+	require.Equal(t, uint64(0), s.tsAt(99))
+	require.Equal(t, uint64(12), s.tsAt(100))
+	require.Equal(t, uint64(12), s.tsAt(101))
+	require.Equal(t, uint64(20), s.tsAt(200))
+	// Other nodes don't get the marks.
+	require.Empty(t, s.membershipState().TsMarks)
+	require.Len(t, s.state.TsMarks, 2)
+
+	// A full history is thinned out, but goes back as far.
+	for i := 2; i < maxTsMarks; i++ {
+		applyTsMark(s.state, &intern.TsMark{Unix: int64(100 + 2*i), Ts: uint64(10*i + 5)})
+	}
+	require.Len(t, s.state.TsMarks, maxTsMarks)
+	applyTsMark(s.state, &intern.TsMark{Unix: 100 + 2*maxTsMarks, Ts: 10 * maxTsMarks})
+	require.Len(t, s.state.TsMarks, maxTsMarks*3/4+1)
+	require.Equal(t, uint64(0), s.tsAt(99))
+	require.Equal(t, uint64(12), s.tsAt(102))
+	require.Equal(t, uint64(10*maxTsMarks), s.tsAt(100+2*maxTsMarks))
+}
@@ -0,0 +1,321 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package zero
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	moveRunning = "running"
+	moveDone    = "done"
+	moveFailed  = "failed"
+
+	// The number of moves kept in the history.
+	maxMoveHistory = 100
+)
+
+// newMove returns the record of moving the predicate from src to dst, unless it can't be moved.
+func (s *Server) newMove(predicate string, src, dst uint32,
+	manual bool) (*intern.TabletMove, error) {
+	s.Lock()
+	defer s.Unlock()
+	tab := s.servingTablet(predicate)
+	switch {
+	case tab == nil:
+		return nil, x.Errorf("Predicate %s isn't served by any group", predicate)
+	case tab.ReadOnly || s.runningMove(predicate) != nil:
+		return nil, x.Errorf("Predicate %s is being moved, please retry later", predicate)
+	case len(tab.Splits) > 0:
+		return nil, x.Errorf("Predicate %s is split, it can't be moved", predicate)
+	case tab.GroupId != src:
+		return nil, x.Errorf("Predicate %s is served by group %d, not %d", predicate,
+			tab.GroupId, src)
+	case src == dst:
+		return nil, x.Errorf("Predicate %s is already served by group %d", predicate, dst)
+	}
+	return s.nextMove(predicate, src, dst, manual), nil
+}
+
+// nextMove returns a new record of a move, which this Zero is going to run.
+func (s *Server) nextMove(predicate string, src, dst uint32, manual bool) *intern.TabletMove {
+	s.AssertLock()
+	// Ids go on from the moves recorded by the previous leaders.
+	for _, m := range s.state.Moves {
+		if m.Id > s.nextMoveId {
+			s.nextMoveId = m.Id
+		}
+	}
+	s.nextMoveId++
+	s.moveRun(s.nextMoveId)
+	return &intern.TabletMove{
+		Id:        s.nextMoveId,
+		Predicate: predicate,
+		SrcGroup:  src,
+		DstGroup:  dst,
+		Manual:    manual,
+		Status:    moveRunning,
+		StartedAt: time.Now().Unix(),
+	}
+}
+
+// runningMove returns the running move of the predicate in the history, if any.
+func (s *Server) runningMove(predicate string) *intern.TabletMove {
+	s.AssertRLock()
+	for _, m := range s.state.Moves {
+		if m.Predicate == predicate && m.Status == moveRunning {
+			return m
+		}
+	}
+	return nil
+}
+
+// startMove records in the membership state that the predicate is moving from src to dst,
+// unless it can't be moved, so that the history of moves outlives this leader.
+func (s *Server) startMove(ctx context.Context, predicate string, src, dst uint32,
+	manual bool) (*intern.TabletMove, error) {
+	m, err := s.newMove(predicate, src, dst, manual)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Node.proposeAndWait(ctx, &intern.ZeroProposal{TabletMove: m}); err != nil {
+		s.moveStopped(m)
+		return nil, err
+	}
+	return m, nil
+}
+
+// resumeMove returns the move of a tablet which a previous leader started, for this Zero to
+// resume, unless it's already doing so. A move missing from the history is recorded again.
+func (s *Server) resumeMove(ctx context.Context, tab *intern.Tablet) (*intern.TabletMove,
+	error) {
+	s.Lock()
+	m := s.runningMove(tab.Predicate)
+	if m != nil && s.movesRun[m.Id] {
+		s.Unlock()
+		return nil, nil
+	}
+	if m != nil && m.DstGroup == tab.Move.DstGroup {
+		s.moveRun(m.Id)
+		resumed := *m
+		s.Unlock()
+		return &resumed, nil
+	}
+	m = s.nextMove(tab.Predicate, tab.GroupId, tab.Move.DstGroup, false)
+	s.Unlock()
+	if err := s.Node.proposeAndWait(ctx, &intern.ZeroProposal{TabletMove: m}); err != nil {
+		s.moveStopped(m)
+		return nil, err
+	}
+	return m, nil
+}
+
+// moveRun records that this Zero runs the move.
+func (s *Server) moveRun(id uint64) {
+	s.AssertLock()
+	if s.movesRun == nil {
+		s.movesRun = make(map[uint64]bool)
+	}
+	s.movesRun[id] = true
+}
+
+// moveStopped records that this Zero isn't running the move anymore.
+func (s *Server) moveStopped(m *intern.TabletMove) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.movesRun, m.Id)
+}
+
+// movesRunning tells whether any tablet is being moved.
+func (s *Server) movesRunning() bool {
+	s.RLock()
+	defer s.RUnlock()
+	for _, m := range s.state.Moves {
+		if m.Status == moveRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// moveContext returns the context of the moves run by this Zero as the leader. It's
+// cancelled by stopMoves.
+func (s *Server) moveContext() context.Context {
+	s.Lock()
+	defer s.Unlock()
+	if s.moveCtx == nil {
+		s.moveCtx, s.cancelMoves = context.WithCancel(context.Background())
+	}
+	return s.moveCtx
+}
+
+// stopMoves cancels the moves run by this Zero, once it isn't the leader anymore. The tablets
+// stay read-only, and the next leader resumes their moves.
+func (s *Server) stopMoves() {
+	s.Lock()
+	defer s.Unlock()
+	if s.cancelMoves != nil {
+		s.cancelMoves()
+		s.moveCtx, s.cancelMoves = nil, nil
+	}
+}
+
+// moveProgress returns a copy of the progress of moving the predicate, or nil if it isn't
+// being moved.
+func (s *Server) moveProgress(predicate string) *intern.MoveProgress {
+	s.RLock()
+	defer s.RUnlock()
+	tab := s.servingTablet(predicate)
+	if tab == nil || tab.Move == nil {
+		return nil
+	}
+	move := *tab.Move
+	return &move
+}
+
+// runMove moves the tablet, and records how it went. If this Zero stopped being the leader,
+// the move stays running in the history, for the next leader to resume.
+func (s *Server) runMove(ctx context.Context, m *intern.TabletMove) error {
+	defer s.moveStopped(m)
+	x.Printf("Going to move predicate %v from %d to %d\n", m.Predicate, m.SrcGroup, m.DstGroup)
+	err := s.moveTablet(ctx, m.Predicate, m.SrcGroup, m.DstGroup)
+	if err != nil {
+		x.Printf("Error while trying to move predicate %v from %d to %d: %v\n",
+			m.Predicate, m.SrcGroup, m.DstGroup, err)
+		if !s.Node.AmLeader() {
+			return err
+		}
+	}
+
+	done := *m
+	done.FinishedAt = time.Now().Unix()
+	if err != nil {
+		done.Status = moveFailed
+		done.Error = err.Error()
+	} else {
+		done.Status = moveDone
+	}
+	p := &intern.ZeroProposal{TabletMove: &done}
+	if perr := s.Node.proposeAndWait(context.Background(), p); perr != nil {
+		x.Printf("Error while recording the move of predicate %v: %v\n", m.Predicate, perr)
+	}
+	return err
+}
+
+// tabletMoves returns a copy of the latest moves, the latest first. With a predicate, only the
+// moves of that predicate are returned.
+func (s *Server) tabletMoves(predicate string) *intern.TabletMoves {
+	s.RLock()
+	defer s.RUnlock()
+	out := &intern.TabletMoves{}
+	if s.state == nil {
+		return out
+	}
+	for i := len(s.state.Moves) - 1; i >= 0; i-- {
+		if predicate != "" && s.state.Moves[i].Predicate != predicate {
+			continue
+		}
+		m := *s.state.Moves[i]
+		out.Moves = append(out.Moves, &m)
+	}
+	return out
+}
+
+// MoveTablet starts moving a predicate to another group. Pinned predicates can be moved too,
+// and stay pinned.
+func (s *Server) MoveTablet(ctx context.Context,
+	in *intern.MoveTabletRequest) (*intern.TabletMove, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if !s.Node.AmLeader() {
+		return nil, x.Errorf("Only leader can move predicates")
+	}
+	if len(in.Predicate) == 0 {
+		return nil, errEmptyPredicate
+	}
+	stab := s.ServingTablet(in.Predicate)
+	if stab == nil {
+		return nil, x.Errorf("Predicate %s isn't served by any group", in.Predicate)
+	}
+	if s.Leader(in.GroupId) == nil {
+		return nil, x.Errorf("No healthy connection found to leader of group %d", in.GroupId)
+	}
+	m, err := s.startMove(ctx, in.Predicate, stab.GroupId, in.GroupId, true)
+	if err != nil {
+		return nil, err
+	}
+	out := *m
+	go s.runMove(s.moveContext(), m)
+	return &out, nil
+}
+
+// PinTablet pins a predicate to the group serving it, so that the rebalancer doesn't move it,
+// or unpins it.
+func (s *Server) PinTablet(ctx context.Context, in *intern.TabletPin) (*api.Payload, error) {
+	if ctx.Err() != nil {
+		return &emptyPayload, ctx.Err()
+	}
+	if len(in.Predicate) == 0 {
+		return &emptyPayload, errEmptyPredicate
+	}
+	if s.ServingTablet(in.Predicate) == nil {
+		return &emptyPayload, x.Errorf("Predicate %s isn't served by any group", in.Predicate)
+	}
+	err := s.Node.proposeAndWait(ctx, &intern.ZeroProposal{Pin: in})
+	return &emptyPayload, err
+}
+
+// GetTabletMoves returns the latest moves of tablets, which every Zero knows of.
+func (s *Server) GetTabletMoves(ctx context.Context,
+	in *api.Payload) (*intern.TabletMoves, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return s.tabletMoves(""), nil
+}
+
+// ReportMoveProgress records that the destination group of a move has written the keys up
+// to in.LastKey.
+func (s *Server) ReportMoveProgress(ctx context.Context,
+	in *intern.MoveProgress) (*api.Payload, error) {
+	if ctx.Err() != nil {
+		return &emptyPayload, ctx.Err()
+	}
+	// Groups also report the keys they get while a predicate is split, which isn't tracked.
+	if move := s.moveProgress(in.Predicate); move == nil || move.DstGroup != in.DstGroup {
+		return &emptyPayload, nil
+	}
+	p := &intern.MoveProgress{
+		Predicate: in.Predicate,
+		DstGroup:  in.DstGroup,
+		LastKey:   in.LastKey,
+		Keys:      in.Keys,
+		Bytes:     in.Bytes,
+		UpdatedAt: time.Now().Unix(),
+		Attempt:   in.Attempt,
+	}
+	err := s.Node.proposeAndWait(ctx, &intern.ZeroProposal{MoveProgress: p})
+	return &emptyPayload, err
+}
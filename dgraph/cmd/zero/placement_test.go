@@ -0,0 +1,219 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package zero
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+func testServer(t *testing.T) *Server {
+	groups := testGroups()
+	for gid, group := range groups {
+		group.Members = map[uint64]*intern.Member{
+			uint64(gid): {Id: uint64(gid), GroupId: gid, Leader: true},
+		}
+	}
+	policy, err := newRebalancePolicy("size", 0.1, time.Hour, time.Minute)
+	require.NoError(t, err)
+	return &Server{state: &intern.MembershipState{Groups: groups}, rebalance: policy}
+}
+
+// recordMove records a move as the leader would, without proposing it.
+func recordMove(s *Server, predicate string, src, dst uint32,
+	manual bool) (*intern.TabletMove, error) {
+	m, err := s.newMove(predicate, src, dst, manual)
+	if err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	return m, applyTabletMove(s.state, m)
+}
+
+// finishMove records that the move is done, as runMove would.
+func finishMove(t *testing.T, s *Server, m *intern.TabletMove) {
+	done := *m
+	done.Status = moveDone
+	s.Lock()
+	defer s.Unlock()
+	require.NoError(t, applyTabletMove(s.state, &done))
+	delete(s.movesRun, m.Id)
+}
+
+func TestStartMove(t *testing.T) {
+	s := testServer(t)
+	_, err := recordMove(s, "name", 2, 1, true)
+	require.Error(t, err)
+	_, err = recordMove(s, "name", 1, 1, true)
+	require.Error(t, err)
+	_, err = recordMove(s, "missing", 1, 2, true)
+	require.Error(t, err)
+
+	m, err := recordMove(s, "name", 1, 2, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), m.Id)
+	require.Equal(t, moveRunning, m.Status)
+	// A predicate moves once at a time.
+	_, err = recordMove(s, "name", 1, 2, false)
+	require.Error(t, err)
+	s.Lock()
+	require.Equal(t, errMoveRunning, applyTabletMove(s.state,
+		&intern.TabletMove{Id: 9, Predicate: "name", Status: moveRunning}))
+	s.Unlock()
+
+	finishMove(t, s, m)
+	_, err = recordMove(s, "age", 1, 2, false)
+	require.NoError(t, err)
+	moves := s.tabletMoves("")
+	require.Len(t, moves.Moves, 2)
+	require.Equal(t, "age", moves.Moves[0].Predicate)
+	require.Equal(t, uint64(2), moves.Moves[0].Id)
+	require.Equal(t, "name", s.tabletMoves("name").Moves[0].Predicate)
+	// The moves are copies.
+	moves.Moves[1].Status = moveFailed
+	require.Equal(t, moveDone, s.tabletMoves("name").Moves[0].Status)
+
+	// A new leader goes on from the ids in the history.
+	s.nextMoveId = 0
+	for i := 0; i < maxMoveHistory; i++ {
+		finishMove(t, s, s.state.Moves[len(s.state.Moves)-1])
+		m, err := recordMove(s, "hot", 1, 2, false)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+3), m.Id)
+	}
+	require.Len(t, s.state.Moves, maxMoveHistory)
+	require.Empty(t, s.tabletMoves("name").Moves)
+}
+
+func TestPlanRebalanceSkipsPinned(t *testing.T) {
+	s := testServer(t)
+	require.Equal(t, []string{"age", "hot"}, predicates(s.planRebalance(10)))
+
+	s.state.Groups[1].Tablets["age"].Pinned = true
+	require.Equal(t, []string{"hot"}, predicates(s.planRebalance(10)))
+
+	// Predicates moved recently stay put.
+	_, err := recordMove(s, "hot", 1, 2, true)
+	require.NoError(t, err)
+	require.Empty(t, s.planRebalance(10).Moves)
+}
+
+func TestApplyMoveProgress(t *testing.T) {
+	tab := &intern.Tablet{GroupId: 1, Predicate: "name", ReadOnly: true,
+		Move: &intern.MoveProgress{Predicate: "name", DstGroup: 2}}
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2, Attempts: 1, UpdatedAt: 10})
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2, LastKey: []byte("b"), Keys: 3,
+		Bytes: 300, UpdatedAt: 20, Attempt: 1})
+	// A late report of the same attempt doesn't move the checkpoint back.
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2, LastKey: []byte("a"), Keys: 1,
+		Bytes: 100, UpdatedAt: 15, Attempt: 1})
+	// Reports for another group are dropped.
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 3, LastKey: []byte("c"), Keys: 1,
+		Attempt: 1})
+	require.Equal(t, &intern.MoveProgress{Predicate: "name", DstGroup: 2, LastKey: []byte("b"),
+		Keys: 3, Bytes: 300, UpdatedAt: 20, Attempts: 1}, tab.Move)
+
+	// Once resumed, the stopped attempt's reports aren't counted.
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2, Attempts: 1, UpdatedAt: 30})
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2, LastKey: []byte("d"), Keys: 2,
+		Bytes: 200, Attempt: 1})
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2, LastKey: []byte("c"), Keys: 1,
+		Bytes: 100, Attempt: 2})
+	require.Equal(t, &intern.MoveProgress{Predicate: "name", DstGroup: 2, LastKey: []byte("c"),
+		Keys: 4, Bytes: 400, UpdatedAt: 30, Attempts: 2}, tab.Move)
+
+	applyMoveProgress(nil, &intern.MoveProgress{DstGroup: 2})
+	tab.Move = nil
+	applyMoveProgress(tab, &intern.MoveProgress{DstGroup: 2})
+	require.Nil(t, tab.Move)
+}
+
+func TestRecoveryResumesMoves(t *testing.T) {
+	s := testServer(t)
+	s.state.Groups[1].Tablets["name"].ReadOnly = true
+	s.state.Groups[1].Tablets["name"].Move = &intern.MoveProgress{Predicate: "name",
+		DstGroup: 2, LastKey: []byte("b")}
+	s.state.Groups[1].Tablets["age"].ReadOnly = true
+	// A split which didn't finish is resumed too.
+	s.state.Groups[1].Tablets["hot"].ReadOnly = true
+	s.state.Groups[1].Tablets["hot"].Splits = []*intern.TabletSplit{{StartUid: 10, GroupId: 2}}
+
+	proposals, resumes := s.recoveryProposals()
+	// Tablets read-only for other reasons are made writable again.
+	require.Len(t, proposals, 1)
+	require.Equal(t, "age", proposals[0].Tablet.Predicate)
+	require.False(t, proposals[0].Tablet.ReadOnly)
+	require.Len(t, resumes, 2)
+	sort.Slice(resumes, func(i, j int) bool { return resumes[i].Predicate < resumes[j].Predicate })
+	require.Equal(t, "hot", resumes[0].Predicate)
+	require.Equal(t, "name", resumes[1].Predicate)
+	resumes = resumes[1:]
+
+	// The previous leader's record of the move is resumed, and keeps whether it was manual.
+	s.state.Moves = []*intern.TabletMove{{Id: 7, Predicate: "name", SrcGroup: 1, DstGroup: 2,
+		Manual: true, Status: moveRunning}}
+	m, err := s.resumeMove(context.Background(), resumes[0])
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	require.Equal(t, uint64(7), m.Id)
+	require.Equal(t, uint32(1), m.SrcGroup)
+	require.Equal(t, uint32(2), m.DstGroup)
+	require.True(t, m.Manual)
+	// The move is only resumed once.
+	m, err = s.resumeMove(context.Background(), resumes[0])
+	require.NoError(t, err)
+	require.Nil(t, m)
+	require.True(t, s.movesRunning())
+	require.Equal(t, []byte("b"), s.moveProgress("name").LastKey)
+	require.Nil(t, s.moveProgress("age"))
+}
+
+func TestRecoveryFinishesMoves(t *testing.T) {
+	s := testServer(t)
+	s.state.Groups[1].Tablets["name"].ReadOnly = true
+	s.state.Groups[1].Tablets["name"].Move = &intern.MoveProgress{Predicate: "name",
+		DstGroup: 2}
+	s.state.Moves = []*intern.TabletMove{
+		{Id: 1, Predicate: "name", SrcGroup: 1, DstGroup: 2, Status: moveRunning},
+		{Id: 2, Predicate: "age", SrcGroup: 1, DstGroup: 2, Status: moveRunning},
+		{Id: 3, Predicate: "hot", SrcGroup: 2, DstGroup: 1, Status: moveRunning},
+	}
+	s.Lock()
+	s.moveRun(3)
+	s.Unlock()
+
+	// The move of name still runs, and this Zero runs the move of hot.
+	proposals, _ := s.recoveryProposals()
+	require.Len(t, proposals, 1)
+	require.Equal(t, uint64(2), proposals[0].TabletMove.Id)
+	require.Equal(t, moveFailed, proposals[0].TabletMove.Status)
+
+	// A move which got as far as switching the tablet is done.
+	s.state.Groups[2].Tablets["age"] = &intern.Tablet{GroupId: 2, Predicate: "age"}
+	delete(s.state.Groups[1].Tablets, "age")
+	proposals, _ = s.recoveryProposals()
+	require.Len(t, proposals, 1)
+	require.Equal(t, moveDone, proposals[0].TabletMove.Status)
+}
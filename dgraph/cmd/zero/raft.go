@@ -18,6 +18,7 @@
 package zero
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"math/rand"
@@ -223,6 +224,8 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *intern.ZeroProposal
 var (
 	errInvalidProposal     = errors.New("Invalid group proposal")
 	errTabletAlreadyServed = errors.New("Tablet is already being served")
+	errMoveRunning         = errors.New("Tablet is already being moved")
+	errTabletNotServed     = errors.New("Tablet isn't served by any group")
 )
 
 func newGroup() *intern.Group {
@@ -232,6 +235,118 @@ func newGroup() *intern.Group {
 	}
 }
 
+func (n *node) applyTablet(tablet *intern.Tablet) error {
+	n.server.AssertLock()
+	state := n.server.state
+	if tablet.GroupId == 0 {
+		return errInvalidProposal
+	}
+	group := state.Groups[tablet.GroupId]
+	if tablet.Remove {
+		if group != nil {
+			delete(group.Tablets, tablet.Predicate)
+		}
+		return nil
+	}
+	if group == nil {
+		group = newGroup()
+		state.Groups[tablet.GroupId] = group
+	}
+
+	// There's a edge case that we're handling.
+	// Two servers ask to serve the same tablet, then we need to ensure that
+	// only the first one succeeds.
+	if prev := n.server.servingTablet(tablet.Predicate); prev != nil {
+		if tablet.Force {
+			group := state.Groups[prev.GroupId]
+			delete(group.Tablets, tablet.Predicate)
+			// Forced updates don't come from the group, keep the load it reported.
+			tablet.ReadQps = prev.ReadQps
+			tablet.WriteQps = prev.WriteQps
+		} else {
+			if prev.GroupId != tablet.GroupId {
+				return errTabletAlreadyServed
+			}
+			// This update can come from tablet size.
+			tablet.ReadOnly = prev.ReadOnly
+			tablet.Splits = prev.Splits
+			tablet.Move = prev.Move
+		}
+		// Tablets are only unpinned through a pin proposal.
+		tablet.Pinned = tablet.Pinned || prev.Pinned
+	}
+	group.Tablets[tablet.Predicate] = tablet
+	return nil
+}
+
+// applyMoveProgress adds the attempts of a move, or the keys and bytes written by its latest
+// attempt, reported by p to the tablet, unless it's not being moved to p.DstGroup anymore.
+func applyMoveProgress(tablet *intern.Tablet, p *intern.MoveProgress) {
+	if tablet == nil || tablet.Move == nil || tablet.Move.DstGroup != p.DstGroup {
+		return
+	}
+	move := tablet.Move
+	move.Attempts += p.Attempts
+	// A report can come late from an attempt which was stopped, and the latest one resumed
+	// after the keys counted so far.
+	if p.Attempts == 0 && p.Attempt == move.Attempts &&
+		bytes.Compare(p.LastKey, move.LastKey) > 0 {
+		move.LastKey = p.LastKey
+		move.Keys += p.Keys
+		move.Bytes += p.Bytes
+	}
+	if p.UpdatedAt > move.UpdatedAt {
+		move.UpdatedAt = p.UpdatedAt
+	}
+}
+
+// applyTabletMove adds a move to the history, or updates it once it's finished. A tablet can't
+// start moving while it's moved already.
+func applyTabletMove(state *intern.MembershipState, m *intern.TabletMove) error {
+	for i, prev := range state.Moves {
+		if prev.Id == m.Id {
+			state.Moves[i] = m
+			return nil
+		}
+	}
+	for _, prev := range state.Moves {
+		if prev.Predicate == m.Predicate && prev.Status == moveRunning {
+			return errMoveRunning
+		}
+	}
+	state.Moves = append(state.Moves, m)
+	if len(state.Moves) > maxMoveHistory {
+		state.Moves = state.Moves[len(state.Moves)-maxMoveHistory:]
+	}
+	return nil
+}
+
+// applyTsMark adds a mark to the history of timestamps. Once the history is full, every other
+// mark of its older half is dropped, keeping the oldest one.
+func applyTsMark(state *intern.MembershipState, m *intern.TsMark) {
+	if n := len(state.TsMarks); n > 0 {
+		last := state.TsMarks[n-1]
+		if m.Ts <= last.Ts || m.Unix < last.Unix {
+			return
+		}
+		if m.Unix == last.Unix {
+			last.Ts = m.Ts
+			return
+		}
+	}
+	if len(state.TsMarks) >= maxTsMarks {
+		half := len(state.TsMarks) / 2
+		marks := state.TsMarks[:0]
+		for i, mark := range state.TsMarks {
+			if i >= half || i%2 == 0 {
+				marks = append(marks, mark)
+			}
+		}
+		state.TsMarks = marks
+	}
+	state.TsMarks = append(state.TsMarks, m)
+}
+
 func (n *node) applyProposal(e raftpb.Entry) (uint32, error) {
 	var p intern.ZeroProposal
 	// Raft commits empty entry on becoming a leader.
@@ -314,37 +429,35 @@ func (n *node) applyProposal(e raftpb.Entry) (uint32, error) {
 		}
 	}
 	if p.Tablet != nil {
-		if p.Tablet.GroupId == 0 {
-			return p.Id, errInvalidProposal
+		if err := n.applyTablet(p.Tablet); err != nil {
+			return p.Id, err
 		}
-		group := state.Groups[p.Tablet.GroupId]
-		if p.Tablet.Remove {
-			if group != nil {
-				delete(group.Tablets, p.Tablet.Predicate)
-			}
-			return p.Id, nil
+	}
+	for _, tablet := range p.Tablets {
+		if err := n.applyTablet(tablet); err != nil {
+			return p.Id, err
 		}
-		if group == nil {
-			group = newGroup()
-			state.Groups[p.Tablet.GroupId] = group
+	}
+	if p.Rebalance != nil {
+		state.RebalancePaused = p.Rebalance.Paused
+	}
+	if p.Pin != nil {
+		tablet := n.server.servingTablet(p.Pin.Predicate)
+		if tablet == nil {
+			return p.Id, errTabletNotServed
 		}
-
-		// There's a edge case that we're handling.
-		// Two servers ask to serve the same tablet, then we need to ensure that
-		// only the first one succeeds.
-		if tablet := n.server.servingTablet(p.Tablet.Predicate); tablet != nil {
-			if p.Tablet.Force {
-				group := state.Groups[tablet.GroupId]
-				delete(group.Tablets, p.Tablet.Predicate)
-			} else {
-				if tablet.GroupId != p.Tablet.GroupId {
-					return p.Id, errTabletAlreadyServed
-				}
-				// This update can come from tablet size.
-				p.Tablet.ReadOnly = tablet.ReadOnly
-			}
+		tablet.Pinned = p.Pin.Pinned
+	}
+	if p.MoveProgress != nil {
+		applyMoveProgress(n.server.servingTablet(p.MoveProgress.Predicate), p.MoveProgress)
+	}
+	if p.TabletMove != nil {
+		if err := applyTabletMove(state, p.TabletMove); err != nil {
+			return p.Id, err
 		}
-		group.Tablets[p.Tablet.Predicate] = p.Tablet
+	}
+	if p.TsMark != nil {
+		applyTsMark(state, p.TsMark)
 	}
 
 	if p.MaxLeaseId > state.MaxLeaseId {
@@ -461,6 +574,10 @@ func (n *node) trySnapshot() {
 	if idx <= si+1000 {
 		return
 	}
+	// The entries not in the WAL archive yet have to stay in the WAL.
+	if idx = n.Wal.Archived(0, idx); idx <= si {
+		return
+	}
 
 	data, err := n.server.MarshalMembershipState()
 	x.Check(err)
@@ -528,6 +645,9 @@ func (n *node) Run() {
 				}
 				n.Applied.Done(entry.Index)
 			}
+			if err := n.Wal.ArchiveApplied(0, rd.CommittedEntries); err != nil {
+				x.Printf("Error while archiving entries: %v\n", err)
+			}
 
 			// TODO: Should we move this to the top?
 			if rd.SoftState != nil {
@@ -0,0 +1,242 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package zero
+
+import (
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// rebalancePolicy decides when Zero moves tablets between groups. The cost of a tablet is its
+// share of the space taken by all the tablets, and its share of their reads and writes per
+// second, weighted by the policy. Tablets move from the costliest groups to the cheapest one.
+type rebalancePolicy struct {
+	name       string
+	sizeWeight float64
+	loadWeight float64
+	// Groups whose costs differ by less than this fraction of the costliest one are left alone.
+	threshold float64
+	// How long a moved predicate stays where it is.
+	cooldown time.Duration
+	// How often tablets are moved, zero disables it.
+	interval time.Duration
+}
+
+var rebalanceWeights = map[string]struct{ size, load float64 }{
+	"size":  {1, 0},
+	"load":  {0, 1},
+	"mixed": {0.5, 0.5},
+}
+
+func newRebalancePolicy(name string, threshold float64, cooldown,
+	interval time.Duration) (rebalancePolicy, error) {
+	w, ok := rebalanceWeights[name]
+	if !ok {
+		return rebalancePolicy{}, x.Errorf("Invalid rebalance policy %q, must be one of"+
+			" size, load or mixed", name)
+	}
+	if threshold < 0 || threshold >= 1 {
+		return rebalancePolicy{}, x.Errorf("Rebalance threshold must be in [0, 1), got %v",
+			threshold)
+	}
+	return rebalancePolicy{
+		name:       name,
+		sizeWeight: w.size,
+		loadWeight: w.load,
+		threshold:  threshold,
+		cooldown:   cooldown,
+		interval:   interval,
+	}, nil
+}
+
+// tabletMove is a move of a tablet between groups planned by the rebalancer.
+type tabletMove struct {
+	Predicate string  `json:"predicate"`
+	From      uint32  `json:"from"`
+	To        uint32  `json:"to"`
+	Space     int64   `json:"space"`
+	Qps       float64 `json:"qps"`
+	Cost      float64 `json:"cost"`
+}
+
+// rebalancePlan holds the moves the rebalancer would make in order if nothing else changed,
+// and the costs of the groups before them.
+type rebalancePlan struct {
+	Policy string             `json:"policy"`
+	Paused bool               `json:"paused"`
+	Groups map[uint32]float64 `json:"groups"`
+	Moves  []tabletMove       `json:"moves"`
+}
+
+// planMoves plans up to max moves balancing the costs of the groups. Tablets for which skip
+// returns true aren't moved, and neither are tablets moved to groups for which ready returns
+// false.
+func planMoves(groups map[uint32]*intern.Group, policy rebalancePolicy, max int,
+	skip func(tab *intern.Tablet) bool, ready func(gid uint32) bool) *rebalancePlan {
+	var totalSpace int64
+	var totalQps float64
+	for _, group := range groups {
+		for _, tab := range group.Tablets {
+			totalSpace += tab.Space
+			totalQps += tab.ReadQps + tab.WriteQps
+		}
+	}
+	cost := func(tab *intern.Tablet) float64 {
+		var c float64
+		if totalSpace > 0 {
+			c += policy.sizeWeight * float64(tab.Space) / float64(totalSpace)
+		}
+		if totalQps > 0 {
+			c += policy.loadWeight * (tab.ReadQps + tab.WriteQps) / totalQps
+		}
+		return c
+	}
+
+	type groupCost struct {
+		gid     uint32
+		cost    float64
+		tablets []*intern.Tablet
+	}
+	plan := &rebalancePlan{Policy: policy.name, Groups: make(map[uint32]float64)}
+	var costs []*groupCost
+	for gid, group := range groups {
+		gc := &groupCost{gid: gid}
+		for _, tab := range group.Tablets {
+			gc.cost += cost(tab)
+			gc.tablets = append(gc.tablets, tab)
+		}
+		plan.Groups[gid] = gc.cost
+		costs = append(costs, gc)
+	}
+	if len(costs) <= 1 {
+		return plan
+	}
+
+	planned := make(map[string]bool)
+	for len(plan.Moves) < max {
+		sort.Slice(costs, func(i, j int) bool {
+			if costs[i].cost != costs[j].cost {
+				return costs[i].cost < costs[j].cost
+			}
+			return costs[i].gid < costs[j].gid
+		})
+		dst := costs[0]
+		if !ready(dst.gid) {
+			break
+		}
+		var move *tabletMove
+		for i := len(costs) - 1; i > 0 && move == nil; i-- {
+			src := costs[i]
+			diff := src.cost - dst.cost
+			if diff <= policy.threshold*src.cost {
+				continue
+			}
+			// Finds the costliest tablet which leaves src at least as costly as dst once moved,
+			// so that it doesn't move back.
+			best := -1
+			var bestCost float64
+			for j, tab := range src.tablets {
+				c := cost(tab)
+				if c <= 0 || c > diff/2 || c <= bestCost || planned[tab.Predicate] || skip(tab) {
+					continue
+				}
+				best, bestCost = j, c
+			}
+			if best < 0 {
+				continue
+			}
+			tab := src.tablets[best]
+			move = &tabletMove{
+				Predicate: tab.Predicate,
+				From:      src.gid,
+				To:        dst.gid,
+				Space:     tab.Space,
+				Qps:       tab.ReadQps + tab.WriteQps,
+				Cost:      bestCost,
+			}
+			src.tablets = append(src.tablets[:best], src.tablets[best+1:]...)
+			src.cost -= bestCost
+			dst.tablets = append(dst.tablets, tab)
+			dst.cost += bestCost
+		}
+		if move == nil {
+			break
+		}
+		planned[move.Predicate] = true
+		plan.Moves = append(plan.Moves, *move)
+	}
+	return plan
+}
+
+// planRebalance returns the moves the rebalancer would make.
+func (s *Server) planRebalance(max int) *rebalancePlan {
+	now := time.Now()
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil {
+		return &rebalancePlan{Policy: s.rebalance.name}
+	}
+	lastMoved := make(map[string]int64)
+	for _, m := range s.state.Moves {
+		lastMoved[m.Predicate] = m.StartedAt
+	}
+	skip := func(tab *intern.Tablet) bool {
+		// Split predicates are served by several groups, and can't be moved.
+		if tab.ReadOnly || tab.Pinned || len(tab.Splits) > 0 {
+			return true
+		}
+		// Nor is a predicate moved back right away, or again after the move failed.
+		last, ok := lastMoved[tab.Predicate]
+		return ok && now.Sub(time.Unix(last, 0)) < s.rebalance.cooldown
+	}
+	// Don't move a tablet unless the group has a leader, which reports the tablet sizes.
+	plan := planMoves(s.state.Groups, s.rebalance, max, skip, s.hasLeader)
+	plan.Paused = s.state.RebalancePaused
+	return plan
+}
+
+// rebalanceNext starts moving the first tablet planned by the rebalancer, if any.
+func (s *Server) rebalanceNext(ctx context.Context) {
+	plan := s.planRebalance(1)
+	// Moves can take long when throttled, don't start another one meanwhile.
+	if plan.Paused || !s.Node.AmLeader() || s.movesRunning() {
+		return
+	}
+	x.Printf("Group costs with %s policy: %+v\n", plan.Policy, plan.Groups)
+	if len(plan.Moves) == 0 {
+		return
+	}
+	move := plan.Moves[0]
+	m, err := s.startMove(ctx, move.Predicate, move.From, move.To, false)
+	if err != nil {
+		x.Printf("Not moving predicate %v: %v\n", move.Predicate, err)
+		return
+	}
+	go s.runMove(ctx, m)
+}
+
+// PauseRebalance pauses or resumes the rebalancing of tablets. Moves already started go on.
+func (s *Server) PauseRebalance(ctx context.Context, paused bool) error {
+	p := &intern.ZeroProposal{Rebalance: &intern.RebalanceState{Paused: paused}}
+	return s.Node.proposeAndWait(ctx, p)
+}
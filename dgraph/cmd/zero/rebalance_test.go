@@ -0,0 +1,105 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+func testGroups() map[uint32]*intern.Group {
+	group := func(tablets ...*intern.Tablet) *intern.Group {
+		g := &intern.Group{Tablets: make(map[string]*intern.Tablet)}
+		for _, tab := range tablets {
+			g.Tablets[tab.Predicate] = tab
+		}
+		return g
+	}
+	return map[uint32]*intern.Group{
+		1: group(
+			&intern.Tablet{GroupId: 1, Predicate: "name", Space: 600},
+			&intern.Tablet{GroupId: 1, Predicate: "age", Space: 200},
+			&intern.Tablet{GroupId: 1, Predicate: "hot", Space: 100, ReadQps: 90},
+		),
+		2: group(
+			&intern.Tablet{GroupId: 2, Predicate: "friend", Space: 100, ReadQps: 10},
+		),
+	}
+}
+
+func testPlan(t *testing.T, policy string, max int,
+	skip func(*intern.Tablet) bool) *rebalancePlan {
+	p, err := newRebalancePolicy(policy, 0.1, time.Hour, time.Minute)
+	require.NoError(t, err)
+	if skip == nil {
+		skip = func(*intern.Tablet) bool { return false }
+	}
+	return planMoves(testGroups(), p, max, skip, func(uint32) bool { return true })
+}
+
+func predicates(plan *rebalancePlan) []string {
+	var preds []string
+	for _, move := range plan.Moves {
+		preds = append(preds, move.Predicate)
+	}
+	return preds
+}
+
+func TestPlanMovesBySize(t *testing.T) {
+	plan := testPlan(t, "size", 10, nil)
+	require.InDelta(t, 0.9, plan.Groups[1], 1e-9)
+	require.InDelta(t, 0.1, plan.Groups[2], 1e-9)
+	// Moving name would leave group 1 cheaper than group 2.
+	require.Equal(t, []string{"age", "hot"}, predicates(plan))
+	require.Equal(t, tabletMove{Predicate: "age", From: 1, To: 2, Space: 200, Cost: 0.2},
+		plan.Moves[0])
+
+	plan = testPlan(t, "size", 1, nil)
+	require.Equal(t, []string{"age"}, predicates(plan))
+}
+
+func TestPlanMovesByLoad(t *testing.T) {
+	// Moving hot, which takes most of the load, would leave group 1 cheaper.
+	plan := testPlan(t, "load", 10, nil)
+	require.Empty(t, plan.Moves)
+
+	plan = testPlan(t, "mixed", 10, nil)
+	require.Equal(t, []string{"name", "age"}, predicates(plan))
+
+	plan = testPlan(t, "mixed", 10, func(tab *intern.Tablet) bool {
+		return tab.Predicate == "name"
+	})
+	require.Equal(t, []string{"age"}, predicates(plan))
+}
+
+func TestPlanMovesThreshold(t *testing.T) {
+	p, err := newRebalancePolicy("size", 0.9, time.Hour, time.Minute)
+	require.NoError(t, err)
+	plan := planMoves(testGroups(), p, 10, func(*intern.Tablet) bool { return false },
+		func(uint32) bool { return true })
+	require.Empty(t, plan.Moves)
+
+	_, err = newRebalancePolicy("random", 0.1, time.Hour, time.Minute)
+	require.Error(t, err)
+	_, err = newRebalancePolicy("size", 1, time.Hour, time.Minute)
+	require.Error(t, err)
+}
@@ -18,6 +18,7 @@
 package zero
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net"
@@ -25,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -43,13 +45,18 @@ import (
 )
 
 type options struct {
-	bindall     bool
-	myAddr      string
-	portOffset  int
-	nodeId      uint64
-	numReplicas int
-	peer        string
-	w           string
+	bindall      bool
+	myAddr       string
+	portOffset   int
+	nodeId       uint64
+	numReplicas  int
+	peer         string
+	w            string
+	walArchive   string
+	walRetention time.Duration
+	admin        x.AdminConfig
+	rebalance    rebalancePolicy
+	moveRate     uint64
 }
 
 var opts options
@@ -84,6 +91,28 @@ instances to achieve high-availability.
 		" The count includes the original shard.")
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
+	flag.String("wal_archive", "",
+		"Directory in which to archive the applied raft entries, for point-in-time recovery.")
+	flag.Duration("wal_archive_retention", 0,
+		"Delete the WAL archive files last written to longer ago than this. Zero keeps them.")
+	flag.Duration("rebalance_interval", 8*time.Minute,
+		"Interval at which a tablet is moved to balance the groups. Zero disables rebalancing.")
+	flag.String("rebalance_policy", "mixed",
+		"What rebalancing balances across groups: size, load (reads and writes per second)"+
+			" or mixed, giving equal weight to both.")
+	flag.Float64("rebalance_threshold", 0.1,
+		"Groups whose costs differ by less than this fraction of the costliest one aren't"+
+			" rebalanced.")
+	flag.Duration("rebalance_cooldown", time.Hour,
+		"Minimum time before the rebalancer moves a predicate again.")
+	flag.Float64("move_rate_mb", 0,
+		"MBs per second sent while moving a predicate to another group. Zero means no limit.")
+	x.RegisterAdminFlags(flag)
+	x.RegisterClusterTLSFlags(flag)
+	x.RegisterAuditFlags(flag)
+	flag.String("encryption_key_file", "",
+		"File holding hex encoded AES-256 keys, one per line, encrypting the WAL."+
+			" The last key encrypts new data.")
 }
 
 func setupListener(addr string, port int, kind string) (listener net.Listener, err error) {
@@ -99,17 +128,19 @@ type state struct {
 }
 
 func (st *state) serveGRPC(l net.Listener, wg *sync.WaitGroup) {
-	s := grpc.NewServer(
+	grpcOpts := append(conn.ServerOptions(),
 		grpc.MaxRecvMsgSize(x.GrpcMaxSize),
 		grpc.MaxSendMsgSize(x.GrpcMaxSize),
 		grpc.MaxConcurrentStreams(1000))
+	s := grpc.NewServer(grpcOpts...)
 
 	rc := intern.RaftContext{Id: opts.nodeId, Addr: opts.myAddr, Group: 0}
 	m := conn.NewNode(&rc)
 	st.rs = &conn.RaftServer{Node: m}
 
 	st.node = &node{Node: m, ctx: context.Background()}
-	st.zero = &Server{NumReplicas: opts.numReplicas, Node: st.node}
+	st.zero = &Server{NumReplicas: opts.numReplicas, Node: st.node, rebalance: opts.rebalance,
+		moveRate: opts.moveRate}
 	st.zero.Init()
 	st.node.server = st.zero
 
@@ -126,6 +157,7 @@ func (st *state) serveGRPC(l net.Listener, wg *sync.WaitGroup) {
 
 func (st *state) serveHTTP(l net.Listener, wg *sync.WaitGroup) {
 	srv := &http.Server{
+		Handler:      opts.admin.GuardDebug(http.DefaultServeMux),
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 600 * time.Second,
 		IdleTimeout:  2 * time.Minute,
@@ -185,6 +217,175 @@ func (st *state) removeNode(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// intsFromQueryParam parses a comma separated list of integers from the query parameter.
+func intsFromQueryParam(w http.ResponseWriter, r *http.Request, name string) ([]uint64, bool) {
+	str := r.URL.Query().Get(name)
+	if len(str) == 0 {
+		return nil, true
+	}
+	var vals []uint64
+	for _, s := range strings.Split(str, ",") {
+		val, err := strconv.ParseUint(strings.TrimSpace(s), 0, 64)
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Error while parsing %s", name))
+			return nil, false
+		}
+		vals = append(vals, val)
+	}
+	return vals, true
+}
+
+func (st *state) splitPredicate(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	predicate := r.URL.Query().Get("predicate")
+	if len(predicate) == 0 {
+		x.SetStatus(w, x.ErrorInvalidRequest, "predicate not passed")
+		return
+	}
+	groups, ok := intsFromQueryParam(w, r, "groups")
+	if !ok {
+		return
+	}
+	if len(groups) == 0 {
+		x.SetStatus(w, x.ErrorInvalidRequest, "groups not passed")
+		return
+	}
+	starts, ok := intsFromQueryParam(w, r, "at")
+	if !ok {
+		return
+	}
+	gids := make([]uint32, 0, len(groups))
+	for _, gid := range groups {
+		gids = append(gids, uint32(gid))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer cancel()
+	tab, err := st.zero.SplitPredicate(ctx, predicate, gids, starts)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	m := jsonpb.Marshaler{}
+	if err := m.Marshal(w, tab); err != nil {
+		x.SetStatus(w, x.ErrorNoData, err.Error())
+	}
+}
+
+// maxPlannedMoves bounds the moves shown by /rebalancePlan.
+const maxPlannedMoves = 10
+
+func (st *state) rebalancePlan(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+
+	plan := st.zero.planRebalance(maxPlannedMoves)
+	if err := json.NewEncoder(w).Encode(plan); err != nil {
+		x.SetStatus(w, x.ErrorNoData, err.Error())
+		return
+	}
+}
+
+func (st *state) pauseRebalance(paused bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		x.AddCorsHeaders(w)
+		if r.Method == "OPTIONS" {
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+			return
+		}
+		if err := st.zero.PauseRebalance(context.Background(), paused); err != nil {
+			x.SetStatus(w, x.Error, err.Error())
+			return
+		}
+		x.SetStatus(w, x.Success, "Done")
+	}
+}
+
+func (st *state) movePredicate(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	predicate := r.URL.Query().Get("predicate")
+	if len(predicate) == 0 {
+		x.SetStatus(w, x.ErrorInvalidRequest, "predicate not passed")
+		return
+	}
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		return
+	}
+	in := &intern.MoveTabletRequest{Predicate: predicate, GroupId: uint32(groupId)}
+	move, err := st.zero.MoveTablet(context.Background(), in)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	m := jsonpb.Marshaler{}
+	if err := m.Marshal(w, move); err != nil {
+		x.SetStatus(w, x.ErrorNoData, err.Error())
+	}
+}
+
+func (st *state) pinPredicate(pinned bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		x.AddCorsHeaders(w)
+		if r.Method == "OPTIONS" {
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+			return
+		}
+
+		predicate := r.URL.Query().Get("predicate")
+		if len(predicate) == 0 {
+			x.SetStatus(w, x.ErrorInvalidRequest, "predicate not passed")
+			return
+		}
+		in := &intern.TabletPin{Predicate: predicate, Pinned: pinned}
+		if _, err := st.zero.PinTablet(context.Background(), in); err != nil {
+			x.SetStatus(w, x.Error, err.Error())
+			return
+		}
+		x.SetStatus(w, x.Success, "Done")
+	}
+}
+
+func (st *state) predicateMoves(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+
+	moves := st.zero.tabletMoves(r.URL.Query().Get("predicate"))
+	m := jsonpb.Marshaler{}
+	if err := m.Marshal(w, moves); err != nil {
+		x.SetStatus(w, x.ErrorNoData, err.Error())
+		return
+	}
+}
+
 func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -204,13 +405,35 @@ func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 
 func run() {
 	opts = options{
-		bindall:     Zero.Conf.GetBool("bindall"),
-		myAddr:      Zero.Conf.GetString("my"),
-		portOffset:  Zero.Conf.GetInt("port_offset"),
-		nodeId:      uint64(Zero.Conf.GetInt("idx")),
-		numReplicas: Zero.Conf.GetInt("replicas"),
-		peer:        Zero.Conf.GetString("peer"),
-		w:           Zero.Conf.GetString("wal"),
+		bindall:      Zero.Conf.GetBool("bindall"),
+		myAddr:       Zero.Conf.GetString("my"),
+		portOffset:   Zero.Conf.GetInt("port_offset"),
+		nodeId:       uint64(Zero.Conf.GetInt("idx")),
+		numReplicas:  Zero.Conf.GetInt("replicas"),
+		peer:         Zero.Conf.GetString("peer"),
+		w:            Zero.Conf.GetString("wal"),
+		walArchive:   Zero.Conf.GetString("wal_archive"),
+		walRetention: Zero.Conf.GetDuration("wal_archive_retention"),
+	}
+	x.Checkf(x.LoadAdminConfig(&opts.admin, Zero.Conf), "Invalid admin options")
+	var err error
+	opts.rebalance, err = newRebalancePolicy(Zero.Conf.GetString("rebalance_policy"),
+		Zero.Conf.GetFloat64("rebalance_threshold"), Zero.Conf.GetDuration("rebalance_cooldown"),
+		Zero.Conf.GetDuration("rebalance_interval"))
+	x.Checkf(err, "Invalid rebalance options")
+	opts.moveRate = uint64(Zero.Conf.GetFloat64("move_rate_mb") * (1 << 20))
+	// The HTTP port of zero doesn't serve TLS.
+	x.AssertTruefNoTrace(!opts.admin.RequireClientCert,
+		"Admin client certificates (--admin_client_cert) aren't supported by zero")
+	clusterTLS, err := x.LoadClusterTLS(Zero.Conf)
+	x.Checkf(err, "Invalid cluster TLS options")
+	conn.SetClusterTLS(clusterTLS)
+	opts.admin.Audit, err = x.LoadAuditLog(Zero.Conf)
+	x.Checkf(err, "Invalid audit log options")
+	if keyFile := Zero.Conf.GetString("encryption_key_file"); keyFile != "" {
+		keys, err := x.LoadKeyRing(keyFile)
+		x.Checkf(err, "Error while loading encryption keys")
+		x.SetValueEncryption(keys)
 	}
 
 	grpc.EnableTracing = false
@@ -238,11 +461,20 @@ func run() {
 	st.serveGRPC(grpcListener, &wg)
 	st.serveHTTP(httpListener, &wg)
 
-	http.HandleFunc("/state", st.getState)
-	http.HandleFunc("/removeNode", st.removeNode)
+	http.HandleFunc("/state", opts.admin.AdminHandler(st.getState))
+	http.HandleFunc("/removeNode", opts.admin.AdminHandler(st.removeNode))
+	http.HandleFunc("/splitPredicate", opts.admin.AdminHandler(st.splitPredicate))
+	http.HandleFunc("/rebalancePlan", opts.admin.AdminHandler(st.rebalancePlan))
+	http.HandleFunc("/pauseRebalance", opts.admin.AdminHandler(st.pauseRebalance(true)))
+	http.HandleFunc("/resumeRebalance", opts.admin.AdminHandler(st.pauseRebalance(false)))
+	http.HandleFunc("/movePredicate", opts.admin.AdminHandler(st.movePredicate))
+	http.HandleFunc("/pinPredicate", opts.admin.AdminHandler(st.pinPredicate(true)))
+	http.HandleFunc("/unpinPredicate", opts.admin.AdminHandler(st.pinPredicate(false)))
+	http.HandleFunc("/predicateMoves", opts.admin.AdminHandler(st.predicateMoves))
 
 	// Open raft write-ahead log and initialize raft node.
 	x.Checkf(os.MkdirAll(opts.w, 0700), "Error while creating WAL dir.")
+	x.Checkf(x.CheckEncryptedDir(opts.w, x.ValueEncryption()), "Can't open WAL dir")
 	kvOpt := badger.DefaultOptions
 	kvOpt.SyncWrites = true
 	kvOpt.Dir = opts.w
@@ -252,6 +484,12 @@ func run() {
 	x.Checkf(err, "Error while opening WAL store")
 	defer kv.Close()
 	wal := raftwal.Init(kv, opts.nodeId)
+	if len(opts.walArchive) > 0 {
+		archive, err := raftwal.OpenArchive(opts.walArchive, opts.walRetention)
+		x.Checkf(err, "Error while opening WAL archive")
+		defer archive.Close()
+		wal.SetArchive(archive)
+	}
 	x.Check(st.node.initAndStartNode(wal))
 
 	sdCh := make(chan os.Signal, 1)
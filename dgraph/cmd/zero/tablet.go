@@ -18,9 +18,10 @@
 package zero
 
 import (
-	"sort"
+	"bytes"
 	"time"
 
+	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/x"
 	"golang.org/x/net/context"
@@ -42,7 +43,11 @@ This would trigger G1 to get latest state. Wait for it.
 • G1 would propose this state to it’s followers.
 • G1 after proposing would do a call to G2, and start streaming.
 • Before G2 starts accepting, it should delete any current keys for P.
+• G1 sends the keys no faster than the move rate of Zero.
+• G2 writes the keys in batches, and tells Zero the last key of each. (Endpoint: G2 → Zero)
 • It should tell Zero whether it succeeded or failed. (Endpoint: G1 → Zero)
+• If it failed, Zero tells G1 to send the keys after the last one G2 wrote, and G2 keeps
+  the keys it has. The next leader of Zero does the same for a move the previous one started.
 
 • Zero would then propose that G2 is serving P (or G1 is, if fail above) P would RW.
 • G1 gets this, G2 gets this.
@@ -52,8 +57,12 @@ This would trigger G1 to get latest state. Wait for it.
 
 //  TODO: Have a event log for everything.
 func (s *Server) rebalanceTablets() {
-	ticker := time.NewTicker(time.Minute * 8)
-	var cancel context.CancelFunc
+	var tick <-chan time.Time
+	if s.rebalance.interval > 0 {
+		ticker := time.NewTicker(s.rebalance.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
 	leaderChangeCh := s.leaderChangeChannel()
 	for {
 		select {
@@ -63,61 +72,103 @@ func (s *Server) rebalanceTablets() {
 			}
 			// Cancel predicate moves when you step down as leader.
 			if !s.Node.AmLeader() {
-				if cancel != nil {
-					cancel()
-					cancel = nil
-				}
+				s.stopMoves()
 				break
 			}
 
 			// We might have initiated predicate move on some other node, give it some
-			// time to get cancelled. On cancellation the other node would set the predicate
-			// to write mode again and we need to be sure that it doesn't happen after we
-			// decide to move the predicate and set it to read mode.
+			// time to get cancelled before resuming it.
 			time.Sleep(time.Minute)
 			// Check if any predicates were stuck in read mode. We don't need to do it
 			// periodically because we revert back the predicate to write state in case
 			// of any error unless a node crashes or is shutdown.
 			s.runRecovery()
-		case <-ticker.C:
-			predicate, srcGroup, dstGroup := s.chooseTablet()
-			if len(predicate) == 0 {
-				break
+		case <-tick:
+			s.rebalanceNext(s.moveContext())
+		}
+	}
+}
+
+// runRecovery resumes the moves and splits of tablets which were stopped, and makes the other
+// tablets stuck in read mode writable again.
+func (s *Server) runRecovery() {
+	proposals, resumes := s.recoveryProposals()
+	// Moves which can't be resumed are finished in the history first.
+	s.proposeRecovery(proposals)
+	if s.Node.AmLeader() {
+		for _, tab := range resumes {
+			if tab.Move == nil {
+				go func(tab *intern.Tablet) {
+					if err := s.finishSplit(s.moveContext(), tab); err != nil {
+						x.Printf("Error while finishing split of predicate %v: %v\n",
+							tab.Predicate, err)
+					}
+				}(tab)
+				continue
 			}
-			x.Printf("Going to move predicate %v from %d to %d\n", predicate, srcGroup, dstGroup)
-			var ctx context.Context
-			ctx, cancel = context.WithTimeout(context.Background(), time.Minute*20)
-			if err := s.moveTablet(ctx, predicate, srcGroup, dstGroup); err != nil {
-				x.Printf("Error while trying to move predicate %v from %d to %d: %v\n",
-					predicate, srcGroup, dstGroup, err)
+			m, err := s.resumeMove(s.moveContext(), tab)
+			if err != nil {
+				x.Printf("Error while resuming move of predicate %v: %v\n", tab.Predicate, err)
+			} else if m != nil {
+				go s.runMove(s.moveContext(), m)
 			}
-			cancel = nil
 		}
 	}
 }
 
-func (s *Server) runRecovery() {
+func (s *Server) recoveryProposals() ([]*intern.ZeroProposal, []*intern.Tablet) {
 	s.RLock()
 	defer s.RUnlock()
 	if s.state == nil {
-		return
+		return nil, nil
 	}
 	var proposals []*intern.ZeroProposal
+	var resumes []*intern.Tablet
 	for _, group := range s.state.Groups {
 		for _, tab := range group.Tablets {
-			if tab.ReadOnly {
-				p := &intern.ZeroProposal{}
-				p.Tablet = &intern.Tablet{
-					GroupId:   tab.GroupId,
-					Predicate: tab.Predicate,
-					Space:     tab.Space,
-					Force:     true,
-				}
-				proposals = append(proposals, p)
+			if !tab.ReadOnly {
+				continue
+			}
+			// A split tablet is only read-only until the group serving the lowest uids drops
+			// the others.
+			if tab.Move != nil || len(tab.Splits) > 0 {
+				t := *tab
+				resumes = append(resumes, &t)
+				continue
 			}
+			p := &intern.ZeroProposal{}
+			p.Tablet = &intern.Tablet{
+				GroupId:   tab.GroupId,
+				Predicate: tab.Predicate,
+				Space:     tab.Space,
+				Force:     true,
+			}
+			proposals = append(proposals, p)
 		}
 	}
+	// Moves left running by a previous leader are finished, unless their tablets still move.
+	for _, m := range s.state.Moves {
+		if m.Status != moveRunning || s.movesRun[m.Id] {
+			continue
+		}
+		tab := s.servingTablet(m.Predicate)
+		if tab != nil && tab.Move != nil && tab.Move.DstGroup == m.DstGroup {
+			continue
+		}
+		done := *m
+		done.FinishedAt = time.Now().Unix()
+		if tab != nil && tab.GroupId == m.DstGroup {
+			done.Status = moveDone
+		} else {
+			done.Status = moveFailed
+			done.Error = "Stopped when the leader of Zero changed"
+		}
+		proposals = append(proposals, &intern.ZeroProposal{TabletMove: &done})
+	}
+	return proposals, resumes
+}
 
+func (s *Server) proposeRecovery(proposals []*intern.ZeroProposal) {
 	errCh := make(chan error)
 	for _, pr := range proposals {
 		go func(pr *intern.ZeroProposal) {
@@ -134,68 +185,13 @@ func (s *Server) runRecovery() {
 	}
 }
 
-func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
-	s.RLock()
-	defer s.RUnlock()
-	if s.state == nil {
-		return
-	}
-	numGroups := len(s.state.Groups)
-	if !s.Node.AmLeader() || numGroups <= 1 {
-		return
-	}
-
-	// Sort all groups by their sizes.
-	type kv struct {
-		gid  uint32
-		size int64
-	}
-	var groups []kv
-	for k, v := range s.state.Groups {
-		space := int64(0)
-		for _, tab := range v.Tablets {
-			space += tab.Space
-		}
-		groups = append(groups, kv{k, space})
-	}
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].size < groups[j].size
-	})
-
-	x.Printf("\n\nGroups sorted by size: %+v\n\n", groups)
-	for lastGroup := numGroups - 1; lastGroup > 0; lastGroup-- {
-		srcGroup = groups[lastGroup].gid
-		dstGroup = groups[0].gid
-		size_diff := groups[lastGroup].size - groups[0].size
-		x.Printf("size_diff %v\n", size_diff)
-		// Don't move a node unless you receive atleast one update regarding tablet size.
-		// Tablet size would have come up with leader update.
-		if !s.hasLeader(dstGroup) {
-			return
-		}
-		// We move the predicate only if the difference between size of both machines is
-		// atleast 10% of src group.
-		if float64(size_diff) < 0.1*float64(groups[0].size) {
-			continue
-		}
-
-		// Try to find a predicate which we can move.
-		size := int64(0)
-		group := s.state.Groups[srcGroup]
-		for _, tab := range group.Tablets {
-			// Finds a tablet as big a possible such that on moving it dstGroup's size is
-			// less than or equal to srcGroup.
-			if tab.Space <= size_diff/2 && tab.Space > size {
-				predicate = tab.Predicate
-				size = tab.Space
-			}
-		}
-		if len(predicate) > 0 {
-			return
-		}
-	}
-	return
-}
+const (
+	// A move is resumed after failing, until it has been attempted this many times.
+	maxMoveAttempts = 5
+	// The attempt to move a tablet is stopped and resumed when the destination group reports
+	// no progress for this long.
+	moveStallTimeout = 5 * time.Minute
+)
 
 func (s *Server) moveTablet(ctx context.Context, predicate string, srcGroup uint32,
 	dstGroup uint32) error {
@@ -204,7 +200,7 @@ func (s *Server) moveTablet(ctx context.Context, predicate string, srcGroup uint
 		return nil
 	}
 	if !s.Node.AmLeader() {
-		s.runRecovery()
+		// The predicate stays read-only, the next leader resumes the move.
 		return err
 	}
 
@@ -228,46 +224,495 @@ func (s *Server) movePredicateHelper(ctx context.Context, predicate string, srcG
 	n := s.Node
 	stab := s.ServingTablet(predicate)
 	x.AssertTrue(stab != nil)
-	// Propose that predicate in read only
 	p := &intern.ZeroProposal{}
+	// A move started by a previous leader is resumed.
+	if move := s.moveProgress(predicate); move == nil || move.DstGroup != dstGroup {
+		// Propose that predicate in read only
+		p.Tablet = &intern.Tablet{
+			GroupId:   srcGroup,
+			Predicate: predicate,
+			Space:     stab.Space,
+			ReadOnly:  true,
+			Force:     true,
+			Move: &intern.MoveProgress{
+				Predicate: predicate,
+				DstGroup:  dstGroup,
+				StartedAt: time.Now().Unix(),
+			},
+		}
+		if err := n.proposeAndWait(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	for {
+		err := s.sendPredicate(ctx, predicate, srcGroup, dstGroup)
+		if err == nil {
+			break
+		}
+		move := s.moveProgress(predicate)
+		if move == nil || move.Attempts >= maxMoveAttempts || ctx.Err() != nil ||
+			!n.AmLeader() {
+			return err
+		}
+		x.Printf("Error while moving predicate %v, attempt %d, resuming after %d keys: %v\n",
+			predicate, move.Attempts, move.Keys, err)
+		select {
+		case <-time.After(time.Duration(move.Attempts) * 10 * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// Propose that predicate is served by dstGroup in RW.
 	p.Tablet = &intern.Tablet{
-		GroupId:   srcGroup,
+		GroupId:   dstGroup,
 		Predicate: predicate,
 		Space:     stab.Space,
-		ReadOnly:  true,
 		Force:     true,
 	}
 	if err := n.proposeAndWait(ctx, p); err != nil {
 		return err
 	}
+	// TODO: Probably make it R in dstGroup and send state to srcGroup and only after
+	// it proposes make it RW in dstGroup. That way we won't have stale reads from srcGroup
+	// for sure.
+	return nil
+}
+
+// sendPredicate has the leader of srcGroup send the predicate to dstGroup, after the last key
+// which dstGroup reported. It's stopped if dstGroup reports no progress for moveStallTimeout.
+func (s *Server) sendPredicate(ctx context.Context, predicate string, srcGroup uint32,
+	dstGroup uint32) error {
+	attempt := &intern.MoveProgress{
+		Predicate: predicate,
+		DstGroup:  dstGroup,
+		Attempts:  1,
+		UpdatedAt: time.Now().Unix(),
+	}
+	if err := s.Node.proposeAndWait(ctx, &intern.ZeroProposal{MoveProgress: attempt}); err != nil {
+		return err
+	}
+	move := s.moveProgress(predicate)
+	if move == nil || move.DstGroup != dstGroup {
+		return x.Errorf("Predicate %s isn't being moved to group %d", predicate, dstGroup)
+	}
 	pl := s.Leader(srcGroup)
 	if pl == nil {
 		return x.Errorf("No healthy connection found to leader of group %d", srcGroup)
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		// Progress is timed by this server, as UpdatedAt could come from the clock of another.
+		lastKey, progressed := move.LastKey, time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			move := s.moveProgress(predicate)
+			if move != nil && !bytes.Equal(move.LastKey, lastKey) {
+				lastKey, progressed = move.LastKey, time.Now()
+			}
+			if move == nil || time.Since(progressed) > moveStallTimeout {
+				x.Printf("No progress moving predicate %v for %v\n", predicate, moveStallTimeout)
+				cancel()
+				return
+			}
+		}
+	}()
+
 	c := intern.NewWorkerClient(pl.Get())
 	in := &intern.MovePredicatePayload{
 		Predicate:     predicate,
 		State:         s.membershipState(),
 		SourceGroupId: srcGroup,
 		DestGroupId:   dstGroup,
+		ResumeKey:     move.LastKey,
+		Rate:          s.moveRate,
+		Attempt:       move.Attempts,
 	}
-	if _, err := c.MovePredicate(ctx, in); err != nil {
+	_, err := c.MovePredicate(ctx, in)
+	return err
+}
+
+/*
+Steps to rename predicate p to q, served by group g:
+• Zero proposes that q is served by g in read-only mode, which fails if q is already served,
+  and then that p is read-only too.
+• Zero tells the leader of g to rename p to q (Endpoint: Zero → g), in a txn Zero starts. The
+  leader replaces p with q in the _predicate_ lists in that txn, and proposes the latest state
+  and then the rename, which every member of g applies by moving the keys and schema of p
+  under q.
+• Zero proposes in one go that q is served by g in RW, that p isn't served anymore and that the
+  txn is committed. If the rename failed, p is made RW again, q is removed and the txn is
+  aborted instead.
+
+As q is served by g from the start, queries for it are always sent to g, which answers them
+from the renamed keys as soon as it has applied the rename.
+*/
+
+// RenamePredicate renames a predicate, along with its indexes and schema.
+func (s *Server) RenamePredicate(ctx context.Context,
+	in *intern.RenamePredicatePayload) (*api.Payload, error) {
+	if ctx.Err() != nil {
+		return &emptyPayload, ctx.Err()
+	}
+	if !s.Node.AmLeader() {
+		return &emptyPayload, x.Errorf("Only leader can rename predicates")
+	}
+	if len(in.Predicate) == 0 || len(in.NewName) == 0 {
+		return &emptyPayload, errEmptyPredicate
+	}
+	stab := s.ServingTablet(in.Predicate)
+	if stab == nil {
+		return &emptyPayload, x.Errorf("Predicate %s isn't served by any group", in.Predicate)
+	}
+	if stab.ReadOnly {
+		return &emptyPayload, x.Errorf("Predicate %s is being moved, please retry later",
+			in.Predicate)
+	}
+	if len(stab.Splits) > 0 {
+		return &emptyPayload, x.Errorf("Predicate %s is split, it can't be renamed",
+			in.Predicate)
+	}
+	gid := stab.GroupId
+	ids, err := s.Timestamps(ctx, &intern.Num{Val: 1})
+	if err != nil {
+		return &emptyPayload, err
+	}
+	startTs := ids.StartId
+
+	p := &intern.ZeroProposal{}
+	p.Tablet = &intern.Tablet{
+		GroupId:   gid,
+		Predicate: in.NewName,
+		ReadOnly:  true,
+	}
+	if err := s.Node.proposeAndWait(ctx, p); err != nil {
+		if err == errTabletAlreadyServed {
+			err = x.Errorf("Predicate %s already exists", in.NewName)
+		}
+		return &emptyPayload, err
+	}
+	if ntab := s.ServingTablet(in.NewName); ntab == nil || ntab.GroupId != gid ||
+		!ntab.ReadOnly {
+		// Somebody asked to serve the new name before us.
+		return &emptyPayload, x.Errorf("Predicate %s already exists", in.NewName)
+	}
+
+	err = s.renamePredicateHelper(ctx, in, stab, startTs)
+	var commitTs uint64
+	if err == nil {
+		var assigned *api.AssignedIds
+		if assigned, err = s.lease(ctx, &intern.Num{Val: 1}, true); err == nil {
+			commitTs = assigned.StartId
+			defer s.orc.doneUntil.Done(commitTs)
+		}
+	}
+	p = &intern.ZeroProposal{}
+	p.Txn = &api.TxnContext{StartTs: startTs, CommitTs: commitTs, Aborted: commitTs == 0}
+	if err == nil {
+		p.Tablets = []*intern.Tablet{
+			{GroupId: gid, Predicate: in.NewName, Space: stab.Space, Force: true,
+				Pinned: stab.Pinned},
+			{GroupId: gid, Predicate: in.Predicate, Remove: true},
+		}
+	} else {
+		p.Tablets = []*intern.Tablet{
+			{GroupId: gid, Predicate: in.Predicate, Space: stab.Space, Force: true},
+			{GroupId: gid, Predicate: in.NewName, Remove: true},
+		}
+	}
+	if perr := s.Node.proposeAndWait(context.Background(), p); perr != nil {
+		x.Printf("Error while updating tablets after renaming predicate %v to %v: %v\n",
+			in.Predicate, in.NewName, perr)
+		if err == nil {
+			err = perr
+		}
+	}
+	return &emptyPayload, err
+}
+
+func (s *Server) renamePredicateHelper(ctx context.Context, in *intern.RenamePredicatePayload,
+	stab *intern.Tablet, startTs uint64) error {
+	// Propose that predicate is read only.
+	p := &intern.ZeroProposal{}
+	p.Tablet = &intern.Tablet{
+		GroupId:   stab.GroupId,
+		Predicate: stab.Predicate,
+		Space:     stab.Space,
+		ReadOnly:  true,
+		Force:     true,
+	}
+	if err := s.Node.proposeAndWait(ctx, p); err != nil {
 		return err
 	}
+	pl := s.Leader(stab.GroupId)
+	if pl == nil {
+		return x.Errorf("No healthy connection found to leader of group %d", stab.GroupId)
+	}
+	c := intern.NewWorkerClient(pl.Get())
+	_, err := c.RenamePredicate(ctx, &intern.RenamePredicatePayload{
+		Predicate: in.Predicate,
+		NewName:   in.NewName,
+		GroupId:   stab.GroupId,
+		State:     s.membershipState(),
+		StartTs:   startTs,
+	})
+	return err
+}
 
-	// Propose that predicate is served by dstGroup in RW.
+/*
+Steps to split predicate p, served by g, across groups g1…gn by uid:
+• Zero proposes that p is read-only.
+• For each gi, Zero tells g to move the data of the range of uids gi is going to serve to gi
+  (Endpoint: Zero → g), as when moving a predicate but without the indexes.
+• Zero tells each gi to rebuild the indexes from the data it got (Endpoint: Zero → gi), and then
+  proposes that p is split, still read-only.
+• Zero tells g to drop the data of the uids it no longer serves and to rebuild its indexes, and
+  finally proposes that p is RW again.
+
+If a step fails before p is split, p is made RW again as it was, and the data copied to the
+other groups isn't served by them. Once p is split, it stays read-only until g dropped the data
+of the other groups, which is retried, and otherwise done by splitting p again or by the next
+leader of Zero.
+*/
+
+// SplitPredicate splits a predicate by uid across groups. The uids from each start up to the
+// next one are served by the matching group, and the ones below the first start by the group
+// already serving the predicate. Without starts, the uids leased so far are split evenly.
+func (s *Server) SplitPredicate(ctx context.Context, predicate string, gids []uint32,
+	starts []uint64) (*intern.Tablet, error) {
+	if !s.Node.AmLeader() {
+		return nil, x.Errorf("Only leader can split predicates")
+	}
+	if len(predicate) == 0 {
+		return nil, errEmptyPredicate
+	}
+	stab := s.ServingTablet(predicate)
+	if stab == nil {
+		return nil, x.Errorf("Predicate %s isn't served by any group", predicate)
+	}
+	if stab.ReadOnly && len(stab.Splits) > 0 {
+		// The split was stopped before it finished, whatever the groups asked for now.
+		split := *stab
+		if err := s.finishSplit(ctx, &split); err != nil {
+			return nil, err
+		}
+		return &split, nil
+	}
+	if stab.ReadOnly {
+		return nil, x.Errorf("Predicate %s is being moved, please retry later", predicate)
+	}
+	if len(stab.Splits) > 0 {
+		return nil, x.Errorf("Predicate %s is already split", predicate)
+	}
+	for _, gid := range gids {
+		if s.Leader(gid) == nil {
+			return nil, x.Errorf("No healthy connection found to leader of group %d", gid)
+		}
+	}
+	split, err := splitTablet(stab, gids, starts, s.maxLeaseId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.splitPredicateHelper(ctx, stab, split)
+	if err == nil {
+		return split, nil
+	}
+	x.Printf("Error while splitting predicate %v: %v\n", predicate, err)
+	if !s.Node.AmLeader() {
+		s.runRecovery()
+		return nil, err
+	}
+	if tab := s.ServingTablet(predicate); tab != nil && len(tab.Splits) > 0 {
+		// The split was proposed, and the groups may have dropped the uids they no longer
+		// serve. It can't be reverted, so it's finished instead.
+		for attempt := 1; attempt < maxMoveAttempts; attempt++ {
+			select {
+			case <-time.After(time.Duration(attempt) * 10 * time.Second):
+			case <-ctx.Done():
+				return nil, err
+			}
+			if err = s.finishSplit(ctx, split); err == nil {
+				return split, nil
+			}
+			x.Printf("Error while finishing split of predicate %v: %v\n", predicate, err)
+		}
+		return nil, err
+	}
+	p := &intern.ZeroProposal{}
 	p.Tablet = &intern.Tablet{
-		GroupId:   dstGroup,
+		GroupId:   stab.GroupId,
 		Predicate: predicate,
 		Space:     stab.Space,
 		Force:     true,
 	}
+	if perr := s.Node.proposeAndWait(context.Background(), p); perr != nil {
+		x.Printf("Error while reverting predicate %v to RW: %v\n", predicate, perr)
+	}
+	return nil, err
+}
+
+// splitTablet returns the tablet split at starts across gids.
+func splitTablet(stab *intern.Tablet, gids []uint32, starts []uint64,
+	maxUid uint64) (*intern.Tablet, error) {
+	if len(gids) == 0 {
+		return nil, x.Errorf("No groups to split predicate %s across", stab.Predicate)
+	}
+	seen := map[uint32]bool{stab.GroupId: true}
+	for _, gid := range gids {
+		if gid == 0 || seen[gid] {
+			return nil, x.Errorf("Group %d already serves predicate %s", gid, stab.Predicate)
+		}
+		seen[gid] = true
+	}
+	if len(starts) == 0 {
+		step := maxUid / uint64(len(gids)+1)
+		if step == 0 {
+			return nil, x.Errorf("Not enough uids to split predicate %s across %d groups",
+				stab.Predicate, len(gids)+1)
+		}
+		for i := range gids {
+			starts = append(starts, step*uint64(i+1)+1)
+		}
+	}
+	if len(starts) != len(gids) {
+		return nil, x.Errorf("Got %d uids to split predicate %s at, for %d groups",
+			len(starts), stab.Predicate, len(gids))
+	}
+	tab := &intern.Tablet{
+		GroupId:   stab.GroupId,
+		Predicate: stab.Predicate,
+		Space:     stab.Space,
+		ReadOnly:  true,
+		Force:     true,
+	}
+	for i, start := range starts {
+		if start == 0 || (i > 0 && start <= starts[i-1]) {
+			return nil, x.Errorf("Uids to split predicate %s at must be increasing and positive",
+				stab.Predicate)
+		}
+		tab.Splits = append(tab.Splits, &intern.TabletSplit{StartUid: start, GroupId: gids[i]})
+	}
+	return tab, nil
+}
+
+func (s *Server) splitPredicateHelper(ctx context.Context, stab *intern.Tablet,
+	split *intern.Tablet) error {
+	n := s.Node
+	// Propose that predicate is read only.
+	p := &intern.ZeroProposal{}
+	p.Tablet = &intern.Tablet{
+		GroupId:   stab.GroupId,
+		Predicate: stab.Predicate,
+		Space:     stab.Space,
+		ReadOnly:  true,
+		Force:     true,
+	}
 	if err := n.proposeAndWait(ctx, p); err != nil {
 		return err
 	}
-	// TODO: Probably make it R in dstGroup and send state to srcGroup and only after
-	// it proposes make it RW in dstGroup. That way we won't have stale reads from srcGroup
-	// for sure.
-	return nil
+
+	worker := func(gid uint32) (intern.WorkerClient, error) {
+		pl := s.Leader(gid)
+		if pl == nil {
+			return nil, x.Errorf("No healthy connection found to leader of group %d", gid)
+		}
+		return intern.NewWorkerClient(pl.Get()), nil
+	}
+	c, err := worker(stab.GroupId)
+	if err != nil {
+		return err
+	}
+	for i, sp := range split.Splits {
+		in := &intern.MovePredicatePayload{
+			Predicate:     stab.Predicate,
+			State:         s.membershipState(),
+			SourceGroupId: stab.GroupId,
+			DestGroupId:   sp.GroupId,
+			StartUid:      sp.StartUid,
+		}
+		if i+1 < len(split.Splits) {
+			in.EndUid = split.Splits[i+1].StartUid
+		}
+		x.Printf("Moving uids [%d, %d) of predicate %v from %d to %d\n", in.StartUid, in.EndUid,
+			stab.Predicate, stab.GroupId, sp.GroupId)
+		if _, err := c.MovePredicate(ctx, in); err != nil {
+			return err
+		}
+	}
+
+	// The indexes are rebuilt as of a timestamp after all the data was written.
+	ts, err := s.Timestamps(ctx, &intern.Num{Val: 1})
+	if err != nil {
+		return err
+	}
+	splitIn := func(gid uint32) error {
+		c, err := worker(gid)
+		if err != nil {
+			return err
+		}
+		_, err = c.SplitPredicate(ctx, &intern.SplitPredicatePayload{
+			Predicate: stab.Predicate,
+			GroupId:   gid,
+			Tablet:    split,
+			ReadTs:    ts.StartId,
+		})
+		return err
+	}
+	for _, sp := range split.Splits {
+		if err := splitIn(sp.GroupId); err != nil {
+			return err
+		}
+	}
+
+	p.Tablet = split
+	if err := n.proposeAndWait(ctx, p); err != nil {
+		return err
+	}
+	return s.finishSplit(ctx, split)
+}
+
+// finishSplit tells the group serving the lowest uids of the split tablet to drop the data of
+// the other uids and rebuild its indexes, and then proposes that the predicate is RW. Until
+// then the predicate stays read-only, as the group still has the data of every uid, and reads
+// only take the uids it serves from it.
+func (s *Server) finishSplit(ctx context.Context, split *intern.Tablet) error {
+	ts, err := s.Timestamps(ctx, &intern.Num{Val: 1})
+	if err != nil {
+		return err
+	}
+	pl := s.Leader(split.GroupId)
+	if pl == nil {
+		return x.Errorf("No healthy connection found to leader of group %d", split.GroupId)
+	}
+	c := intern.NewWorkerClient(pl.Get())
+	_, err = c.SplitPredicate(ctx, &intern.SplitPredicatePayload{
+		Predicate: split.Predicate,
+		GroupId:   split.GroupId,
+		Tablet:    split,
+		ReadTs:    ts.StartId,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Propose that the split predicate is RW.
+	p := &intern.ZeroProposal{}
+	p.Tablet = &intern.Tablet{
+		GroupId:   split.GroupId,
+		Predicate: split.Predicate,
+		Space:     split.Space,
+		Force:     true,
+		Splits:    split.Splits,
+	}
+	return s.Node.proposeAndWait(ctx, p)
 }
@@ -65,6 +65,14 @@ type Server struct {
 	nextGroup      uint32
 	leaderChangeCh chan struct{}
 	shutDownCh     chan struct{} // Used to tell stream to close.
+
+	rebalance  rebalancePolicy
+	nextMoveId uint64
+	movesRun   map[uint64]bool // The moves run by this Zero, by id.
+
+	moveRate    uint64 // Bytes per second sent while moving a tablet, zero for no limit.
+	moveCtx     context.Context
+	cancelMoves context.CancelFunc
 }
 
 func (s *Server) Init() {
@@ -84,6 +92,7 @@ func (s *Server) Init() {
 	s.shutDownCh = make(chan struct{}, 1)
 	go s.rebalanceTablets()
 	go s.purgeOracle()
+	go s.markTimestamps()
 }
 
 func (s *Server) triggerLeaderChange() {
@@ -193,7 +202,10 @@ func (s *Server) MarshalMembershipState() ([]byte, error) {
 func (s *Server) membershipState() *intern.MembershipState {
 	s.RLock()
 	defer s.RUnlock()
-	return proto.Clone(s.state).(*intern.MembershipState)
+	// The marks of timestamps are only read by Zero, and would make every update large.
+	state := *s.state
+	state.TsMarks = nil
+	return proto.Clone(&state).(*intern.MembershipState)
 }
 
 func (s *Server) storeZero(m *intern.Member) {
@@ -284,9 +296,9 @@ func (s *Server) createProposals(dst *intern.Group) ([]*intern.ZeroProposal, err
 			continue
 		}
 
-		s := float64(srcTablet.Space)
-		d := float64(dstTablet.Space)
-		if dstTablet.Remove || (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1) {
+		if dstTablet.Remove || changed(float64(srcTablet.Space), float64(dstTablet.Space)) ||
+			changed(srcTablet.ReadQps, dstTablet.ReadQps) ||
+			changed(srcTablet.WriteQps, dstTablet.WriteQps) {
 			dstTablet.Force = false
 			proposal := &intern.ZeroProposal{
 				Tablet: dstTablet,
@@ -297,6 +309,11 @@ func (s *Server) createProposals(dst *intern.Group) ([]*intern.ZeroProposal, err
 	return res, nil
 }
 
+// changed returns whether the size or the load reported for a tablet changed by more than 10%.
+func changed(src, dst float64) bool {
+	return (src == 0 && dst > 0) || (src > 0 && math.Abs(dst/src-1) > 0.1)
+}
+
 // Its users responsibility to ensure that node doesn't come back again before calling the api.
 func (s *Server) removeNode(ctx context.Context, nodeId uint64, groupId uint32) error {
 	if groupId == 0 {
@@ -0,0 +1,654 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Users and groups are nodes holding these predicates. The rules of a group are a JSON list
+// of aclRule.
+const (
+	aclXid        = "dgraph.xid"
+	aclPassword   = "dgraph.password"
+	aclUserGroup  = "dgraph.user.group"
+	aclGroupRules = "dgraph.group.acl"
+
+	// Predicates starting with this are only open to guardians.
+	aclReservedPrefix = "dgraph."
+
+	// Members of the guardians group can do anything. groot is created in it at first, with
+	// the password read from --acl_password_file.
+	guardiansGroup = "guardians"
+	grootUser      = "groot"
+
+	aclSchema = `
+		dgraph.xid: string @index(exact) .
+		dgraph.password: password .
+		dgraph.user.group: uid @reverse .
+		dgraph.group.acl: string .
+	`
+
+	// Rules changed through other servers are picked up after this long.
+	aclRefreshInterval = 30 * time.Second
+	minAclSecretLen    = 32
+	minPasswordLen     = 6
+)
+
+// Permissions granted by a rule. They can be combined, like 6 for read and write.
+const (
+	readPerm   int32 = 4 // Query the predicate.
+	writePerm  int32 = 2 // Mutate the predicate.
+	modifyPerm int32 = 1 // Alter the schema of the predicate, or drop it.
+)
+
+func permName(perm int32) string {
+	switch perm {
+	case readPerm:
+		return "read"
+	case writePerm:
+		return "write"
+	case modifyPerm:
+		return "modify"
+	}
+	return fmt.Sprintf("%d", perm)
+}
+
+var (
+	// Secret signing the access tokens. Access control is off while it's empty.
+	aclSecret []byte
+	// Password groot is created with.
+	grootPassword string
+
+	errInvalidToken = status.Error(codes.Unauthenticated, "Invalid access token")
+	errNoToken      = status.Error(codes.Unauthenticated,
+		"Access control is enabled. Login, and send the access token with the request")
+	errInvalidLogin = status.Error(codes.Unauthenticated, "Invalid userid or password")
+)
+
+func aclEnabled() bool {
+	return len(aclSecret) > 0
+}
+
+func loadAclSecret(path string) {
+	if path == "" {
+		return
+	}
+	secret, err := ioutil.ReadFile(path)
+	x.Checkf(err, "Error while reading ACL secret file")
+	secret = []byte(strings.TrimSpace(string(secret)))
+	x.AssertTruefNoTrace(len(secret) >= minAclSecretLen,
+		"ACL secret (--acl_secret_file) must be at least %d bytes long", minAclSecretLen)
+	aclSecret = secret
+}
+
+func loadGrootPassword(path string) {
+	x.AssertTruefNoTrace(path != "",
+		"Access control needs the initial password of %s in --acl_password_file", grootUser)
+	password, err := ioutil.ReadFile(path)
+	x.Checkf(err, "Error while reading %s password file", grootUser)
+	grootPassword = strings.TrimSpace(string(password))
+	x.AssertTruefNoTrace(len(grootPassword) >= minPasswordLen,
+		"Password of %s (--acl_password_file) must be at least %d characters long",
+		grootUser, minPasswordLen)
+}
+
+// aclRule grants perm on a predicate, or on every predicate starting with the prefix before
+// a trailing *. A rule for * alone is needed for S * * deletions and expand().
+type aclRule struct {
+	Predicate string `json:"predicate"`
+	Perm      int32  `json:"perm"`
+}
+
+// matches takes the attribute of a predicate, which has its namespace like the predicate of
+// the rule does once read from the cluster. Rules never match predicates of other namespaces.
+func (r aclRule) matches(attr string) bool {
+	ruleNs, rulePred := x.ParseNamespaceAttr(r.Predicate)
+	ns, pred := x.ParseNamespaceAttr(attr)
+	if ruleNs != ns {
+		return false
+	}
+	if strings.HasSuffix(rulePred, "*") {
+		return strings.HasPrefix(pred, strings.TrimSuffix(rulePred, "*"))
+	}
+	return rulePred == pred
+}
+
+// accessControl holds the rules of every group, as last read from the cluster. Groups and
+// the predicates of their rules are keyed by their attribute in the namespace of the group.
+type accessControl struct {
+	sync.RWMutex
+	rules   map[string][]aclRule
+	changed chan struct{}
+}
+
+var acls = accessControl{changed: make(chan struct{}, 1)}
+
+func (ac *accessControl) allowed(ns uint64, groups []string, pred string, perm int32) bool {
+	attr := x.NamespaceAttr(ns, pred)
+	ac.RLock()
+	defer ac.RUnlock()
+	for _, group := range groups {
+		for _, rule := range ac.rules[x.NamespaceAttr(ns, group)] {
+			if rule.Perm&perm == perm && rule.matches(attr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// notify makes runAccessControl read the rules again right away.
+func (ac *accessControl) notify() {
+	select {
+	case ac.changed <- struct{}{}:
+	default:
+	}
+}
+
+// refresh reads the rules of the groups of every namespace having some.
+func (ac *accessControl) refresh(ctx context.Context) error {
+	namespaces, err := worker.PredicateNamespaces(ctx, aclGroupRules)
+	if err != nil {
+		return err
+	}
+	rules := make(map[string][]aclRule)
+	for _, ns := range namespaces {
+		if err := readRules(x.WithNamespace(ctx, ns), rules); err != nil {
+			return err
+		}
+	}
+	ac.Lock()
+	ac.rules = rules
+	ac.Unlock()
+	return nil
+}
+
+// readRules adds the rules of the groups in the namespace of ctx to rules.
+func readRules(ctx context.Context, rules map[string][]aclRule) error {
+	js, err := queryJSON(ctx, fmt.Sprintf(`{
+		groups(func: has(<%s>)) {
+			<%s>
+			<%s>
+		}
+	}`, aclGroupRules, aclXid, aclGroupRules), nil)
+	if err != nil {
+		return err
+	}
+	var res struct {
+		Groups []struct {
+			Xid   string `json:"dgraph.xid"`
+			Rules string `json:"dgraph.group.acl"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal(js, &res); err != nil {
+		return err
+	}
+	ns := x.Namespace(ctx)
+	for _, group := range res.Groups {
+		var rs []aclRule
+		if err := json.Unmarshal([]byte(group.Rules), &rs); err != nil {
+			x.Printf("Ignoring invalid rules of group %s in namespace %d: %v\n",
+				group.Xid, ns, err)
+			continue
+		}
+		for i := range rs {
+			rs[i].Predicate = x.NamespaceAttr(ns, rs[i].Predicate)
+		}
+		key := x.NamespaceAttr(ns, group.Xid)
+		rules[key] = append(rules[key], rs...)
+	}
+	return nil
+}
+
+// accessClaims are signed into the access tokens. Users and groups belong to the namespace
+// the user logged into.
+type accessClaims struct {
+	Userid    string   `json:"userid"`
+	Groups    []string `json:"groups"`
+	Namespace uint64   `json:"namespace"`
+	Expiry    int64    `json:"exp"` // Unix time in seconds.
+}
+
+func (c *accessClaims) isGuardian() bool {
+	for _, group := range c.Groups {
+		if group == guardiansGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// Tokens are the claims as JSON and their HMAC-SHA256, each base64 encoded, joined by a dot.
+func signToken(c *accessClaims) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	p := base64.RawURLEncoding.EncodeToString(payload)
+	return p + "." + base64.RawURLEncoding.EncodeToString(tokenMac(p)), nil
+}
+
+func tokenMac(payload string) []byte {
+	mac := hmac.New(sha256.New, aclSecret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func parseToken(token string) (*accessClaims, error) {
+	idx := strings.LastIndexByte(token, '.')
+	if idx < 0 {
+		return nil, errInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(token[idx+1:])
+	if err != nil || !hmac.Equal(sig, tokenMac(token[:idx])) {
+		return nil, errInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(token[:idx])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var c accessClaims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, errInvalidToken
+	}
+	if time.Now().Unix() >= c.Expiry {
+		return nil, status.Error(codes.Unauthenticated, "Access token has expired. Login again")
+	}
+	return &c, nil
+}
+
+// accessToken returns the token sent with the request. gRPC clients pass it as metadata, and
+// the HTTP handlers attach it to the context.
+func accessToken(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md["accesstoken"]; len(v) > 0 {
+			return v[0]
+		}
+	}
+	token, _ := ctx.Value("accesstoken").(string)
+	return token
+}
+
+// asInternal marks requests made by the server itself, which access control lets through.
+func asInternal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, "_internal_", true)
+}
+
+func isInternal(ctx context.Context) bool {
+	internal, _ := ctx.Value("_internal_").(bool)
+	return internal
+}
+
+func claimsFrom(ctx context.Context) (*accessClaims, error) {
+	token := accessToken(ctx)
+	if token == "" {
+		return nil, errNoToken
+	}
+	return parseToken(token)
+}
+
+// Authenticate checks that the request came with a valid access token, if access control is
+// enabled.
+func Authenticate(ctx context.Context) error {
+	if !aclEnabled() || isInternal(ctx) {
+		return nil
+	}
+	_, err := claimsFrom(ctx)
+	return err
+}
+
+// authorize checks that the user who sent the request has perm on every one of preds.
+func authorize(ctx context.Context, perm int32, preds []string) error {
+	if !aclEnabled() || isInternal(ctx) {
+		return nil
+	}
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return err
+	}
+	if claims.isGuardian() {
+		return nil
+	}
+	ns := x.Namespace(ctx)
+	for _, pred := range preds {
+		if strings.HasPrefix(pred, aclReservedPrefix) ||
+			!acls.allowed(ns, claims.Groups, pred, perm) {
+			return status.Errorf(codes.PermissionDenied,
+				"User %s doesn't have %s permission on predicate %s",
+				claims.Userid, permName(perm), pred)
+		}
+	}
+	return nil
+}
+
+func authorizeGuardian(ctx context.Context) error {
+	if !aclEnabled() || isInternal(ctx) {
+		return nil
+	}
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return err
+	}
+	if !claims.isGuardian() {
+		return status.Errorf(codes.PermissionDenied,
+			"Only members of the %s group can do this", guardiansGroup)
+	}
+	return nil
+}
+
+// hideReserved leaves the reserved predicates out of those the server finds on behalf of users
+// who aren't guardians, so that expand(), S * * deletions and subscriptions don't reach them.
+func hideReserved(ctx context.Context) context.Context {
+	if !aclEnabled() || isInternal(ctx) {
+		return ctx
+	}
+	if claims, err := claimsFrom(ctx); err != nil || claims.isGuardian() {
+		return ctx
+	}
+	return x.WithPredicateFilter(ctx, func(attr string) bool {
+		_, pred := x.ParseNamespaceAttr(strings.TrimPrefix(attr, "~"))
+		return !strings.HasPrefix(pred, aclReservedPrefix)
+	})
+}
+
+func authorizeQuery(ctx context.Context, parsed *gql.Result) error {
+	preds := queryPredicates(parsed)
+	if err := checkPredicateNames(preds...); err != nil {
+		return err
+	}
+	return authorize(ctx, readPerm, preds)
+}
+
+// queryPredicates returns the predicates read by the query. Reading through expand() counts
+// as reading x.Star, as any predicate could be returned.
+func queryPredicates(parsed *gql.Result) []string {
+	var preds []string
+	seen := make(map[string]bool)
+	add := func(pred string) {
+		pred = strings.TrimPrefix(pred, "~")
+		if pred != "" && !seen[pred] {
+			seen[pred] = true
+			preds = append(preds, pred)
+		}
+	}
+	addFunc := func(f *gql.Function) {
+		if f != nil && !f.IsValueVar {
+			add(f.Attr)
+		}
+	}
+	var addFilter func(ft *gql.FilterTree)
+	addFilter = func(ft *gql.FilterTree) {
+		if ft == nil {
+			return
+		}
+		addFunc(ft.Func)
+		for _, child := range ft.Child {
+			addFilter(child)
+		}
+	}
+	var addQuery func(gq *gql.GraphQuery)
+	addQuery = func(gq *gql.GraphQuery) {
+		switch {
+		case gq.Expand != "":
+			add(x.Star)
+		case !gq.IsInternal && gq.Attr != "uid":
+			add(gq.Attr)
+		}
+		addFunc(gq.Func)
+		addFilter(gq.Filter)
+		vars := make(map[string]bool)
+		for _, v := range gq.NeedsVar {
+			vars[v.Name] = true
+		}
+		for _, order := range gq.Order {
+			// Ordering by a value variable puts its name in here.
+			if !vars[order.Attr] {
+				add(order.Attr)
+			}
+		}
+		for _, attr := range gq.GroupbyAttrs {
+			add(attr.Attr)
+		}
+		for _, child := range gq.Children {
+			addQuery(child)
+		}
+	}
+	for _, gq := range parsed.Query {
+		addQuery(gq)
+	}
+	return preds
+}
+
+// readableSchema drops the predicates the user can't read from a schema query's result.
+func readableSchema(ctx context.Context, nodes []*api.SchemaNode) []*api.SchemaNode {
+	var res []*api.SchemaNode
+	for _, node := range nodes {
+		if authorize(ctx, readPerm, []string{node.Predicate}) == nil {
+			res = append(res, node)
+		}
+	}
+	return res
+}
+
+func mutationPredicates(edges []*intern.DirectedEdge) []string {
+	var preds []string
+	seen := make(map[string]bool)
+	for _, edge := range edges {
+		if !seen[edge.Attr] {
+			seen[edge.Attr] = true
+			preds = append(preds, edge.Attr)
+		}
+	}
+	return preds
+}
+
+// queryJSON runs a query on behalf of the server, without checking permissions, and returns
+// its result as JSON. Unlike Server.query, it doesn't trace the variables, which can hold
+// passwords.
+func queryJSON(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	parsed, err := gql.Parse(gql.Request{Str: q, Variables: vars})
+	if err != nil {
+		return nil, err
+	}
+	var l query.Latency
+	qr := query.QueryRequest{
+		Latency:  &l,
+		GqlQuery: &parsed,
+		ReadTs:   State.getTimestamp(),
+	}
+	er, err := qr.Process(ctx)
+	if err != nil {
+		return nil, x.Wrap(err)
+	}
+	return query.ToJson(&l, er.Subgraphs)
+}
+
+// Login checks the password of a user of the namespace of the request, and returns an access
+// token holding its namespace and groups. Changes to the groups of a user apply to the tokens
+// issued after them.
+func (s *Server) Login(ctx context.Context, req *api.LoginRequest) (*api.LoginResponse, error) {
+	ctx, err := withNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ae := newAuditRecord(ctx, "login")
+	if ae != nil {
+		ae.User = req.Userid
+	}
+	resp, err := s.login(ctx, req)
+	ae.log(err)
+	return resp, err
+}
+
+func (s *Server) login(ctx context.Context, req *api.LoginRequest) (*api.LoginResponse, error) {
+	if err := x.HealthCheck(); err != nil {
+		return nil, err
+	}
+	if !aclEnabled() {
+		return nil, x.Errorf("Access control isn't enabled. Set --acl_secret_file to enable it")
+	}
+	if req.Userid == "" {
+		return nil, errInvalidLogin
+	}
+	js, err := queryJSON(ctx, fmt.Sprintf(`query login($userid: string, $password: string) {
+		user(func: eq(<%s>, $userid)) @filter(has(<%s>)) {
+			checkpwd(<%s>, $password)
+			<%s> {
+				<%s>
+			}
+		}
+	}`, aclXid, aclPassword, aclPassword, aclUserGroup, aclXid),
+		map[string]string{"$userid": req.Userid, "$password": req.Password})
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		User []struct {
+			Password []struct {
+				Check bool `json:"checkpwd"`
+			} `json:"dgraph.password"`
+			Groups []struct {
+				Xid string `json:"dgraph.xid"`
+			} `json:"dgraph.user.group"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal(js, &res); err != nil {
+		return nil, err
+	}
+	if len(res.User) != 1 || len(res.User[0].Password) == 0 || !res.User[0].Password[0].Check {
+		return nil, errInvalidLogin
+	}
+
+	claims := &accessClaims{
+		Userid:    req.Userid,
+		Namespace: x.Namespace(ctx),
+		Expiry:    time.Now().Add(Config.AclAccessTtl).Unix(),
+	}
+	for _, group := range res.User[0].Groups {
+		claims.Groups = append(claims.Groups, group.Xid)
+	}
+	token, err := signToken(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &api.LoginResponse{AccessToken: token, ExpiresAt: claims.Expiry}, nil
+}
+
+// runAccessControl sets up the schema of the users and groups, and the groot user, once the
+// server is up. It then keeps the rules of the groups up to date.
+func (s *Server) runAccessControl() {
+	ctx := asInternal(context.Background())
+	for {
+		if err := x.HealthCheck(); err == nil {
+			if err = s.initAccessControl(ctx); err == nil {
+				break
+			}
+			x.Printf("Error while setting up access control: %v. Will retry...\n", err)
+		}
+		time.Sleep(time.Second)
+	}
+
+	ticker := time.NewTicker(aclRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := acls.refresh(ctx); err != nil {
+			x.Printf("Error while reading access control rules: %v\n", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-acls.changed:
+		}
+	}
+}
+
+func (s *Server) initAccessControl(ctx context.Context) error {
+	preds := []string{aclXid, aclPassword, aclUserGroup, aclGroupRules}
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &intern.SchemaRequest{Predicates: preds})
+	if err != nil {
+		return err
+	}
+	if len(nodes) < len(preds) {
+		if _, err := s.Alter(ctx, &api.Operation{Schema: aclSchema}); err != nil {
+			return err
+		}
+	}
+
+	js, err := queryJSON(ctx, fmt.Sprintf(`{
+		groot(func: eq(<%s>, %q)) { uid }
+		guardians(func: eq(<%s>, %q)) { uid }
+	}`, aclXid, grootUser, aclXid, guardiansGroup), nil)
+	if err != nil {
+		return err
+	}
+	type node struct {
+		Uid string `json:"uid"`
+	}
+	var res struct {
+		Groot     []node `json:"groot"`
+		Guardians []node `json:"guardians"`
+	}
+	if err := json.Unmarshal(js, &res); err != nil {
+		return err
+	}
+	if len(res.Groot) > 0 {
+		return nil
+	}
+
+	str := func(s string) *api.Value {
+		return &api.Value{&api.Value_StrVal{s}}
+	}
+	guardians := "_:guardians"
+	var nqs []*api.NQuad
+	if len(res.Guardians) > 0 {
+		guardians = res.Guardians[0].Uid
+	} else {
+		nqs = append(nqs, &api.NQuad{Subject: guardians, Predicate: aclXid,
+			ObjectValue: str(guardiansGroup)})
+	}
+	nqs = append(nqs,
+		&api.NQuad{Subject: "_:groot", Predicate: aclXid, ObjectValue: str(grootUser)},
+		&api.NQuad{Subject: "_:groot", Predicate: aclPassword, ObjectValue: str(grootPassword)},
+		&api.NQuad{Subject: "_:groot", Predicate: aclUserGroup, ObjectId: guardians})
+	// Servers starting together conflict on the index of dgraph.xid, and retry.
+	if _, err := s.Mutate(ctx, &api.Mutation{Set: nqs, CommitNow: true}); err != nil {
+		return err
+	}
+	x.Printf("Created user %s in group %s.\n", grootUser, guardiansGroup)
+	return nil
+}
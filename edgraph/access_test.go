@@ -0,0 +1,221 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func withAcl(t *testing.T, rules map[string][]aclRule) func() {
+	aclSecret = []byte("0123456789abcdef0123456789abcdef")
+	acls.rules = rules
+	return func() {
+		aclSecret = nil
+		acls.rules = nil
+	}
+}
+
+func userCtx(t *testing.T, userid string, groups ...string) context.Context {
+	return nsUserCtx(t, 0, "", userid, groups...)
+}
+
+// nsUserCtx returns the context of a request to namespace reqNs, or to none if it's empty, by a
+// user of namespace ns.
+func nsUserCtx(t *testing.T, ns uint64, reqNs string, userid string,
+	groups ...string) context.Context {
+	token, err := signToken(&accessClaims{
+		Userid:    userid,
+		Groups:    groups,
+		Namespace: ns,
+		Expiry:    time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	md := metadata.Pairs("accesstoken", token)
+	if reqNs != "" {
+		md.Set("namespace", reqNs)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestAccessToken(t *testing.T) {
+	defer withAcl(t, nil)()
+
+	claims := &accessClaims{
+		Userid: "alice",
+		Groups: []string{"dev", "ops"},
+		Expiry: time.Now().Add(time.Minute).Unix(),
+	}
+	token, err := signToken(claims)
+	require.NoError(t, err)
+	parsed, err := parseToken(token)
+	require.NoError(t, err)
+	require.Equal(t, claims, parsed)
+
+	_, err = parseToken(token[:len(token)-2])
+	require.Equal(t, errInvalidToken, err)
+	_, err = parseToken("x" + token)
+	require.Equal(t, errInvalidToken, err)
+
+	aclSecret = []byte("another secret, also 32 bytes long")
+	_, err = parseToken(token)
+	require.Equal(t, errInvalidToken, err)
+}
+
+func TestAccessTokenExpired(t *testing.T) {
+	defer withAcl(t, nil)()
+
+	token, err := signToken(&accessClaims{
+		Userid: "alice",
+		Expiry: time.Now().Add(-time.Second).Unix(),
+	})
+	require.NoError(t, err)
+	_, err = parseToken(token)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAclRuleMatches(t *testing.T) {
+	require.True(t, aclRule{Predicate: "name"}.matches("name"))
+	require.False(t, aclRule{Predicate: "name"}.matches("names"))
+	require.True(t, aclRule{Predicate: "user.*"}.matches("user.email"))
+	require.False(t, aclRule{Predicate: "user.*"}.matches("username"))
+	require.True(t, aclRule{Predicate: "*"}.matches(x.Star))
+	require.True(t, aclRule{Predicate: "*"}.matches("name"))
+	require.False(t, aclRule{Predicate: "name"}.matches(x.Star))
+
+	require.False(t, aclRule{Predicate: "name"}.matches(x.NamespaceAttr(7, "name")))
+	require.False(t, aclRule{Predicate: "*"}.matches(x.NamespaceAttr(7, "name")))
+	require.True(t, aclRule{Predicate: x.NamespaceAttr(7, "*")}.matches(
+		x.NamespaceAttr(7, "name")))
+	require.False(t, aclRule{Predicate: x.NamespaceAttr(7, "name")}.matches("name"))
+}
+
+func TestAuthorize(t *testing.T) {
+	defer withAcl(t, map[string][]aclRule{
+		"dev": {{Predicate: "name", Perm: readPerm | writePerm}},
+		"ops": {{Predicate: "server.*", Perm: readPerm | modifyPerm}},
+	})()
+
+	ctx := userCtx(t, "alice", "dev", "ops")
+	require.NoError(t, authorize(ctx, readPerm, []string{"name", "server.ip"}))
+	require.NoError(t, authorize(ctx, writePerm, []string{"name"}))
+	require.NoError(t, authorize(ctx, modifyPerm, []string{"server.ip"}))
+
+	err := authorize(ctx, writePerm, []string{"name", "server.ip"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = authorize(ctx, readPerm, []string{aclPassword})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, codes.PermissionDenied, status.Code(authorizeGuardian(ctx)))
+
+	bob := userCtx(t, "bob", "dev")
+	err = authorize(bob, readPerm, []string{"server.ip"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	groot := userCtx(t, grootUser, guardiansGroup)
+	require.NoError(t, authorize(groot, modifyPerm, []string{aclPassword, x.Star}))
+	require.NoError(t, authorizeGuardian(groot))
+
+	err = authorize(context.Background(), readPerm, []string{"name"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.NoError(t, authorize(asInternal(context.Background()), readPerm, []string{"name"}))
+}
+
+func TestAuthorizeDisabled(t *testing.T) {
+	require.NoError(t, authorize(context.Background(), modifyPerm, []string{aclPassword}))
+	require.NoError(t, authorizeGuardian(context.Background()))
+	require.NoError(t, Authenticate(context.Background()))
+}
+
+func TestQueryPredicates(t *testing.T) {
+	res, err := gql.Parse(gql.Request{Str: `{
+		me(func: eq(name, "alice"), orderasc: age) @filter(has(friend) or le(age, 30)) {
+			uid
+			name
+			~friend @groupby(city) {
+				count(uid)
+			}
+			a as age
+		}
+		you(func: uid(1), orderdesc: val(a)) {
+			expand(_all_)
+		}
+	}`})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "friend", "age", "city", x.Star},
+		queryPredicates(&res))
+}
+
+func TestAuthorizeNamespaces(t *testing.T) {
+	defer withAcl(t, map[string][]aclRule{
+		"dev":                     {{Predicate: "name", Perm: readPerm}},
+		x.NamespaceAttr(7, "dev"): {{Predicate: x.NamespaceAttr(7, "age"), Perm: readPerm}},
+	})()
+
+	ctx, err := withNamespace(nsUserCtx(t, 7, "", "alice", "dev"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), x.Namespace(ctx))
+	require.NoError(t, authorize(ctx, readPerm, []string{"age"}))
+	err = authorize(ctx, readPerm, []string{"name"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The rules of dev in the default namespace don't apply to dev in namespace 7.
+	ctx, err = withNamespace(nsUserCtx(t, 0, "", "bob", "dev"))
+	require.NoError(t, err)
+	require.NoError(t, authorize(ctx, readPerm, []string{"name"}))
+	err = authorize(ctx, readPerm, []string{"age"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Users can't pick another namespace than the one they logged into.
+	_, err = withNamespace(nsUserCtx(t, 7, "3", "alice", "dev"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = withNamespace(nsUserCtx(t, 0, "7", "bob", "dev"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = withNamespace(nsUserCtx(t, 7, "0", "groot", guardiansGroup))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	ctx, err = withNamespace(nsUserCtx(t, 7, "7", "alice", "dev"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), x.Namespace(ctx))
+
+	// Except for the guardians of the default namespace.
+	ctx, err = withNamespace(nsUserCtx(t, 0, "7", grootUser, guardiansGroup))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), x.Namespace(ctx))
+}
+
+func TestHideReserved(t *testing.T) {
+	defer withAcl(t, map[string][]aclRule{"dev": {{Predicate: x.Star, Perm: readPerm}}})()
+
+	ctx := hideReserved(userCtx(t, "alice", "dev"))
+	require.True(t, x.PredicateAllowed(ctx, "name"))
+	require.False(t, x.PredicateAllowed(ctx, aclPassword))
+	require.False(t, x.PredicateAllowed(ctx, "~"+aclUserGroup))
+	require.False(t, x.PredicateAllowed(ctx, x.NamespaceAttr(7, aclPassword)))
+
+	groot := hideReserved(userCtx(t, grootUser, guardiansGroup))
+	require.True(t, x.PredicateAllowed(groot, aclPassword))
+}
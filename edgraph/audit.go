@@ -0,0 +1,258 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Requests served by this server are written to the audit log, when set.
+var auditLog *x.AuditLog
+
+func SetAuditLog(a *x.AuditLog) {
+	auditLog = a
+}
+
+// auditRecord is filled in while a request runs, and logged once it's done. It's nil when the
+// request isn't audited, which makes its methods no-ops.
+type auditRecord struct {
+	x.AuditEntry
+}
+
+// newAuditRecord starts the record of a request. Requests made by the server itself aren't
+// audited.
+func newAuditRecord(ctx context.Context, op string) *auditRecord {
+	if auditLog == nil || isInternal(ctx) {
+		return nil
+	}
+	r := &auditRecord{x.AuditEntry{
+		Time:      time.Now(),
+		Client:    clientAddr(ctx),
+		Operation: op,
+	}}
+	if aclEnabled() {
+		if claims, err := claimsFrom(ctx); err == nil {
+			r.User = claims.Userid
+		}
+	}
+	return r
+}
+
+// clientAddr returns the address of the gRPC peer, or the one the HTTP handlers attached.
+func clientAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	addr, _ := ctx.Value("clientaddr").(string)
+	return addr
+}
+
+func (r *auditRecord) setTs(startTs, commitTs uint64) {
+	if r == nil {
+		return
+	}
+	r.StartTs, r.CommitTs = startTs, commitTs
+}
+
+func (r *auditRecord) setRequest(req interface{}) {
+	if r == nil {
+		return
+	}
+	r.Request = req
+}
+
+func (r *auditRecord) setQuery(q string, vars map[string]string) {
+	if r == nil {
+		return
+	}
+	r.Request = auditQuery(q, vars)
+}
+
+func (r *auditRecord) setMutation(ctx context.Context, gmu *gql.Mutation) {
+	if r == nil {
+		return
+	}
+	var passwords map[string]bool
+	if auditLog.RedactPasswords {
+		nqs := make([]*api.NQuad, 0, len(gmu.Set)+len(gmu.Del))
+		passwords = passwordPredicates(ctx, append(append(nqs, gmu.Set...), gmu.Del...))
+	}
+	r.Request = map[string][]string{
+		"set":    auditNQuads(gmu.Set, passwords),
+		"delete": auditNQuads(gmu.Del, passwords),
+	}
+}
+
+func (r *auditRecord) log(err error) {
+	if r == nil {
+		return
+	}
+	r.SetOutcome(err)
+	auditLog.Log(&r.AuditEntry)
+}
+
+// Matches the password argument of checkpwd, either a string or a variable.
+var checkpwdRe = regexp.MustCompile(`(checkpwd\s*\(\s*[^,()]+,\s*)("(?:[^"\\]|\\.)*"|\$\w+)`)
+
+// auditQuery returns the query and its variables to log, without the passwords passed to
+// checkpwd when those are redacted.
+func auditQuery(q string, vars map[string]string) map[string]interface{} {
+	if auditLog.RedactPasswords {
+		redacted := make(map[string]bool)
+		q = checkpwdRe.ReplaceAllStringFunc(q, func(m string) string {
+			sub := checkpwdRe.FindStringSubmatch(m)
+			if strings.HasPrefix(sub[2], "$") {
+				redacted[sub[2]] = true
+				return m
+			}
+			return sub[1] + strconv.Quote(x.RedactedPassword)
+		})
+		if len(redacted) > 0 {
+			logged := make(map[string]string, len(vars))
+			for k, v := range vars {
+				if redacted[k] {
+					v = x.RedactedPassword
+				}
+				logged[k] = v
+			}
+			vars = logged
+		}
+	}
+	req := map[string]interface{}{"query": q}
+	if len(vars) > 0 {
+		req["variables"] = vars
+	}
+	return req
+}
+
+// passwordPredicates returns which predicates set with a value have the password type. The
+// schema of the predicates served by other groups is fetched from them. If that fails, all
+// of those are taken to be passwords.
+func passwordPredicates(ctx context.Context, nqs []*api.NQuad) map[string]bool {
+	passwords := make(map[string]bool)
+	var remote []string
+	seen := make(map[string]bool)
+	for _, nq := range nqs {
+		if nq.ObjectValue == nil || seen[nq.Predicate] {
+			continue
+		}
+		seen[nq.Predicate] = true
+		attr := x.NamespaceAttr(x.Namespace(ctx), nq.Predicate)
+		if typ, err := schema.State().TypeOf(attr); err == nil {
+			passwords[nq.Predicate] = typ == types.PasswordID
+		} else {
+			remote = append(remote, nq.Predicate)
+		}
+	}
+	if len(remote) == 0 {
+		return passwords
+	}
+	nodes, err := worker.GetSchemaOverNetwork(ctx,
+		&intern.SchemaRequest{Predicates: remote, Fields: []string{"type"}})
+	if err != nil {
+		for _, pred := range remote {
+			passwords[pred] = true
+		}
+		return passwords
+	}
+	for _, node := range nodes {
+		passwords[node.Predicate] = node.Type == types.PasswordID.Name()
+	}
+	return passwords
+}
+
+// auditNQuads returns the N-Quads in RDF. Passwords are replaced unless passwords is nil.
+func auditNQuads(nqs []*api.NQuad, passwords map[string]bool) []string {
+	out := make([]string, 0, len(nqs))
+	for _, nq := range nqs {
+		obj := auditNode(nq.ObjectId)
+		if nq.ObjectValue != nil {
+			val := auditValue(nq.ObjectValue)
+			_, isPassword := nq.ObjectValue.Val.(*api.Value_PasswordVal)
+			if passwords != nil && (isPassword || passwords[nq.Predicate]) {
+				val = x.RedactedPassword
+			}
+			obj = strconv.Quote(val)
+			if val == x.Star {
+				obj = "*"
+			}
+			if nq.Lang != "" {
+				obj += "@" + nq.Lang
+			}
+		}
+		pred := "<" + nq.Predicate + ">"
+		if nq.Predicate == x.Star {
+			pred = "*"
+		}
+		out = append(out, fmt.Sprintf("%s %s %s .", auditNode(nq.Subject), pred, obj))
+	}
+	return out
+}
+
+func auditNode(id string) string {
+	if id == x.Star {
+		return "*"
+	}
+	if strings.HasPrefix(id, "_:") {
+		return id
+	}
+	return "<" + id + ">"
+}
+
+func auditValue(v *api.Value) string {
+	switch val := v.Val.(type) {
+	case *api.Value_DefaultVal:
+		return val.DefaultVal
+	case *api.Value_StrVal:
+		return val.StrVal
+	case *api.Value_PasswordVal:
+		return val.PasswordVal
+	case *api.Value_IntVal:
+		return strconv.FormatInt(val.IntVal, 10)
+	case *api.Value_DoubleVal:
+		return strconv.FormatFloat(val.DoubleVal, 'g', -1, 64)
+	case *api.Value_BoolVal:
+		return strconv.FormatBool(val.BoolVal)
+	case *api.Value_UidVal:
+		return fmt.Sprintf("%#x", val.UidVal)
+	case *api.Value_BytesVal:
+		return fmt.Sprintf("%x", val.BytesVal)
+	case *api.Value_GeoVal:
+		return fmt.Sprintf("%x", val.GeoVal)
+	case *api.Value_DateVal:
+		return fmt.Sprintf("%x", val.DateVal)
+	case *api.Value_DatetimeVal:
+		return fmt.Sprintf("%x", val.DatetimeVal)
+	}
+	return ""
+}
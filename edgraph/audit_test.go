@@ -0,0 +1,70 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestAuditQuery(t *testing.T) {
+	auditLog = &x.AuditLog{RedactPasswords: true}
+	defer func() { auditLog = nil }()
+
+	q := `{
+		me(func: uid(1)) {
+			checkpwd(password, "secret")
+			other: checkpwd(pin , "1\"2")
+		}
+		you(func: uid(2)) @filter(checkpwd(password, $pwd)) { name }
+	}`
+	req := auditQuery(q, map[string]string{"$pwd": "secret", "$name": "alice"})
+	require.NotContains(t, req["query"], "secret")
+	require.NotContains(t, req["query"], `1\"2`)
+	require.Contains(t, req["query"], `checkpwd(password, "****")`)
+	require.Contains(t, req["query"], `checkpwd(password, $pwd)`)
+	require.Equal(t, map[string]string{"$pwd": "****", "$name": "alice"}, req["variables"])
+
+	auditLog.RedactPasswords = false
+	req = auditQuery(q, nil)
+	require.Equal(t, q, req["query"])
+	require.Nil(t, req["variables"])
+}
+
+func TestAuditNQuads(t *testing.T) {
+	str := func(s string) *api.Value { return &api.Value{Val: &api.Value_DefaultVal{DefaultVal: s}} }
+	nqs := []*api.NQuad{
+		{Subject: "_:a", Predicate: "name", ObjectValue: str("alice"), Lang: "en"},
+		{Subject: "_:a", Predicate: "secret", ObjectValue: str("pass")},
+		{Subject: "0x1", Predicate: "pin",
+			ObjectValue: &api.Value{Val: &api.Value_PasswordVal{PasswordVal: "1234"}}},
+		{Subject: "0x1", Predicate: "friend", ObjectId: "_:a"},
+		{Subject: "0x2", Predicate: x.Star, ObjectValue: str(x.Star)},
+	}
+	require.Equal(t, []string{
+		`_:a <name> "alice"@en .`,
+		`_:a <secret> "****" .`,
+		`<0x1> <pin> "****" .`,
+		`<0x1> <friend> _:a .`,
+		`<0x2> * * .`,
+	}, auditNQuads(nqs, map[string]bool{"name": false, "secret": true}))
+	require.Equal(t, `_:a <secret> "pass" .`, auditNQuads(nqs, nil)[1])
+}
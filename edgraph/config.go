@@ -19,6 +19,7 @@ package edgraph
 import (
 	"expvar"
 	"path/filepath"
+	"time"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/worker"
@@ -34,6 +35,10 @@ type Options struct {
 	AllottedMemory float64
 
 	ExportPath          string
+	BackupPath          string
+	CdcPath             string
+	WalArchivePath      string
+	WalArchiveRetention time.Duration
 	NumPendingProposals int
 	Tracing             float64
 	MyAddr              string
@@ -41,6 +46,28 @@ type Options struct {
 	RaftId              uint64
 	MaxPendingCount     uint64
 	ExpandEdge          bool
+	HistoryRetention    time.Duration
+	TTLPurgeInterval    time.Duration
+
+	// Access control is enabled when the secret file is set. The password file holds the
+	// password groot is created with.
+	AclSecretFile   string
+	AclPasswordFile string
+	AclAccessTtl    time.Duration
+
+	// Encrypts the postings and the WAL when set.
+	EncryptionKeyFile string
+	EncryptExports    bool
+
+	// A standby only takes the changes replicated from the primary cluster until promoted.
+	// ReplicateFrom lists the primary servers to tail, any of which streams the changes of
+	// the whole cluster.
+	Standby                 bool
+	ReplicateFrom           string
+	ReplicateSinceTs        uint64
+	ReplicationMaxLag       time.Duration
+	ReplicationUser         string
+	ReplicationPasswordFile string
 
 	DebugMode bool
 }
@@ -58,12 +85,32 @@ var DefaultConfig = Options{
 	AllottedMemory: -1.0,
 
 	ExportPath:          "export",
+	BackupPath:          "backup",
+	CdcPath:             "",
+	WalArchivePath:      "",
+	WalArchiveRetention: 0,
 	NumPendingProposals: 2000,
 	Tracing:             0.0,
 	MyAddr:              "",
 	ZeroAddr:            "localhost:7080",
 	MaxPendingCount:     1000,
 	ExpandEdge:          true,
+	HistoryRetention:    0,
+	TTLPurgeInterval:    10 * time.Minute,
+
+	AclSecretFile:   "",
+	AclPasswordFile: "",
+	AclAccessTtl:    6 * time.Hour,
+
+	EncryptionKeyFile: "",
+	EncryptExports:    false,
+
+	Standby:                 false,
+	ReplicateFrom:           "",
+	ReplicateSinceTs:        0,
+	ReplicationMaxLag:       0,
+	ReplicationUser:         "",
+	ReplicationPasswordFile: "",
 
 	DebugMode: false,
 }
@@ -103,11 +150,25 @@ func setConfVar(conf Options) {
 	x.Conf.Set("posting_dir", newStr(conf.PostingDir))
 	x.Conf.Set("posting_tables", newStr(conf.PostingTables))
 	x.Conf.Set("wal_dir", newStr(conf.WALDir))
+	x.Conf.Set("cdc", newStr(conf.CdcPath))
+	x.Conf.Set("wal_archive", newStr(conf.WalArchivePath))
+	x.Conf.Set("wal_archive_retention", newStr(conf.WalArchiveRetention.String()))
 	x.Conf.Set("allotted_memory", newFloat(conf.AllottedMemory))
 	x.Conf.Set("tracing", newFloat(conf.Tracing))
 	x.Conf.Set("max_pending_count", newInt(int(conf.MaxPendingCount)))
 	x.Conf.Set("num_pending_proposals", newInt(conf.NumPendingProposals))
 	x.Conf.Set("expand_edge", newIntFromBool(conf.ExpandEdge))
+	x.Conf.Set("history_retention", newStr(conf.HistoryRetention.String()))
+	x.Conf.Set("ttl_purge_interval", newStr(conf.TTLPurgeInterval.String()))
+	x.Conf.Set("acl_secret_file", newStr(conf.AclSecretFile))
+	x.Conf.Set("acl_password_file", newStr(conf.AclPasswordFile))
+	x.Conf.Set("acl_access_ttl", newStr(conf.AclAccessTtl.String()))
+	x.Conf.Set("encryption_key_file", newStr(conf.EncryptionKeyFile))
+	x.Conf.Set("encrypt_exports", newIntFromBool(conf.EncryptExports))
+	x.Conf.Set("standby", newIntFromBool(conf.Standby))
+	x.Conf.Set("replicate_from", newStr(conf.ReplicateFrom))
+	x.Conf.Set("replication_max_lag", newStr(conf.ReplicationMaxLag.String()))
+	x.Conf.Set("replication_user", newStr(conf.ReplicationUser))
 }
 
 func SetConfiguration(newConfig Options) {
@@ -118,8 +179,13 @@ func SetConfiguration(newConfig Options) {
 	posting.Config.Mu.Lock()
 	posting.Config.AllottedMemory = Config.AllottedMemory
 	posting.Config.Mu.Unlock()
+	posting.Config.HistoryRetention = Config.HistoryRetention
 
 	worker.Config.ExportPath = Config.ExportPath
+	worker.Config.BackupPath = Config.BackupPath
+	worker.Config.CdcPath = Config.CdcPath
+	worker.Config.WalArchivePath = Config.WalArchivePath
+	worker.Config.WalArchiveRetention = Config.WalArchiveRetention
 	worker.Config.NumPendingProposals = Config.NumPendingProposals
 	worker.Config.Tracing = Config.Tracing
 	worker.Config.MyAddr = Config.MyAddr
@@ -127,6 +193,8 @@ func SetConfiguration(newConfig Options) {
 	worker.Config.RaftId = Config.RaftId
 	worker.Config.MaxPendingCount = Config.MaxPendingCount
 	worker.Config.ExpandEdge = Config.ExpandEdge
+	worker.Config.TTLPurgeInterval = Config.TTLPurgeInterval
+	worker.Config.EncryptExports = Config.EncryptExports
 
 	x.Config.DebugMode = Config.DebugMode
 }
@@ -143,4 +211,24 @@ func (o *Options) validate() {
 		"Allotted memory (--memory_mb) must be specified, with value greater than 1024 MB")
 	x.AssertTruefNoTrace(o.AllottedMemory >= MinAllottedMemory,
 		"Allotted memory (--memory_mb) must be at least %.0f MB. Currently set to: %f", MinAllottedMemory, o.AllottedMemory)
+	x.AssertTruefNoTrace(o.HistoryRetention >= 0,
+		"History retention (--history_retention) can't be negative. Currently set to: %v", o.HistoryRetention)
+	x.AssertTruefNoTrace(o.TTLPurgeInterval >= 0,
+		"TTL purge interval (--ttl_purge_interval) can't be negative. Currently set to: %v", o.TTLPurgeInterval)
+	x.AssertTruefNoTrace(o.AclAccessTtl > 0,
+		"ACL access TTL (--acl_access_ttl) must be positive. Currently set to: %v", o.AclAccessTtl)
+	x.AssertTruefNoTrace(o.AclSecretFile == "" || o.AclPasswordFile != "",
+		"Access control (--acl_secret_file) needs the initial password of %s in"+
+			" --acl_password_file", grootUser)
+	x.AssertTruefNoTrace(!o.EncryptExports || o.EncryptionKeyFile != "",
+		"Encrypting exports (--encrypt_exports) needs an --encryption_key_file")
+	x.AssertTruefNoTrace(o.ReplicateSinceTs == 0 || o.ReplicateFrom != "",
+		"Replicating since a timestamp (--replicate_since_ts) needs --replicate_from")
+	x.AssertTruefNoTrace(o.ReplicationMaxLag >= 0,
+		"Replication max lag (--replication_max_lag) can't be negative. Currently set to: %v",
+		o.ReplicationMaxLag)
+	x.AssertTruefNoTrace(o.ReplicationUser == "" || o.ReplicateFrom != "",
+		"Replication user (--replication_user) needs --replicate_from")
+	x.AssertTruefNoTrace(o.ReplicationUser == "" || o.ReplicationPasswordFile != "",
+		"Replication user (--replication_user) needs --replication_password_file")
 }
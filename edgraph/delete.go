@@ -0,0 +1,192 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// Deletions per transaction, unless the request asks for fewer. S * * deletions expand
+	// into one edge per predicate of the node, so this keeps proposals well below the gRPC
+	// message size and the transaction size Badger accepts.
+	deleteBatchSize = 1000
+	// Times a batch is retried when it conflicts with another transaction.
+	deleteBatchRetries = 5
+)
+
+// BulkDelete runs the query of req, and deletes the nodes bound to its variables in
+// transactions of their own. send is called with the progress once the nodes are known, and
+// after every committed batch. Batches committed before an error stay committed.
+func (s *Server) BulkDelete(ctx context.Context, req *api.DeleteByQueryRequest,
+	send func(*api.DeleteProgress) error) error {
+	ctx, err := withNamespace(ctx)
+	if err != nil {
+		return err
+	}
+	ae := newAuditRecord(ctx, "delete_by_query")
+	ae.setQuery(req.Query, req.Vars)
+	err = s.bulkDelete(ctx, req, send)
+	ae.log(err)
+	return err
+}
+
+func (s *Server) bulkDelete(ctx context.Context, req *api.DeleteByQueryRequest,
+	send func(*api.DeleteProgress) error) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	if !isMutationAllowed(ctx) {
+		return x.Errorf("No mutations allowed.")
+	}
+	if len(req.Deletes) == 0 {
+		return x.Errorf("Delete by query needs at least one variable to delete")
+	}
+	batchSize := deleteBatchSize
+	if req.BatchSize > 0 && int(req.BatchSize) < batchSize {
+		batchSize = int(req.BatchSize)
+	}
+
+	dels, err := s.queryDeletes(ctx, req)
+	if err != nil {
+		return err
+	}
+	progress := &api.DeleteProgress{
+		Matched: uint64(len(dels)),
+		Done:    req.DryRun || len(dels) == 0,
+	}
+	if err := send(progress); err != nil || progress.Done {
+		return err
+	}
+
+	for len(dels) > 0 {
+		var nquads []*api.NQuad
+		n := 0
+		// Keep the deletions of a node together, even if they exceed the batch.
+		for n < len(dels) && (n == 0 || len(nquads)+len(dels[n]) <= batchSize) {
+			nquads = append(nquads, dels[n]...)
+			n++
+		}
+		if err := s.deleteBatch(ctx, nquads, req.DeleteIncoming); err != nil {
+			return err
+		}
+		dels = dels[n:]
+		progress.Deleted += uint64(n)
+		progress.Batches++
+		progress.Done = len(dels) == 0
+		if err := send(progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryDeletes runs the query of req, and returns the deletions for each node bound to the
+// variables, in the order the nodes were first found.
+func (s *Server) queryDeletes(ctx context.Context,
+	req *api.DeleteByQueryRequest) ([][]*api.NQuad, error) {
+	vars := make([]string, 0, len(req.Deletes))
+	for _, d := range req.Deletes {
+		vars = append(vars, d.Var)
+	}
+	parsed, err := gql.Parse(gql.Request{
+		Str:       req.Query,
+		Variables: req.Vars,
+		UsedVars:  vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeQuery(ctx, &parsed); err != nil {
+		return nil, err
+	}
+	qr := query.QueryRequest{
+		Latency:  &query.Latency{},
+		GqlQuery: &parsed,
+		ReadTs:   State.getTimestamp(),
+	}
+	if err := qr.ProcessQuery(ctx); err != nil {
+		return nil, x.Wrap(err)
+	}
+
+	star := &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}
+	var dels [][]*api.NQuad
+	idx := make(map[uint64]int)
+	all := make(map[uint64]bool)
+	for _, d := range req.Deletes {
+		for _, uid := range qr.UidVar(d.Var) {
+			i, ok := idx[uid]
+			if !ok {
+				i = len(dels)
+				idx[uid] = i
+				dels = append(dels, nil)
+			}
+			if all[uid] {
+				continue
+			}
+			subject := fmt.Sprintf("%#x", uid)
+			if len(d.Predicates) == 0 {
+				all[uid] = true
+				dels[i] = []*api.NQuad{{Subject: subject, Predicate: x.Star, ObjectValue: star}}
+				continue
+			}
+			for _, pred := range d.Predicates {
+				dels[i] = append(dels[i],
+					&api.NQuad{Subject: subject, Predicate: pred, ObjectValue: star})
+			}
+		}
+	}
+	return dels, nil
+}
+
+// deleteBatch commits the deletions in a transaction, retrying it if it conflicts.
+func (s *Server) deleteBatch(ctx context.Context, nquads []*api.NQuad, incoming bool) error {
+	var err error
+	for i := 0; i < deleteBatchRetries; i++ {
+		_, err = s.Mutate(ctx, &api.Mutation{
+			Del:            nquads,
+			CommitNow:      true,
+			DeleteIncoming: incoming,
+		})
+		if status.Code(err) != codes.Aborted {
+			return err
+		}
+		select {
+		case <-time.After(time.Duration(i+1) * 100 * time.Millisecond):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// DeleteByQuery is BulkDelete over a gRPC stream.
+func (s *Server) DeleteByQuery(req *api.DeleteByQueryRequest,
+	stream api.Dgraph_DeleteByQueryServer) error {
+	return s.BulkDelete(stream.Context(), req, stream.Send)
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"bytes"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// Commits coming in quicker than this are coalesced into one run.
+	liveQueryDebounce = 50 * time.Millisecond
+	// But a steady stream of commits doesn't hold back results longer than this.
+	liveQueryMaxDelay = time.Second
+)
+
+// LiveQuery runs the query and calls send with the response. It then runs the query again
+// after every commit touching the predicates it read, calling send whenever the result
+// differs from the last one sent. It returns when ctx is done, or on the first error.
+func (s *Server) LiveQuery(ctx context.Context, req *api.Request,
+	send func(*api.Response) error) error {
+	ctx, err := withNamespace(ctx)
+	if err != nil {
+		return err
+	}
+	ae := newAuditRecord(ctx, "live_query")
+	ae.setQuery(req.Query, req.Vars)
+	err = s.liveQuery(ctx, req, send)
+	ae.log(err)
+	return err
+}
+
+func (s *Server) liveQuery(ctx context.Context, req *api.Request,
+	send func(*api.Response) error) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	if req.StartTs != 0 || req.AsOfTs != 0 || req.AsOfTime != 0 {
+		return x.Errorf("Live queries always read the latest data and can't set a timestamp")
+	}
+
+	// Start watching before the first run, so that no commit falls in between.
+	w := worker.WatchCommits()
+	defer w.Close()
+
+	var last []byte
+	for {
+		r := *req
+		resp, sgs, err := s.query(ctx, &r)
+		if err != nil {
+			return err
+		}
+		w.SetPredicates(ctx, query.GetAllPredicates(sgs), resp.Txn.StartTs)
+		if last == nil || !bytes.Equal(last, resp.Json) {
+			if err := send(resp); err != nil {
+				return err
+			}
+			last = resp.Json
+		}
+		if err := waitForCommits(ctx, w.C); err != nil {
+			return err
+		}
+	}
+}
+
+// waitForCommits blocks until a commit comes in, and then until commits stop coming in for
+// liveQueryDebounce, or liveQueryMaxDelay passes.
+func waitForCommits(ctx context.Context, c <-chan struct{}) error {
+	select {
+	case <-c:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	deadline := time.After(liveQueryMaxDelay)
+	for {
+		select {
+		case <-c:
+		case <-time.After(liveQueryDebounce):
+			return nil
+		case <-deadline:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Watch is LiveQuery over a gRPC stream.
+func (s *Server) Watch(req *api.Request, stream api.Dgraph_WatchServer) error {
+	return s.LiveQuery(stream.Context(), req, stream.Send)
+}
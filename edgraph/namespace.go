@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// withNamespace sets the namespace of the request, taken from the "namespace" metadata of gRPC
+// requests, or the X-Dgraph-Namespace header of HTTP ones, in the context. Requests without one
+// keep the namespace already set, which is the default namespace 0 unless the server made the
+// request itself.
+//
+// With access control enabled, the namespace of a request with an access token is the one the
+// user logged into. A different namespace is rejected, except from the guardians of the default
+// namespace, who can act in any namespace to set up its users and groups.
+func withNamespace(ctx context.Context) (context.Context, error) {
+	var ns string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md["namespace"]; len(v) > 0 {
+			ns = v[0]
+		}
+	}
+	if ns == "" {
+		ns, _ = ctx.Value("namespace").(string)
+	}
+	var claims *accessClaims
+	if aclEnabled() && !isInternal(ctx) && accessToken(ctx) != "" {
+		var err error
+		if claims, err = claimsFrom(ctx); err != nil {
+			return ctx, err
+		}
+	}
+	if ns == "" {
+		if claims != nil {
+			return x.WithNamespace(ctx, claims.Namespace), nil
+		}
+		return ctx, nil
+	}
+	id, err := strconv.ParseUint(ns, 10, 64)
+	if err != nil {
+		return ctx, x.Errorf("Invalid namespace: %s", ns)
+	}
+	if claims != nil && id != claims.Namespace &&
+		!(claims.Namespace == 0 && claims.isGuardian()) {
+		return ctx, status.Errorf(codes.PermissionDenied,
+			"User %s of namespace %d can't access namespace %d",
+			claims.Userid, claims.Namespace, id)
+	}
+	return x.WithNamespace(ctx, id), nil
+}
+
+// checkPredicateNames rejects predicates which would escape their namespace.
+func checkPredicateNames(preds ...string) error {
+	for _, pred := range preds {
+		if strings.Contains(pred, x.NamespaceSeparator) {
+			return x.Errorf("Predicate %s can't contain %q", pred, x.NamespaceSeparator)
+		}
+	}
+	return nil
+}
+
+// dropNamespace drops the predicates of the namespace of the request. The default namespace
+// drops everything, unless other namespaces have predicates, in which case it's just its own.
+func dropNamespace(ctx context.Context) error {
+	preds, others, err := worker.NamespacePredicates(ctx)
+	if err != nil {
+		return err
+	}
+	if !others {
+		_, err := query.ApplyMutations(ctx, &intern.Mutations{DropAll: true})
+		return err
+	}
+	for _, attr := range preds {
+		// A proposal can only drop one predicate.
+		edge := &intern.DirectedEdge{
+			Attr:  attr,
+			Op:    intern.DirectedEdge_DEL,
+			Value: []byte(x.Star),
+		}
+		m := &intern.Mutations{Edges: []*intern.DirectedEdge{edge}}
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return x.Wrapf(err, "While dropping predicate %s", attr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestWithNamespace(t *testing.T) {
+	ctx, err := withNamespace(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), x.Namespace(ctx))
+
+	md := metadata.New(map[string]string{"namespace": "7"})
+	ctx, err = withNamespace(metadata.NewIncomingContext(context.Background(), md))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), x.Namespace(ctx))
+
+	ctx, err = withNamespace(context.WithValue(context.Background(), "namespace", "3"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), x.Namespace(ctx))
+
+	// Requests made by the server keep their namespace.
+	ctx, err = withNamespace(x.WithNamespace(context.Background(), 5))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), x.Namespace(ctx))
+
+	_, err = withNamespace(context.WithValue(context.Background(), "namespace", "abc"))
+	require.Error(t, err)
+}
+
+func TestCheckPredicateNames(t *testing.T) {
+	require.NoError(t, checkPredicateNames("name", "http://schema.org/name"))
+	require.Error(t, checkPredicateNames("name", "3|name"))
+}
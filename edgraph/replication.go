@@ -0,0 +1,725 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	replicationStateFile = "replication.json"
+	replicationBuffer    = 1000
+	replicationRetry     = time.Second
+	schemaSyncInterval   = 10 * time.Second
+	// How often the latest timestamp of the primary is fetched, to tell the lag.
+	lagPollInterval = time.Second
+	// How often the position is saved on the progress marks of the primary.
+	positionSaveInterval = 10 * time.Second
+	// An access token is renewed this long before it expires.
+	tokenRenewal = time.Minute
+
+	// The commit_ts of the last transaction applied from the primary is kept on a node the
+	// primary can't lease, and written in the same transaction as its changes.
+	replicationPosition    = "dgraph.replication.after_ts"
+	replicationPositionUid = "0xffffffffffffffff"
+)
+
+var errStandby = x.Errorf("This server is a read-only standby. Promote it to accept writes.")
+
+// replicationState is kept in the postings directory, so that a promoted server stays
+// promoted. The position of the replication is kept with the data instead.
+type replicationState struct {
+	// The schema last copied from the primary, by predicate.
+	Schema   map[string]string `json:"schema"`
+	Promoted bool              `json:"promoted"`
+}
+
+func loadReplicationState(path string) (replicationState, error) {
+	st := replicationState{Schema: make(map[string]string)}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return st, nil
+	}
+	if err != nil {
+		return st, err
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return st, x.Wrapf(err, "While reading %s", path)
+	}
+	if st.Schema == nil {
+		st.Schema = make(map[string]string)
+	}
+	return st, nil
+}
+
+// save writes the state to a temporary file first, so that a crash doesn't leave it half
+// written.
+func (st *replicationState) save(path string) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// primaryTs is a timestamp the primary handed out, and when the standby learnt of it.
+type primaryTs struct {
+	ts uint64
+	at time.Time
+}
+
+// standby applies the changes committed on the primary cluster, which this server tails when
+// run with --replicate_from. Until it is promoted, only the replicated changes are written.
+type standby struct {
+	sync.Mutex
+	path  string
+	state replicationState
+	// The primary servers, any of which can stream the changes of the whole cluster.
+	addrs     []string
+	primary   string
+	connected bool
+	err       string
+	applied   uint64
+
+	// The commit_ts up to which the changes of the primary were applied, and up to which
+	// that is saved.
+	afterTs uint64
+	savedTs uint64
+	savedAt time.Time
+	// The timestamps of the primary not applied up to yet, oldest first, and when the primary
+	// was last heard from.
+	behind  []primaryTs
+	contact time.Time
+
+	// The access token for the primary, when --replication_user is set.
+	token   string
+	expires time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// Serializes the schema syncs.
+	schemaMu     sync.Mutex
+	schemaSynced time.Time
+}
+
+// replica is set when the server was started as a standby, even once it is promoted.
+var replica *standby
+
+func initStandby() {
+	path := filepath.Join(Config.PostingDir, replicationStateFile)
+	st, err := loadReplicationState(path)
+	x.Checkf(err, "Error while loading the replication state")
+	replica = &standby{path: path, state: st, contact: time.Now()}
+	if st.Promoted {
+		x.Printf("Standby was promoted already. Not replicating.\n")
+		return
+	}
+	for _, addr := range strings.Split(Config.ReplicateFrom, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			replica.addrs = append(replica.addrs, addr)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	replica.cancel = cancel
+	replica.wg.Add(1)
+	go replica.replicate(ctx)
+	go replica.updateLag(ctx)
+}
+
+// isStandby returns whether this server only takes the changes replicated from its primary.
+func isStandby() bool {
+	if replica == nil {
+		return false
+	}
+	replica.Lock()
+	defer replica.Unlock()
+	return !replica.state.Promoted
+}
+
+// lagLocked returns how long ago the primary handed out the oldest timestamp which the
+// standby hasn't applied up to, or since when the primary couldn't be reached.
+func (r *standby) lagLocked(now time.Time) time.Duration {
+	if r.state.Promoted {
+		return 0
+	}
+	var lag time.Duration
+	if len(r.behind) > 0 {
+		lag = now.Sub(r.behind[0].at)
+	}
+	if d := now.Sub(r.contact); d > 2*lagPollInterval && d > lag {
+		lag = d
+	}
+	return lag
+}
+
+func (r *standby) lag() time.Duration {
+	r.Lock()
+	defer r.Unlock()
+	return r.lagLocked(time.Now())
+}
+
+// appliedUpTo records that every change of the primary up to ts was applied.
+func (r *standby) appliedUpTo(ts uint64) {
+	r.Lock()
+	defer r.Unlock()
+	if ts > r.afterTs {
+		r.afterTs = ts
+	}
+	i := 0
+	for i < len(r.behind) && r.behind[i].ts <= r.afterTs {
+		i++
+	}
+	r.behind = r.behind[i:]
+}
+
+func (r *standby) updateLag(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			x.ReplicationLag.Set(int64(r.lag() / time.Millisecond))
+		case <-ctx.Done():
+			x.ReplicationLag.Set(0)
+			return
+		}
+	}
+}
+
+// checkReplicationLag fails queries on a standby which fell further behind its primary than
+// --replication_max_lag.
+func checkReplicationLag() error {
+	if Config.ReplicationMaxLag <= 0 || !isStandby() {
+		return nil
+	}
+	if lag := replica.lag(); lag > Config.ReplicationMaxLag {
+		return x.Errorf("Standby is %v behind its primary, more than the allowed %v",
+			lag, Config.ReplicationMaxLag)
+	}
+	return nil
+}
+
+// replicate keeps the stream from the primary going until the standby is promoted, moving to
+// the next primary server when one fails.
+func (r *standby) replicate(ctx context.Context) {
+	defer r.wg.Done()
+	for i := 0; ; i++ {
+		for x.HealthCheck() != nil {
+			select {
+			case <-time.After(replicationRetry):
+			case <-ctx.Done():
+				return
+			}
+		}
+		addr := r.addrs[i%len(r.addrs)]
+		err := r.stream(ctx, addr)
+		if ctx.Err() != nil {
+			return
+		}
+		r.Lock()
+		r.connected, r.err = false, err.Error()
+		r.Unlock()
+		x.Printf("Replication from %s stopped: %v. Retrying.\n", addr, err)
+		select {
+		case <-time.After(replicationRetry):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// readPosition returns the commit_ts up to which the changes of the primary were applied, as
+// saved along with them.
+func readPosition(ctx context.Context) (uint64, error) {
+	js, err := queryJSON(asInternal(ctx), fmt.Sprintf(`{
+		q(func: uid(%s)) {
+			<%s>
+		}
+	}`, replicationPositionUid, replicationPosition), nil)
+	if err != nil {
+		return 0, err
+	}
+	var res struct {
+		Q []map[string]uint64 `json:"q"`
+	}
+	if err := json.Unmarshal(js, &res); err != nil {
+		return 0, err
+	}
+	if len(res.Q) == 0 {
+		return 0, nil
+	}
+	return res.Q[0][replicationPosition], nil
+}
+
+// withToken returns ctx carrying an access token for the primary, logging in again when the
+// current one is about to expire.
+func (r *standby) withToken(ctx context.Context, dc api.DgraphClient) (context.Context, error) {
+	if Config.ReplicationUser == "" {
+		return ctx, nil
+	}
+	r.Lock()
+	token, expires := r.token, r.expires
+	r.Unlock()
+	if token == "" || time.Until(expires) < tokenRenewal {
+		password, err := ioutil.ReadFile(Config.ReplicationPasswordFile)
+		if err != nil {
+			return ctx, x.Wrapf(err, "While reading the replication password")
+		}
+		resp, err := dc.Login(ctx, &api.LoginRequest{
+			Userid:   Config.ReplicationUser,
+			Password: strings.TrimSpace(string(password)),
+		})
+		if err != nil {
+			return ctx, x.Wrapf(err, "While logging in to the primary")
+		}
+		token, expires = resp.AccessToken, time.Unix(resp.ExpiresAt, 0)
+		r.Lock()
+		r.token, r.expires = token, expires
+		r.Unlock()
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("accesstoken", token)), nil
+}
+
+type receivedChange struct {
+	ev  *api.ChangeEvent
+	err error
+}
+
+func (r *standby) stream(ctx context.Context, addr string) error {
+	cc, err := grpc.Dial(addr,
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(x.GrpcMaxSize),
+			grpc.MaxCallSendMsgSize(x.GrpcMaxSize)),
+		conn.TransportOption())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	dc := api.NewDgraphClient(cc)
+	if err := r.syncSchema(ctx, dc, true); err != nil {
+		return err
+	}
+
+	r.Lock()
+	if r.savedTs == 0 {
+		r.Unlock()
+		ts, err := readPosition(ctx)
+		if err != nil {
+			return x.Wrapf(err, "While reading the replication position")
+		}
+		if ts == 0 {
+			ts = Config.ReplicateSinceTs
+		}
+		r.Lock()
+		r.afterTs, r.savedTs, r.savedAt = ts, ts, time.Now()
+	}
+	req := &api.SubscribeRequest{AfterTs: r.afterTs}
+	r.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sctx, err := r.withToken(ctx, dc)
+	if err != nil {
+		return err
+	}
+	sub, err := dc.Subscribe(sctx, req)
+	if err != nil {
+		return err
+	}
+	ch := make(chan receivedChange, replicationBuffer)
+	go func() {
+		for {
+			ev, err := sub.Recv()
+			select {
+			case ch <- receivedChange{ev: ev, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	go r.pollPrimary(ctx, dc)
+
+	r.Lock()
+	r.primary, r.connected, r.err = addr, true, ""
+	r.Unlock()
+	ticker := time.NewTicker(schemaSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case rc := <-ch:
+			if rc.err != nil {
+				return rc.err
+			}
+			if err := r.apply(ctx, dc, rc.ev); err != nil {
+				return x.Wrapf(err, "While applying the changes committed at %d", rc.ev.CommitTs)
+			}
+		case <-ticker.C:
+			// Schema changes don't come with the changes, so they are polled for.
+			if err := r.syncSchema(ctx, dc, false); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// pollPrimary keeps fetching the latest timestamp of the primary, which the lag is measured
+// against, so that a stalled stream still shows up as lag.
+func (r *standby) pollPrimary(ctx context.Context, dc api.DgraphClient) {
+	ticker := time.NewTicker(lagPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		qctx, err := r.withToken(ctx, dc)
+		if err != nil {
+			continue
+		}
+		resp, err := dc.Query(qctx, &api.Request{
+			Query:    fmt.Sprintf("{ q(func: uid(%s)) { uid } }", replicationPositionUid),
+			ReadOnly: true,
+		})
+		if err != nil || resp.Txn == nil {
+			continue
+		}
+		now := time.Now()
+		r.Lock()
+		r.contact = now
+		if ts := resp.Txn.StartTs; ts > r.afterTs &&
+			(len(r.behind) == 0 || ts > r.behind[len(r.behind)-1].ts) {
+			r.behind = append(r.behind, primaryTs{ts: ts, at: now})
+		}
+		r.Unlock()
+	}
+}
+
+// apply commits the edges of the transaction on this cluster. The uids it uses are leased
+// first, so that new nodes created after a promotion don't get them. Progress marks, which
+// have no edges, only move the position.
+func (r *standby) apply(ctx context.Context, dc api.DgraphClient, ev *api.ChangeEvent) error {
+	r.Lock()
+	afterTs, savedAt := r.afterTs, r.savedAt
+	r.Unlock()
+	if ev.CommitTs <= afterTs {
+		// Applied before the stream was resumed.
+		return nil
+	}
+	if len(ev.Set) == 0 && len(ev.Del) == 0 {
+		if time.Since(savedAt) >= positionSaveInterval {
+			if err := r.commit(ctx, &gql.Mutation{}, ev.CommitTs); err != nil {
+				return err
+			}
+		}
+		r.appliedUpTo(ev.CommitTs)
+		return nil
+	}
+
+	var maxUid uint64
+	var unknown bool
+	r.Lock()
+	keep := func(nqs []*api.NQuad) ([]*api.NQuad, error) {
+		var out []*api.NQuad
+		for _, nq := range nqs {
+			if nq.Predicate == "_predicate_" {
+				// Dgraph adds these on its own.
+				continue
+			}
+			if _, ok := r.state.Schema[nq.Predicate]; !ok {
+				unknown = true
+			}
+			for _, id := range []string{nq.Subject, nq.ObjectId} {
+				if id == "" {
+					continue
+				}
+				uid, err := strconv.ParseUint(id, 0, 64)
+				if err != nil {
+					return nil, x.Wrapf(err, "Invalid uid %q", id)
+				}
+				if uid > maxUid {
+					maxUid = uid
+				}
+			}
+			out = append(out, nq)
+		}
+		return out, nil
+	}
+	gmu := &gql.Mutation{}
+	var err error
+	if gmu.Set, err = keep(ev.Set); err == nil {
+		gmu.Del, err = keep(ev.Del)
+	}
+	r.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if unknown {
+		if err := r.syncSchema(ctx, dc, true); err != nil {
+			return err
+		}
+	}
+	if lease := worker.MaxLeaseId(); maxUid > lease {
+		if _, err := worker.AssignUidsOverNetwork(ctx,
+			&intern.Num{Val: maxUid - lease}); err != nil {
+			return err
+		}
+	}
+	if err := r.commit(ctx, gmu, ev.CommitTs); err != nil {
+		return err
+	}
+	for _, nq := range append(gmu.Set, gmu.Del...) {
+		if nq.Predicate == aclGroupRules {
+			acls.notify()
+			break
+		}
+	}
+	r.appliedUpTo(ev.CommitTs)
+	r.Lock()
+	r.applied++
+	r.Unlock()
+	x.ReplicatedEvents.Add(1)
+	return nil
+}
+
+// commit writes the edges along with the new position in one transaction, so that a crash
+// can't apply a transaction of the primary twice.
+func (r *standby) commit(ctx context.Context, gmu *gql.Mutation, afterTs uint64) error {
+	gmu.Set = append(gmu.Set, &api.NQuad{
+		Subject:     replicationPositionUid,
+		Predicate:   replicationPosition,
+		ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: int64(afterTs)}},
+	})
+	edges, err := query.ToInternal(gmu, nil)
+	if err != nil {
+		return err
+	}
+	ctx = asInternal(ctx)
+	tctx, err := query.ApplyMutations(ctx, &intern.Mutations{
+		Edges:   edges,
+		StartTs: State.getTimestamp(),
+	})
+	if err != nil {
+		if tctx != nil {
+			tctx.Aborted = true
+			worker.CommitOverNetwork(ctx, tctx)
+		}
+		return err
+	}
+	cts, err := worker.CommitOverNetwork(ctx, tctx)
+	if err != nil {
+		return err
+	}
+	State.sawTs(cts)
+
+	r.Lock()
+	defer r.Unlock()
+	r.savedTs, r.savedAt = afterTs, time.Now()
+	return nil
+}
+
+// syncSchema copies the schema of the primary, dropping the predicates which are gone from it.
+// Unless forced, it does nothing if the schema was synced less than schemaSyncInterval ago.
+func (r *standby) syncSchema(ctx context.Context, dc api.DgraphClient, force bool) error {
+	r.schemaMu.Lock()
+	defer r.schemaMu.Unlock()
+	if !force && time.Since(r.schemaSynced) < schemaSyncInterval {
+		return nil
+	}
+	qctx, err := r.withToken(ctx, dc)
+	if err != nil {
+		return err
+	}
+	resp, err := dc.Query(qctx, &api.Request{Query: "schema {}"})
+	if err != nil {
+		return x.Wrapf(err, "While fetching the schema of the primary")
+	}
+	current := make(map[string]string)
+	for _, node := range resp.Schema {
+		if node.Predicate != "_predicate_" {
+			current[node.Predicate] = schemaNodeString(node)
+		}
+	}
+
+	r.Lock()
+	var changed, dropped []string
+	for pred, s := range current {
+		if r.state.Schema[pred] != s {
+			changed = append(changed, s)
+		}
+	}
+	for pred := range r.state.Schema {
+		if _, ok := current[pred]; !ok {
+			dropped = append(dropped, pred)
+		}
+	}
+	r.Unlock()
+
+	ictx := asInternal(ctx)
+	if len(changed) > 0 {
+		sort.Strings(changed)
+		op := &api.Operation{Schema: strings.Join(changed, "\n")}
+		if _, err := (&Server{}).Alter(ictx, op); err != nil {
+			return x.Wrapf(err, "While copying the schema of the primary")
+		}
+	}
+	for _, pred := range dropped {
+		if _, err := (&Server{}).Alter(ictx, &api.Operation{DropAttr: pred}); err != nil {
+			return x.Wrapf(err, "While dropping predicate %s", pred)
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.state.Schema = current
+	r.schemaSynced = time.Now()
+	return r.state.save(r.path)
+}
+
+// schemaNodeString returns the schema of a predicate, as returned by a schema query, in the
+// schema language.
+func schemaNodeString(node *api.SchemaNode) string {
+	var buf bytes.Buffer
+	if strings.ContainsRune(node.Predicate, ':') {
+		fmt.Fprintf(&buf, "<%s>:", node.Predicate)
+	} else {
+		buf.WriteString(node.Predicate + ":")
+	}
+	// Uid predicates are always lists, and can't be declared as such.
+	if node.List && node.Type != "uid" {
+		fmt.Fprintf(&buf, " [%s]", node.Type)
+	} else {
+		buf.WriteString(" " + node.Type)
+	}
+	if node.Reverse {
+		buf.WriteString(" @reverse")
+	} else if node.Index && len(node.Tokenizer) > 0 {
+		fmt.Fprintf(&buf, " @index(%s)", strings.Join(node.Tokenizer, ", "))
+	}
+	if node.Count {
+		buf.WriteString(" @count")
+	}
+	if node.Constraint != "" {
+		fmt.Fprintf(&buf, " @constraint(%s)", node.Constraint)
+	}
+	if node.Pattern != "" {
+		fmt.Fprintf(&buf, " @pattern(%s)", strconv.Quote(node.Pattern))
+	}
+	if len(node.Enum) > 0 {
+		quoted := make([]string, 0, len(node.Enum))
+		for _, v := range node.Enum {
+			quoted = append(quoted, strconv.Quote(v))
+		}
+		fmt.Fprintf(&buf, " @enum(%s)", strings.Join(quoted, ", "))
+	}
+	if node.Ttl != "" {
+		fmt.Fprintf(&buf, " @ttl(%s)", node.Ttl)
+	}
+	buf.WriteString(" .")
+	return buf.String()
+}
+
+// ReplicationStatus is returned by /admin/replication.
+type ReplicationStatus struct {
+	Standby   bool   `json:"standby"`
+	Promoted  bool   `json:"promoted"`
+	Primary   string `json:"primary,omitempty"`
+	Connected bool   `json:"connected"`
+	AfterTs   uint64 `json:"after_ts,omitempty"`
+	Applied   uint64 `json:"applied,omitempty"`
+	LagMs     int64  `json:"lag_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// GetReplicationStatus returns whether this server is a standby, and how far behind its
+// primary it is.
+func GetReplicationStatus() ReplicationStatus {
+	if replica == nil {
+		return ReplicationStatus{}
+	}
+	r := replica
+	r.Lock()
+	defer r.Unlock()
+	status := ReplicationStatus{Standby: !r.state.Promoted, Promoted: r.state.Promoted}
+	if r.state.Promoted {
+		return status
+	}
+	status.Primary = r.primary
+	status.Connected = r.connected
+	status.AfterTs = r.afterTs
+	status.Applied = r.applied
+	status.LagMs = int64(r.lagLocked(time.Now()) / time.Millisecond)
+	status.Error = r.err
+	return status
+}
+
+// PromoteStandby stops replicating from the primary, and lets the server take writes. The
+// promotion is kept across restarts.
+func PromoteStandby() error {
+	if replica == nil {
+		return x.Errorf("This server isn't a standby")
+	}
+	r := replica
+	if r.cancel != nil {
+		r.cancel()
+		r.wg.Wait()
+	}
+	r.Lock()
+	defer r.Unlock()
+	if r.state.Promoted {
+		return nil
+	}
+	r.state.Promoted = true
+	r.connected, r.behind = false, nil
+	x.Printf("Standby promoted. Writes are allowed.\n")
+	return r.state.save(r.path)
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/schema"
+)
+
+func TestSchemaNodeString(t *testing.T) {
+	nodes := []*api.SchemaNode{
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"exact", "term"},
+			Count: true},
+		{Predicate: "friend", Type: "uid", Reverse: true, List: true},
+		{Predicate: "age", Type: "int", Constraint: "min: 0, max: 150", Ttl: "1h0m0s"},
+		{Predicate: "tags", Type: "string", List: true},
+		{Predicate: "color", Type: "string", Pattern: "^[a-z]+$", Enum: []string{"red", "blue"}},
+		{Predicate: "http://schema.org/name", Type: "string"},
+	}
+	require.Equal(t, "name: string @index(exact, term) @count .", schemaNodeString(nodes[0]))
+	require.Equal(t, "friend: uid @reverse .", schemaNodeString(nodes[1]))
+	require.Equal(t, "tags: [string] .", schemaNodeString(nodes[3]))
+	require.Equal(t, "<http://schema.org/name>: string .", schemaNodeString(nodes[5]))
+
+	for _, node := range nodes {
+		updates, err := schema.Parse(schemaNodeString(node))
+		require.NoError(t, err, schemaNodeString(node))
+		require.Len(t, updates, 1)
+		require.Equal(t, node.Predicate, updates[0].Predicate)
+	}
+}
+
+func TestReplicationState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replication")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, replicationStateFile)
+
+	st, err := loadReplicationState(path)
+	require.NoError(t, err)
+	require.False(t, st.Promoted)
+	st.Schema["name"] = "name: string ."
+	require.NoError(t, st.save(path))
+
+	st, err = loadReplicationState(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"name": "name: string ."}, st.Schema)
+}
+
+func TestStandbyPromote(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replication")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, replicationStateFile)
+
+	st, err := loadReplicationState(path)
+	require.NoError(t, err)
+	now := time.Now()
+	replica = &standby{path: path, state: st, primary: "a", connected: true, contact: now,
+		behind: []primaryTs{{ts: 10, at: now.Add(-time.Minute)}}}
+	Config.ReplicationMaxLag = 10 * time.Second
+	defer func() {
+		replica = nil
+		Config.ReplicationMaxLag = 0
+	}()
+
+	require.True(t, isStandby())
+	require.True(t, replica.lag() >= time.Minute)
+	require.Error(t, checkReplicationLag())
+	status := GetReplicationStatus()
+	require.True(t, status.Standby)
+	require.Equal(t, "a", status.Primary)
+	require.True(t, status.LagMs >= 60000)
+
+	require.NoError(t, PromoteStandby())
+	require.False(t, isStandby())
+	require.NoError(t, checkReplicationLag())
+	st, err = loadReplicationState(path)
+	require.NoError(t, err)
+	require.True(t, st.Promoted)
+}
+
+func TestStandbyLag(t *testing.T) {
+	now := time.Now()
+	r := &standby{contact: now, afterTs: 5, behind: []primaryTs{
+		{ts: 8, at: now.Add(-30 * time.Second)},
+		{ts: 12, at: now.Add(-20 * time.Second)},
+	}}
+	require.Equal(t, 30*time.Second, r.lagLocked(now))
+
+	// Applying up to a timestamp of the primary catches up with it.
+	r.appliedUpTo(9)
+	require.Equal(t, uint64(9), r.afterTs)
+	require.Equal(t, 20*time.Second, r.lagLocked(now))
+	r.appliedUpTo(12)
+	require.Zero(t, r.lagLocked(now))
+
+	// Without word from the primary, the lag grows.
+	r.contact = now.Add(-time.Minute)
+	require.Equal(t, time.Minute, r.lagLocked(now))
+}
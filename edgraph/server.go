@@ -23,9 +23,11 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -36,7 +38,9 @@ import (
 
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/badger/options"
+	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/query"
@@ -65,11 +69,21 @@ type ServerState struct {
 	mu     sync.Mutex
 	needTs []chan uint64
 	notify chan struct{}
+
+	// The latest timestamp leased or committed at via this server. Accessed atomically.
+	latestTs uint64
+	// Leased for read-only queries, which share it for readOnlyTsTTL.
+	readOnlyTs     uint64
+	readOnlyLeased time.Time
+	// Timestamps recently handed out to read-only queries, to reject mutations at them.
+	readOnlyHist []uint64
 }
 
 // TODO(tzdybal) - remove global
 var State ServerState
 
+var errReadOnly = x.Errorf("Mutations aren't allowed in read-only transactions")
+
 func InitServerState() {
 	Config.validate()
 
@@ -77,9 +91,23 @@ func InitServerState() {
 	State.ShutdownCh = make(chan struct{})
 	State.notify = make(chan struct{}, 1)
 
+	if Config.EncryptionKeyFile != "" {
+		keys, err := x.LoadKeyRing(Config.EncryptionKeyFile)
+		x.Checkf(err, "Error while loading encryption keys")
+		x.SetValueEncryption(keys)
+	}
 	State.initStorage()
 
 	go State.fillTimestampRequests()
+
+	loadAclSecret(Config.AclSecretFile)
+	if aclEnabled() {
+		loadGrootPassword(Config.AclPasswordFile)
+		go (&Server{}).runAccessControl()
+	}
+	if Config.Standby || Config.ReplicateFrom != "" {
+		initStandby()
+	}
 }
 
 func (s *ServerState) runVlogGC(store *badger.ManagedDB) {
@@ -111,6 +139,7 @@ func (s *ServerState) runVlogGC(store *badger.ManagedDB) {
 func (s *ServerState) initStorage() {
 	// Write Ahead Log directory
 	x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
+	x.Checkf(x.CheckEncryptedDir(Config.WALDir, x.ValueEncryption()), "Can't open WAL dir")
 	kvOpt := badger.DefaultOptions
 	kvOpt.SyncWrites = true
 	kvOpt.Dir = Config.WALDir
@@ -125,6 +154,8 @@ func (s *ServerState) initStorage() {
 	// All the writes to posting store should be synchronous. We use batched writers
 	// for posting lists, so the cost of sync writes is amortized.
 	x.Check(os.MkdirAll(Config.PostingDir, 0700))
+	x.Checkf(x.CheckEncryptedDir(Config.PostingDir, x.ValueEncryption()),
+		"Can't open postings dir")
 	opt := badger.DefaultOptions
 	opt.SyncWrites = true
 	opt.Dir = Config.PostingDir
@@ -196,6 +227,7 @@ func (s *ServerState) fillTimestampRequests() {
 		}
 		delay = initDelay
 		x.AssertTrue(ts.EndId-ts.StartId+1 == uint64(len(chs)))
+		s.sawTs(ts.EndId)
 		for i, ch := range chs {
 			ch <- ts.StartId + uint64(i)
 		}
@@ -216,7 +248,95 @@ func (s *ServerState) getTimestamp() uint64 {
 	return <-ch
 }
 
+const (
+	// How long read-only queries share a timestamp, before a new one is leased from Zero.
+	readOnlyTsTTL      = 500 * time.Millisecond
+	maxReadOnlyHistory = 1024
+)
+
+func (s *ServerState) sawTs(ts uint64) {
+	for {
+		latest := atomic.LoadUint64(&s.latestTs)
+		if ts <= latest || atomic.CompareAndSwapUint64(&s.latestTs, latest, ts) {
+			return
+		}
+	}
+}
+
+// getReadOnlyTs returns a timestamp leased from Zero for read-only queries. The same
+// timestamp is shared for readOnlyTsTTL, so that this server asks Zero at most once per
+// interval. Reads at it can miss the commits made via other servers since, but not the ones
+// which went through this server, so that clients can read their own writes.
+func (s *ServerState) getReadOnlyTs() uint64 {
+	s.mu.Lock()
+	ts, leased := s.readOnlyTs, s.readOnlyLeased
+	s.mu.Unlock()
+	if ts > 0 && ts >= atomic.LoadUint64(&s.latestTs) && time.Since(leased) < readOnlyTsTTL {
+		return ts
+	}
+
+	ts = s.getTimestamp()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ts > s.readOnlyTs {
+		s.readOnlyTs, s.readOnlyLeased = ts, time.Now()
+	}
+	s.handedOutReadOnly(ts)
+	return ts
+}
+
+// getBestEffortTs returns the latest commit timestamp this server has heard of from Zero,
+// without asking Zero for one. Unlike the latest timestamp Zero handed out, it can't be the
+// start of another txn, whose uncommitted writes would be read at it.
+func (s *ServerState) getBestEffortTs() uint64 {
+	ts := posting.Oracle().MaxAssigned()
+	if ts == 0 {
+		// Nothing has been committed yet.
+		return s.getReadOnlyTs()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handedOutReadOnly(ts)
+	return ts
+}
+
+// handedOutReadOnly records that ts was handed out to read-only queries, so that mutations
+// at it are rejected.
+func (s *ServerState) handedOutReadOnly(ts uint64) {
+	if n := len(s.readOnlyHist); n > 0 && s.readOnlyHist[n-1] == ts {
+		return
+	}
+	s.readOnlyHist = append(s.readOnlyHist, ts)
+	if len(s.readOnlyHist) > maxReadOnlyHistory {
+		s.readOnlyHist = append(s.readOnlyHist[:0:0], s.readOnlyHist[maxReadOnlyHistory/2:]...)
+	}
+}
+
+func (s *ServerState) isReadOnlyTs(ts uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, rts := range s.readOnlyHist {
+		if rts == ts {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, error) {
+	ctx, err := withNamespace(ctx)
+	if err != nil {
+		return &api.Payload{}, err
+	}
+	ae := newAuditRecord(ctx, "alter")
+	ae.setRequest(op)
+	payload, err := s.alter(ctx, op)
+	ae.setTs(op.StartTs, 0)
+	ae.log(err)
+	return payload, err
+}
+
+func (s *Server) alter(ctx context.Context, op *api.Operation) (*api.Payload, error) {
 	empty := &api.Payload{}
 	if err := x.HealthCheck(); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
@@ -224,13 +344,23 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		}
 		return empty, err
 	}
+	if isStandby() && !isInternal(ctx) {
+		return empty, errStandby
+	}
 
 	if op.DropAll {
-		m := intern.Mutations{DropAll: true}
-		_, err := query.ApplyMutations(ctx, &m)
-		return empty, err
+		if err := authorizeGuardian(ctx); err != nil {
+			return empty, err
+		}
+		return empty, dropNamespace(ctx)
 	}
 	if len(op.DropAttr) > 0 {
+		if err := checkPredicateNames(op.DropAttr); err != nil {
+			return empty, err
+		}
+		if err := authorize(ctx, modifyPerm, []string{op.DropAttr}); err != nil {
+			return empty, err
+		}
 		nq := &api.NQuad{
 			Subject:     x.Star,
 			Predicate:   op.DropAttr,
@@ -246,21 +376,178 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		_, err = query.ApplyMutations(ctx, m)
 		return empty, err
 	}
+	if len(op.RenameAttr) > 0 {
+		if len(op.RenameTo) == 0 {
+			return empty, x.Errorf("No new name given for predicate %s", op.RenameAttr)
+		}
+		if err := checkPredicateNames(op.RenameAttr, op.RenameTo); err != nil {
+			return empty, err
+		}
+		err := authorize(ctx, modifyPerm, []string{op.RenameAttr, op.RenameTo})
+		if err != nil {
+			return empty, err
+		}
+		return empty, worker.RenamePredicateOverNetwork(ctx, op.RenameAttr, op.RenameTo)
+	}
 	updates, err := schema.Parse(op.Schema)
 	if err != nil {
 		return empty, err
 	}
+	preds := make([]string, 0, len(updates))
+	for _, update := range updates {
+		preds = append(preds, update.Predicate)
+	}
+	if err := checkPredicateNames(preds...); err != nil {
+		return empty, err
+	}
+	if err := authorize(ctx, modifyPerm, preds); err != nil {
+		return empty, err
+	}
 	fmt.Printf("Got schema: %+v\n", updates)
 	// TODO: Maybe add some checks about the schema.
 	if op.StartTs == 0 {
 		op.StartTs = State.getTimestamp()
 	}
-	m := &intern.Mutations{Schema: updates, StartTs: op.StartTs}
-	_, err = query.ApplyMutations(ctx, m)
+	m := &intern.Mutations{
+		Schema:      updates,
+		StartTs:     op.StartTs,
+		DropInvalid: op.DropInvalid,
+		// Values converted to a new type are written at a timestamp of their own, so that
+		// reads at earlier ones keep seeing the old values.
+		CommitTs: State.getTimestamp(),
+	}
+	tctx, err := query.ApplyMutations(ctx, m)
+	if tctx != nil {
+		// Like the values dropped with drop_invalid.
+		return &api.Payload{Warnings: tctx.Warnings}, err
+	}
 	return empty, err
 }
 
+// deletedNodes returns the subjects of the S * * deletions among the edges.
+func deletedNodes(edges []*intern.DirectedEdge) []uint64 {
+	var uids []uint64
+	for _, edge := range edges {
+		if edge.Op == intern.DirectedEdge_DEL && edge.Attr == x.Star {
+			uids = append(uids, edge.Entity)
+		}
+	}
+	return uids
+}
+
+// incomingEdges returns the edges deleting every edge that points at one of the uids, as seen
+// at startTs. Predicates with @reverse find them through their reverse edges. Others have to
+// be scanned, which reads every node having the predicate, and is returned as a warning.
+func (s *Server) incomingEdges(ctx context.Context, uids []uint64,
+	startTs uint64) ([]*intern.DirectedEdge, []string, error) {
+	if len(uids) == 0 {
+		return nil, nil, nil
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	uids = algo.MergeSorted([]*intern.List{{Uids: uids}}).Uids
+	preds, err := worker.GetSchemaOverNetwork(ctx,
+		&intern.SchemaRequest{Fields: []string{"type", "reverse"}})
+	if err != nil {
+		return nil, nil, err
+	}
+	var edges []*intern.DirectedEdge
+	var warnings []string
+	for _, pred := range preds {
+		if pred.Type != "uid" || pred.Predicate == x.PredicateListAttr ||
+			!x.PredicateAllowed(ctx, pred.Predicate) {
+			continue
+		}
+		if !pred.Reverse {
+			warning := fmt.Sprintf("Predicate %s has no @reverse index. Deleting incoming "+
+				"edges scanned every node having it.", pred.Predicate)
+			x.Printf("WARNING: %s\n", warning)
+			warnings = append(warnings, warning)
+			if tr, ok := trace.FromContext(ctx); ok {
+				tr.LazyPrintf("Scanning predicate %s for incoming edges", pred.Predicate)
+			}
+		}
+		err := edgeSources(ctx, pred, uids, startTs, func(src, uid uint64) {
+			edges = append(edges, &intern.DirectedEdge{
+				Entity:  src,
+				Attr:    pred.Predicate,
+				ValueId: uid,
+				Op:      intern.DirectedEdge_DEL,
+			})
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return edges, warnings, nil
+}
+
+// edgeSources calls found with every node having an edge of pred pointing at one of the
+// sorted uids.
+func edgeSources(ctx context.Context, pred *api.SchemaNode, uids []uint64, startTs uint64,
+	found func(src, uid uint64)) error {
+	if pred.Reverse {
+		res, err := worker.ProcessTaskOverNetwork(ctx, &intern.Query{
+			Attr:    pred.Predicate,
+			Reverse: true,
+			UidList: &intern.List{Uids: uids},
+			ReadTs:  startTs,
+		})
+		if err != nil {
+			return err
+		}
+		for i, sources := range res.UidMatrix {
+			for _, src := range sources.Uids {
+				found(src, uids[i])
+			}
+		}
+		return nil
+	}
+
+	// The lists committed in memory only are scanned as well, so that edges added by
+	// transactions committed just before aren't missed.
+	res, err := worker.ProcessTaskOverNetwork(ctx, &intern.Query{
+		Attr:          pred.Predicate,
+		SrcFunc:       &intern.SrcFunction{Name: "has"},
+		ReadTs:        startTs,
+		IncludeCached: true,
+	})
+	if err != nil {
+		return err
+	}
+	if len(res.UidMatrix) == 0 || len(res.UidMatrix[0].Uids) == 0 {
+		return nil
+	}
+	nodes := res.UidMatrix[0].Uids
+	res, err = worker.ProcessTaskOverNetwork(ctx, &intern.Query{
+		Attr:    pred.Predicate,
+		UidList: &intern.List{Uids: nodes},
+		ReadTs:  startTs,
+	})
+	if err != nil {
+		return err
+	}
+	for i, targets := range res.UidMatrix {
+		for _, uid := range algo.IntersectSorted([]*intern.List{targets, {Uids: uids}}).Uids {
+			found(nodes[i], uid)
+		}
+	}
+	return nil
+}
+
 func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assigned, err error) {
+	if ctx, err = withNamespace(ctx); err != nil {
+		return &api.Assigned{}, err
+	}
+	ae := newAuditRecord(ctx, "mutate")
+	defer func() {
+		if resp != nil && resp.Context != nil {
+			ae.setTs(mu.StartTs, resp.Context.CommitTs)
+		} else {
+			ae.setTs(mu.StartTs, 0)
+		}
+		ae.log(err)
+	}()
+
 	resp = &api.Assigned{}
 	if err := x.HealthCheck(); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
@@ -272,6 +559,15 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	if !isMutationAllowed(ctx) {
 		return nil, x.Errorf("No mutations allowed.")
 	}
+	if isStandby() && !isInternal(ctx) {
+		return nil, errStandby
+	}
+	ctx = hideReserved(ctx)
+	// Clients pass on the read_only mark of the txn context, so that any server can reject
+	// them. The timestamps this server leased for reads are rejected either way.
+	if mu.ReadOnly || (mu.StartTs != 0 && State.isReadOnlyTs(mu.StartTs)) {
+		return nil, errReadOnly
+	}
 	if mu.StartTs == 0 {
 		mu.StartTs = State.getTimestamp()
 	}
@@ -291,6 +587,7 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	if err != nil {
 		return resp, err
 	}
+	ae.setMutation(ctx, gmu)
 	newUids, err := query.AssignUids(ctx, gmu.Set)
 	if err != nil {
 		return resp, err
@@ -300,6 +597,21 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	if err != nil {
 		return resp, err
 	}
+	if mu.DeleteIncoming {
+		incoming, warnings, err := s.incomingEdges(ctx, deletedNodes(edges), mu.StartTs)
+		if err != nil {
+			return resp, err
+		}
+		edges = append(edges, incoming...)
+		resp.Warnings = warnings
+	}
+	preds := mutationPredicates(edges)
+	if err := checkPredicateNames(preds...); err != nil {
+		return resp, err
+	}
+	if err := authorize(ctx, writePerm, preds); err != nil {
+		return resp, err
+	}
 
 	m := &intern.Mutations{
 		Edges:               edges,
@@ -320,23 +632,35 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 		tr.LazyPrintf("Prewrites err: %v. Attempting to commit/abort immediately.", err)
 	}
 	ctxn := resp.Context
+	merr := err
 	if err != nil {
 		// Tell Zero to abort.
 		ctxn.Aborted = true
 	}
 	// zero would assign the CommitTs
 	cts, err := worker.CommitOverNetwork(ctx, ctxn)
+	State.sawTs(cts)
 	if ok {
 		tr.LazyPrintf("Status of commit at ts: %d: %v", ctxn.StartTs, err)
 	}
 	if err != nil {
 		if err == y.ErrAborted {
-			err = status.Errorf(codes.Aborted, err.Error())
 			resp.Context.Aborted = true
+			if status.Code(merr) == codes.InvalidArgument {
+				// The values break a constraint of the schema, so retrying won't help.
+				return resp, merr
+			}
+			err = status.Errorf(codes.Aborted, err.Error())
 		}
 		return resp, err
 	}
 	resp.Context.CommitTs = cts
+	for _, pred := range preds {
+		if pred == aclGroupRules || pred == x.Star {
+			acls.notify()
+			break
+		}
+	}
 	return resp, nil
 }
 
@@ -350,12 +674,19 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		return resp, err
 	}
 
+	if ctx, err = withNamespace(ctx); err != nil {
+		return resp, err
+	}
+
 	x.PendingQueries.Add(1)
 	x.NumQueries.Add(1)
 	defer x.PendingQueries.Add(-1)
 	if ctx.Err() != nil {
 		return resp, ctx.Err()
 	}
+	if err := checkReplicationLag(); err != nil {
+		return resp, err
+	}
 
 	if rand.Float64() < worker.Config.Tracing {
 		var tr trace.Trace
@@ -363,12 +694,23 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		defer tr.Finish()
 	}
 
+	ae := newAuditRecord(ctx, "query")
+	ae.setQuery(req.Query, req.Vars)
+	resp, _, err = s.query(ctx, req)
+	ae.setTs(req.StartTs, 0)
+	ae.log(err)
+	return resp, err
+}
+
+// query runs the request, and also returns the processed subgraphs.
+func (s *Server) query(ctx context.Context, req *api.Request) (resp *api.Response,
+	sgs []*query.SubGraph, err error) {
 	resp = new(api.Response)
 	if len(req.Query) == 0 {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Empty query")
 		}
-		return resp, fmt.Errorf("empty query")
+		return resp, nil, fmt.Errorf("empty query")
 	}
 
 	if Config.DebugMode {
@@ -385,14 +727,42 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		Variables: req.Vars,
 	})
 	if err != nil {
-		return resp, err
+		return resp, nil, err
 	}
+	if err := authorizeQuery(ctx, &parsedReq); err != nil {
+		return resp, nil, err
+	}
+	ctx = hideReserved(ctx)
 
+	if req.AsOfTs != 0 || req.AsOfTime != 0 {
+		if req.StartTs, err = readTsAsOf(ctx, req); err != nil {
+			if tr, ok := trace.FromContext(ctx); ok {
+				tr.LazyPrintf("Error while resolving as_of: %+v", err)
+			}
+			return resp, nil, err
+		}
+	}
+	if req.ReadOnly && req.BestEffort {
+		return resp, nil, x.Errorf("Only one of read_only and best_effort can be set")
+	}
+	if req.BestEffort {
+		// Don't wait for this server to catch up with the rest of the group.
+		req.LinRead = nil
+	}
 	if req.StartTs == 0 {
-		req.StartTs = State.getTimestamp()
+		switch {
+		case req.BestEffort:
+			req.StartTs = State.getBestEffortTs()
+		case req.ReadOnly:
+			req.StartTs = State.getReadOnlyTs()
+		default:
+			req.StartTs = State.getTimestamp()
+		}
 	}
 	resp.Txn = &api.TxnContext{
-		StartTs: req.StartTs,
+		StartTs:    req.StartTs,
+		ReadOnly:   req.ReadOnly,
+		BestEffort: req.BestEffort,
 	}
 
 	var queryRequest = query.QueryRequest{
@@ -407,16 +777,19 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Error while processing query: %+v", err)
 		}
-		return resp, x.Wrap(err)
+		return resp, nil, x.Wrap(err)
 	}
 	resp.Schema = er.SchemaNode
+	if aclEnabled() {
+		resp.Schema = readableSchema(ctx, resp.Schema)
+	}
 
 	json, err := query.ToJson(&l, er.Subgraphs)
 	if err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Error while converting to protocol buffer: %+v", err)
 		}
-		return resp, err
+		return resp, nil, err
 	}
 	resp.Json = json
 
@@ -428,10 +801,54 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 
 	resp.Latency = gl
 	resp.Txn.LinRead = queryRequest.LinRead
-	return resp, err
+	return resp, er.Subgraphs, err
+}
+
+// readTsAsOf resolves the as_of fields of a request into the timestamp to read at.
+func readTsAsOf(ctx context.Context, req *api.Request) (uint64, error) {
+	if req.StartTs != 0 {
+		return 0, x.Errorf("as_of can't be used within a transaction")
+	}
+	if req.AsOfTs != 0 && req.AsOfTime != 0 {
+		return 0, x.Errorf("Only one of as_of_ts and as_of_time can be set")
+	}
+	readTs := req.AsOfTs
+	if req.AsOfTime != 0 {
+		t := time.Unix(req.AsOfTime, 0)
+		if t.After(time.Now()) {
+			return 0, x.Errorf("as_of_time: %v is in the future", t)
+		}
+		var err error
+		if readTs, err = worker.TimestampAt(ctx, t); err != nil {
+			return 0, err
+		}
+		if readTs == 0 {
+			return 0, x.Errorf("No timestamp history at as_of_time: %v", t)
+		}
+	}
+	if max := posting.Oracle().MaxPending(); readTs > max {
+		return 0, x.Errorf("as_of_ts: %d is ahead of the latest timestamp: %d", readTs, max)
+	}
+	if err := posting.CheckReadTs(readTs); err != nil {
+		return 0, err
+	}
+	return readTs, nil
 }
 
 func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.TxnContext,
+	error) {
+	op := "commit"
+	if tc.Aborted {
+		op = "abort"
+	}
+	ae := newAuditRecord(ctx, op)
+	tctx, err := s.commitOrAbort(ctx, tc)
+	ae.setTs(tc.StartTs, tctx.CommitTs)
+	ae.log(err)
+	return tctx, err
+}
+
+func (s *Server) commitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.TxnContext,
 	error) {
 	if err := x.HealthCheck(); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
@@ -440,9 +857,17 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 		return &api.TxnContext{}, err
 	}
 
+	if err := Authenticate(ctx); err != nil {
+		return &api.TxnContext{}, err
+	}
+	if !tc.Aborted && (tc.ReadOnly || tc.BestEffort || State.isReadOnlyTs(tc.StartTs)) {
+		return &api.TxnContext{}, errReadOnly
+	}
+
 	tctx := &api.TxnContext{}
 
 	commitTs, err := worker.CommitOverNetwork(ctx, tc)
+	State.sawTs(commitTs)
 	if err == y.ErrAborted {
 		tctx.Aborted = true
 		return tctx, status.Errorf(codes.Aborted, err.Error())
@@ -451,6 +876,31 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 	return tctx, err
 }
 
+// Subscribe streams the edges of committed transactions. See worker.Subscribe.
+func (s *Server) Subscribe(req *api.SubscribeRequest, stream api.Dgraph_SubscribeServer) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	ctx, err := withNamespace(stream.Context())
+	if err != nil {
+		return err
+	}
+	preds := req.Predicates
+	if len(preds) == 0 {
+		preds = []string{x.Star}
+	}
+	ae := newAuditRecord(ctx, "subscribe")
+	ae.setRequest(map[string][]string{"predicates": req.Predicates})
+	if err = checkPredicateNames(req.Predicates...); err == nil {
+		err = authorize(ctx, readPerm, preds)
+	}
+	if err == nil {
+		err = worker.Subscribe(hideReserved(ctx), req, stream)
+	}
+	ae.log(err)
+	return err
+}
+
 func (s *Server) CheckVersion(ctx context.Context, c *api.Check) (v *api.Version, err error) {
 	if err := x.HealthCheck(); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
@@ -850,6 +1300,13 @@ func parseMutationObject(mu *api.Mutation) (*gql.Mutation, error) {
 	}
 	res.Set = append(res.Set, mu.Set...)
 	res.Del = append(res.Del, mu.Del...)
+	if mu.Ttl > 0 {
+		for _, nq := range res.Set {
+			if nq.Ttl == 0 {
+				nq.Ttl = mu.Ttl
+			}
+		}
+	}
 
 	return res, validWildcards(res.Set, res.Del)
 }
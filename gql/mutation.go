@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
@@ -123,11 +124,12 @@ func (nq NQuad) createEdge(subjectUid uint64, newToUid map[string]uint64) (*inte
 	var objectUid uint64
 
 	out := &intern.DirectedEdge{
-		Entity: subjectUid,
-		Attr:   nq.Predicate,
-		Label:  nq.Label,
-		Lang:   nq.Lang,
-		Facets: nq.Facets,
+		Entity:    subjectUid,
+		Attr:      nq.Predicate,
+		Label:     nq.Label,
+		Lang:      nq.Lang,
+		Facets:    nq.Facets,
+		ExpiresAt: nq.expiresAt(),
 	}
 
 	switch nq.valueType() {
@@ -150,12 +152,25 @@ func (nq NQuad) createEdge(subjectUid uint64, newToUid map[string]uint64) (*inte
 
 func (nq NQuad) createEdgePrototype(subjectUid uint64) *intern.DirectedEdge {
 	return &intern.DirectedEdge{
-		Entity: subjectUid,
-		Attr:   nq.Predicate,
-		Label:  nq.Label,
-		Lang:   nq.Lang,
-		Facets: nq.Facets,
+		Entity:    subjectUid,
+		Attr:      nq.Predicate,
+		Label:     nq.Label,
+		Lang:      nq.Lang,
+		Facets:    nq.Facets,
+		ExpiresAt: nq.expiresAt(),
+	}
+}
+
+// expiresAt returns the unix time at which an edge created from the NQuad now would expire,
+// or 0 if it has no TTL.
+func (nq NQuad) expiresAt() uint64 {
+	if nq.ExpiresAt != 0 {
+		return nq.ExpiresAt
+	}
+	if nq.Ttl == 0 {
+		return 0
 	}
+	return uint64(time.Now().Unix()) + nq.Ttl
 }
 
 func (nq NQuad) CreateUidEdge(subjectUid uint64, objectUid uint64) *intern.DirectedEdge {
@@ -284,6 +284,8 @@ func convertToVarMap(variables map[string]string) (vm varMap) {
 type Request struct {
 	Str       string
 	Variables map[string]string
+	// Query variables used outside of the query, e.g. by a delete by query.
+	UsedVars []string
 }
 
 func checkValueType(vm varMap) error {
@@ -510,6 +512,9 @@ func Parse(r Request) (res Result, rerr error) {
 		}
 
 		allVars := res.QueryVars
+		if len(r.UsedVars) > 0 {
+			allVars = append(allVars[:len(allVars):len(allVars)], &Vars{Needs: r.UsedVars})
+		}
 		if err := checkDependency(allVars); err != nil {
 			return res, err
 		}
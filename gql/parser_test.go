@@ -775,6 +775,22 @@ func TestParseQueryWithVarError1(t *testing.T) {
 	require.Contains(t, err.Error(), "Some variables are defined but not used")
 }
 
+func TestParseQueryWithUsedVars(t *testing.T) {
+	query := `
+	{
+		L as var(func: uid(0x0a)) {J AS friends}
+		me(func: uid(J)) {name}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	_, err = Parse(Request{Str: query, UsedVars: []string{"L"}})
+	require.NoError(t, err)
+	_, err = Parse(Request{Str: query, UsedVars: []string{"L", "M"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Some variables are used but not defined")
+}
+
 func TestParseQueryWithVarError2(t *testing.T) {
 	query := `
 	{
@@ -16,13 +16,20 @@
  */
 package posting
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 type Options struct {
 	Mu             sync.Mutex
 	AllottedMemory float64
 
 	CommitFraction float64
+
+	// How long older versions of posting lists are kept around for reads in the past.
+	// Zero disables history retention.
+	HistoryRetention time.Duration
 }
 
 var Config Options
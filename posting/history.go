@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package posting
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// historyHorizon is the oldest timestamp at which reads are still served, when history
+// retention is on. Versions needed to read at or after it aren't purged from disk. Zero
+// means that the horizon isn't known yet, in which case nothing is purged.
+var historyHorizon uint64
+
+func retainHistory() bool {
+	return Config.HistoryRetention > 0
+}
+
+// SetHistoryHorizon moves the history horizon forward to ts. It never moves it back.
+func SetHistoryHorizon(ts uint64) {
+	for {
+		cur := atomic.LoadUint64(&historyHorizon)
+		if ts <= cur || atomic.CompareAndSwapUint64(&historyHorizon, cur, ts) {
+			return
+		}
+	}
+}
+
+func HistoryHorizon() uint64 {
+	return atomic.LoadUint64(&historyHorizon)
+}
+
+// CheckReadTs returns an error if the versions needed to read at readTs might have been
+// purged already.
+func CheckReadTs(readTs uint64) error {
+	if !retainHistory() {
+		return x.Errorf("Reads in the past need history retention to be turned on")
+	}
+	horizon := HistoryHorizon()
+	if horizon == 0 {
+		return x.Errorf("History retention horizon isn't known yet")
+	}
+	if readTs < horizon {
+		return x.Errorf("Timestamp: %d is before the history retention horizon: %d",
+			readTs, horizon)
+	}
+	return nil
+}
+
+// purgeTs returns the version below which all versions of key can be purged, once the
+// complete posting list has been written at minTs. Returns zero if nothing can be purged.
+func purgeTs(key []byte, minTs uint64) uint64 {
+	if !retainHistory() {
+		return minTs
+	}
+	horizon := HistoryHorizon()
+	if horizon == 0 {
+		return 0
+	}
+	if horizon >= minTs {
+		return minTs
+	}
+	// Reads at the horizon need the latest complete posting list at or before it, along with
+	// all the deltas after that.
+	txn := pstore.NewTransactionAt(horizon, false)
+	defer txn.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	iterOpts.PrefetchValues = false
+	it := txn.NewIterator(iterOpts)
+	defer it.Close()
+	for it.Seek(key); it.Valid(); it.Next() {
+		item := it.Item()
+		if !bytes.Equal(item.Key(), key) {
+			break
+		}
+		if item.UserMeta()&BitCompletePosting > 0 {
+			return item.Version()
+		}
+	}
+	return 0
+}
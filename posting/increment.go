@@ -0,0 +1,359 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package posting
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync/atomic"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Increments are stored as Inc postings holding the delta. They don't replace the value
+// before them, but are folded into it when the list is read: the latest Set or Del committed
+// before readTs is the base, and the increments committed after it are added on top. Rollup
+// turns the folded value into a regular posting of the immutable layer.
+
+// numericValue returns the value of p converted to tid. A missing or deleted value counts as 0.
+func numericValue(p *intern.Posting, tid types.TypeID) (types.Val, error) {
+	if p == nil || p.Op == Del {
+		if tid == types.FloatID {
+			return types.Val{Tid: tid, Value: float64(0)}, nil
+		}
+		return types.Val{Tid: tid, Value: int64(0)}, nil
+	}
+	return types.Convert(valueToTypesVal(p), tid)
+}
+
+// addIncrement adds the delta held by the Inc posting p to v.
+func addIncrement(v types.Val, p *intern.Posting) (types.Val, error) {
+	d, err := numericValue(p, v.Tid)
+	if err != nil {
+		return v, err
+	}
+	switch v.Tid {
+	case types.IntID:
+		v.Value = v.Value.(int64) + d.Value.(int64)
+	case types.FloatID:
+		v.Value = v.Value.(float64) + d.Value.(float64)
+	default:
+		return v, x.Errorf("Cannot increment value of type %s", v.Tid.Name())
+	}
+	return v, nil
+}
+
+func binaryValue(v types.Val) ([]byte, error) {
+	b := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(v, &b); err != nil {
+		return nil, err
+	}
+	return b.Value.([]byte), nil
+}
+
+// hasIncrements tells whether any mutation to the uid at midx is an increment.
+func (l *List) hasIncrements(midx int) bool {
+	uid := l.mlayer[midx].Uid
+	for ; midx < len(l.mlayer) && l.mlayer[midx].Uid == uid; midx++ {
+		if l.mlayer[midx].Op == Inc {
+			return true
+		}
+	}
+	return false
+}
+
+// foldIncrements returns the posting for the uid at midx as of readTs, with its increments
+// applied. base is the posting of the uid in the immutable layer, if any. Postings for which
+// skip returns true are left out. A Del posting is returned if there is no value.
+func (l *List) foldIncrements(midx int, readTs, deleteTs uint64, base *intern.Posting,
+	skip func(p *intern.Posting) bool) (*intern.Posting, error) {
+	l.AssertRLock()
+	uid := l.mlayer[midx].Uid
+	// The immutable layer precedes everything in the mutable one.
+	var baseTs, latestTs uint64
+	latest := base
+	var incs []*intern.Posting
+	var incTs []uint64
+	for ; midx < len(l.mlayer) && l.mlayer[midx].Uid == uid; midx++ {
+		mp := l.mlayer[midx]
+		if (skip != nil && skip(mp)) || !l.inSnapshot(mp, readTs, deleteTs) {
+			continue
+		}
+		// Commits can be applied out of order, so go by commitTs and not by position.
+		ts := atomic.LoadUint64(&mp.CommitTs)
+		if ts == 0 {
+			// Uncommitted mutations of the reading txn come last.
+			ts = math.MaxUint64
+		}
+		if ts > latestTs {
+			latest, latestTs = mp, ts
+		}
+		if mp.Op == Inc {
+			incs = append(incs, mp)
+			incTs = append(incTs, ts)
+		} else if ts > baseTs {
+			base, baseTs = mp, ts
+		}
+	}
+
+	var deltas []*intern.Posting
+	for i, p := range incs {
+		if incTs[i] > baseTs {
+			deltas = append(deltas, p)
+		}
+	}
+	if len(deltas) == 0 {
+		if base == nil {
+			return &intern.Posting{Uid: uid, Op: Del}, nil
+		}
+		return base, nil
+	}
+
+	tid := types.TypeID(deltas[0].ValType)
+	sum, err := numericValue(base, tid)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range deltas {
+		if sum, err = addIncrement(sum, p); err != nil {
+			return nil, err
+		}
+	}
+	var folded intern.Posting
+	if base != nil && base.Op != Del {
+		folded = *base
+	} else {
+		folded = *deltas[0]
+	}
+	if folded.Value, err = binaryValue(sum); err != nil {
+		return nil, err
+	}
+	folded.Op = Set
+	folded.ValType = intern.Posting_ValType(tid)
+	folded.StartTs = latest.StartTs
+	folded.CommitTs = atomic.LoadUint64(&latest.CommitTs)
+	return &folded, nil
+}
+
+// mergeOwnIncrement applies the increment mpost to a value written earlier by the same txn.
+// It returns true if mpost got merged, false if it should be added as a posting of its own.
+func (l *List) mergeOwnIncrement(mpost *intern.Posting) (bool, error) {
+	l.AssertLock()
+	if l.markdeleteAll == mpost.StartTs {
+		// The txn deleted all values before, so this starts from 0.
+		mpost.Op = Set
+		return false, nil
+	}
+	midx := sort.Search(len(l.mlayer), func(idx int) bool {
+		mp := l.mlayer[idx]
+		if mpost.Uid != mp.Uid {
+			return mpost.Uid < mp.Uid
+		}
+		return mpost.StartTs >= mp.StartTs
+	})
+	if midx >= len(l.mlayer) || l.mlayer[midx].Uid != mpost.Uid ||
+		l.mlayer[midx].StartTs != mpost.StartTs {
+		return false, nil
+	}
+	own := l.mlayer[midx]
+	if own.Op == Del {
+		mpost.Op = Set
+		return false, nil
+	}
+	tid := types.TypeID(mpost.ValType)
+	v, err := numericValue(own, tid)
+	if err != nil {
+		return false, err
+	}
+	if v, err = addIncrement(v, mpost); err != nil {
+		return false, err
+	}
+	if own.Value, err = binaryValue(v); err != nil {
+		return false, err
+	}
+	own.ValType = mpost.ValType
+	return true, nil
+}
+
+// ownPendingIncrement tells whether p is an increment of the txn reading at readTs. Those are
+// only indexed at commit, so index maintenance before that must leave them out.
+func ownPendingIncrement(readTs uint64) func(p *intern.Posting) bool {
+	return func(p *intern.Posting) bool {
+		return p.Op == Inc && p.StartTs == readTs && atomic.LoadUint64(&p.CommitTs) == 0
+	}
+}
+
+// ownSetValue returns the value the txn started at startTs set for uid, if any.
+func (l *List) ownSetValue(startTs, uid uint64) (types.Val, bool) {
+	l.RLock()
+	defer l.RUnlock()
+	for _, mp := range l.mlayer {
+		if mp.Uid == uid && mp.StartTs == startTs && mp.Op == Set {
+			return valueToTypesVal(mp), true
+		}
+	}
+	return types.Val{}, false
+}
+
+// hasPendingIndexedIncrements tells whether the list holds increments to an indexed value
+// which aren't committed in memory yet.
+func (l *List) hasPendingIndexedIncrements() bool {
+	l.AssertRLock()
+	for _, mp := range l.mlayer {
+		if mp.Op != Inc {
+			continue
+		}
+		if _, ok := l.activeTxns[mp.StartTs]; ok {
+			pk := x.Parse(l.key)
+			return pk != nil && schema.State().IsIndexed(pk.Attr)
+		}
+	}
+	return false
+}
+
+// foldedPosting returns the posting for uid as of readTs with its increments applied, leaving
+// out postings for which skip returns true. It returns nil if there is no posting.
+func (l *List) foldedPosting(readTs, uid uint64,
+	skip func(p *intern.Posting) bool) (*intern.Posting, error) {
+	l.AssertRLock()
+	if readTs < l.minTs {
+		return nil, ErrTsTooOld
+	}
+	deleteTs := l.deleteTs(readTs)
+	var p *intern.Posting
+	if l.minTs > deleteTs {
+		var pitr PIterator
+		pitr.Init(l.plist, uid-1)
+		if pitr.Valid() && pitr.Posting().Uid == uid {
+			p = pitr.Posting()
+		}
+	}
+	midx := sort.Search(len(l.mlayer), func(idx int) bool {
+		return uid <= l.mlayer[idx].Uid
+	})
+	if midx < len(l.mlayer) && l.mlayer[midx].Uid == uid {
+		return l.foldIncrements(midx, readTs, deleteTs, p, skip)
+	}
+	return p, nil
+}
+
+// incrementedValues returns the value before and after the increment inc, committed at
+// commitTs. before has a nil Value if there was none.
+func (l *List) incrementedValues(commitTs uint64, inc *intern.Posting) (before,
+	after types.Val, err error) {
+	l.RLock()
+	defer l.RUnlock()
+	p, err := l.foldedPosting(commitTs, inc.Uid, func(mp *intern.Posting) bool {
+		return mp == inc
+	})
+	if err != nil {
+		return before, after, err
+	}
+	if p != nil && p.Op != Del {
+		before = valueToTypesVal(p)
+	}
+	tid := types.TypeID(inc.ValType)
+	v, err := numericValue(p, tid)
+	if err != nil {
+		return before, after, err
+	}
+	if v, err = addIncrement(v, inc); err != nil {
+		return before, after, err
+	}
+	after.Tid = tid
+	after.Value, err = binaryValue(v)
+	return before, after, err
+}
+
+// indexIncrements returns a txn holding the index updates for the values incremented by tx.
+// They are only known at commit, so the txn starts at commitTs. That way its postings
+// order by commit against those written for other increments of the same value.
+func (tx *Txn) indexIncrements(ctx context.Context, commitTs uint64) *Txn {
+	// Only the last write of tx to a value counts.
+	last := make(map[string]*intern.Posting)
+	var keys []string
+	for _, d := range tx.deltas {
+		if _, ok := last[string(d.key)]; !ok {
+			keys = append(keys, string(d.key))
+		}
+		last[string(d.key)] = d.posting
+	}
+	var idx *Txn
+	for _, key := range keys {
+		inc := last[key]
+		if inc.Op != Inc {
+			continue
+		}
+		pk := x.Parse([]byte(key))
+		if pk == nil || !pk.IsData() || !schema.State().IsIndexed(pk.Attr) {
+			continue
+		}
+		before, after, err := Get([]byte(key)).incrementedValues(commitTs, inc)
+		if err != nil {
+			x.Printf("Error while indexing increment of %s for uid %#x at %d: %v\n",
+				pk.Attr, pk.Uid, commitTs, err)
+			continue
+		}
+		if idx == nil {
+			idx = &Txn{StartTs: commitTs, IgnoreIndexConflict: true}
+		}
+		edge := &intern.DirectedEdge{Entity: pk.Uid, Attr: pk.Attr}
+		if before.Value != nil {
+			err = idx.addIndexMutations(ctx, edge, before, intern.DirectedEdge_DEL)
+		}
+		if err == nil {
+			err = idx.addIndexMutations(ctx, edge, after, intern.DirectedEdge_SET)
+		}
+		if err != nil {
+			x.Printf("Error while indexing increment of %s for uid %#x at %d: %v\n",
+				pk.Attr, pk.Uid, commitTs, err)
+		}
+	}
+	return idx
+}
+
+// addIncrementWithIndex is AddMutationWithIndex for an increment of an indexed value.
+func (l *List) addIncrementWithIndex(ctx context.Context, t *intern.DirectedEdge, txn *Txn,
+	hasCountIndex bool) error {
+	// Increments only apply to single untagged values, see ValidateAndConvert.
+	before, set := l.ownSetValue(txn.StartTs, math.MaxUint64)
+	_, _, cp, err := txn.addMutationHelper(ctx, l, false, hasCountIndex, t)
+	if err != nil {
+		return err
+	}
+	x.PredicateStats.Add(t.Attr, 1)
+	if hasCountIndex && cp.countAfter != cp.countBefore {
+		if err := txn.updateCount(ctx, cp); err != nil {
+			return err
+		}
+	}
+	after, ok := l.ownSetValue(txn.StartTs, math.MaxUint64)
+	if !ok {
+		return nil
+	}
+	if set {
+		if err := txn.addIndexMutations(ctx, t, before, intern.DirectedEdge_DEL); err != nil {
+			return err
+		}
+	}
+	return txn.addIndexMutations(ctx, t, after, intern.DirectedEdge_SET)
+}
@@ -22,7 +22,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/trace"
@@ -30,6 +32,7 @@ import (
 	"github.com/dgraph-io/badger"
 	"github.com/dgryski/go-farm"
 
+	"github.com/dgraph-io/dgraph/bp128"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
@@ -94,10 +97,12 @@ func (txn *Txn) addIndexMutations(ctx context.Context, t *intern.DirectedEdge, p
 	}
 
 	// Create a value token -> uid edge.
+	// The index entry expires along with the value, so that lookups skip it as well.
 	edge := &intern.DirectedEdge{
-		ValueId: uid,
-		Attr:    attr,
-		Op:      op,
+		ValueId:   uid,
+		Attr:      attr,
+		Op:        op,
+		ExpiresAt: t.ExpiresAt,
 	}
 
 	for _, token := range tokens {
@@ -181,11 +186,12 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *intern.DirectedEdge)
 
 	x.AssertTrue(plist != nil)
 	edge := &intern.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 
 	hasCountIndex := schema.State().HasCount(t.Attr)
@@ -324,9 +330,9 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 	if doUpdateIndex {
 		// Check original value BEFORE any mutation actually happens.
 		if len(t.Lang) > 0 {
-			val, found, err = l.findValue(txn.StartTs, farm.Fingerprint64([]byte(t.Lang)))
+			val, found, err = l.findStoredValue(txn.StartTs, farm.Fingerprint64([]byte(t.Lang)))
 		} else {
-			val, found, err = l.findValue(txn.StartTs, math.MaxUint64)
+			val, found, err = l.findStoredValue(txn.StartTs, math.MaxUint64)
 		}
 		if err != nil {
 			return val, found, emptyCountParams, err
@@ -373,6 +379,11 @@ func (l *List) AddMutationWithIndex(ctx context.Context, t *intern.DirectedEdge,
 
 	doUpdateIndex := pstore != nil && (t.Value != nil) && schema.State().IsIndexed(t.Attr)
 	hasCountIndex := schema.State().HasCount(t.Attr)
+	if doUpdateIndex && t.Op == intern.DirectedEdge_INC {
+		// Increments are indexed at commit, once the value they apply to is known. Only
+		// those merged into a value set by the same txn are indexed right away.
+		return l.addIncrementWithIndex(ctx, t, txn, hasCountIndex)
+	}
 	val, found, cp, err := txn.addMutationHelper(ctx, l, doUpdateIndex, hasCountIndex, t)
 	if err != nil {
 		return err
@@ -413,6 +424,12 @@ func (l *List) AddMutationWithIndex(ctx context.Context, t *intern.DirectedEdge,
 }
 
 func deleteEntries(prefix []byte) error {
+	return deleteEntriesIf(prefix, nil)
+}
+
+// deleteEntriesIf deletes the keys with the prefix for which del returns true, or all of them
+// if it's nil.
+func deleteEntriesIf(prefix []byte, del func(key []byte) bool) error {
 	iterOpt := badger.DefaultIteratorOptions
 	iterOpt.PrefetchValues = false
 	txn := pstore.NewTransactionAt(math.MaxUint64, false)
@@ -426,6 +443,9 @@ func deleteEntries(prefix []byte) error {
 	var err error
 	for idxIt.Seek(prefix); idxIt.ValidForPrefix(prefix); idxIt.Next() {
 		item := idxIt.Item()
+		if del != nil && !del(item.Key()) {
+			continue
+		}
 		nkey := make([]byte, len(item.Key()))
 		copy(nkey, item.Key())
 
@@ -510,7 +530,11 @@ func rebuildCountIndex(ctx context.Context, attr string, reverse bool, doneCh ch
 					Attr:    attr,
 					Op:      intern.DirectedEdge_SET,
 				}
-				len := l.Length(txn.StartTs, 0)
+				// Count every stored posting, like mutations do, so that purging
+				// expired postings later moves the uid to the right count.
+				l.RLock()
+				len := l.length(txn.StartTs, 0)
+				l.RUnlock()
 				if len == -1 {
 					continue
 				}
@@ -621,6 +645,7 @@ func RebuildReverseEdges(ctx context.Context, attr string, startTs uint64) {
 			edge.Op = intern.DirectedEdge_SET
 			edge.Facets = pp.Facets
 			edge.Label = pp.Label
+			edge.ExpiresAt = pp.ExpiresAt
 			err = txn.addReverseMutation(ctx, &edge)
 			for err == ErrRetry {
 				time.Sleep(10 * time.Millisecond)
@@ -723,6 +748,7 @@ func RebuildIndex(ctx context.Context, attr string, startTs uint64) {
 				Value: p.Value,
 				Tid:   types.TypeID(p.ValType),
 			}
+			edge.ExpiresAt = p.ExpiresAt
 			err = txn.addIndexMutations(ctx, &edge, val, intern.DirectedEdge_SET)
 			for err == ErrRetry {
 				time.Sleep(10 * time.Millisecond)
@@ -792,11 +818,221 @@ func RebuildIndex(ctx context.Context, attr string, startTs uint64) {
 	}
 }
 
+// flushPredicate writes the data lists of attr held in memory to disk, so that iterating over
+// the store sees every committed value.
+func flushPredicate(attr string) {
+	CommitLists(func(key []byte) bool {
+		return compareAttrAndType(key, attr, x.ByteData)
+	})
+}
+
+// IterateValues calls fn for every posting committed to the data lists of attr as of readTs,
+// stopping at the first error.
+func IterateValues(attr string, readTs uint64, fn func(uid uint64, p *intern.Posting) error) error {
+	flushPredicate(attr)
+	pk := x.ParsedKey{Attr: attr}
+	prefix := pk.DataPrefix()
+	t := pstore.NewTransactionAt(readTs, false)
+	defer t.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := t.NewIterator(iterOpts)
+	defer it.Close()
+
+	var prevKey []byte
+	it.Seek(prefix)
+	for it.ValidForPrefix(prefix) {
+		key := it.Item().Key()
+		if bytes.Equal(key, prevKey) {
+			it.Next()
+			continue
+		}
+		nk := make([]byte, len(key))
+		copy(nk, key)
+		prevKey = nk
+		pki := x.Parse(nk)
+		if pki == nil {
+			it.Next()
+			continue
+		}
+		l, err := ReadPostingList(nk, it)
+		if err != nil {
+			return err
+		}
+		var ferr error
+		if err := l.Iterate(readTs, 0, func(p *intern.Posting) bool {
+			ferr = fn(pki.Uid, p)
+			return ferr == nil
+		}); err != nil {
+			return err
+		}
+		if ferr != nil {
+			return ferr
+		}
+	}
+	return nil
+}
+
+// RewriteValues replaces the postings of every data list of attr with those returned by
+// rewrite. Each new list is written as a complete posting list at commitTs, which must be
+// newer than any commit to attr, so that reads at earlier timestamps still see the older
+// versions. Lists of attr cached in memory are evicted, so later reads see the new postings.
+func RewriteValues(attr string, commitTs uint64,
+	rewrite func(uid uint64, postings []*intern.Posting) ([]*intern.Posting, error)) error {
+	flushPredicate(attr)
+	evict := func(key []byte) bool {
+		return compareAttrAndType(key, attr, x.ByteData)
+	}
+	lcache.clear(evict)
+	defer lcache.clear(evict)
+
+	pk := x.ParsedKey{Attr: attr}
+	prefix := pk.DataPrefix()
+	t := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer t.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := t.NewIterator(iterOpts)
+	defer it.Close()
+
+	var hasError uint32
+	var wg sync.WaitGroup
+	var prevKey []byte
+	it.Seek(prefix)
+	for it.ValidForPrefix(prefix) {
+		key := it.Item().Key()
+		if bytes.Equal(key, prevKey) {
+			it.Next()
+			continue
+		}
+		nk := make([]byte, len(key))
+		copy(nk, key)
+		prevKey = nk
+		pki := x.Parse(nk)
+		if pki == nil {
+			it.Next()
+			continue
+		}
+		l, err := ReadPostingList(nk, it)
+		if err != nil {
+			return err
+		}
+		if l.commitTs >= commitTs {
+			wg.Wait()
+			return x.Errorf("Predicate %s was written at ts %d, after the conversion at %d."+
+				" Retry the schema change.", attr, l.commitTs, commitTs)
+		}
+		var postings []*intern.Posting
+		if err := l.Iterate(math.MaxUint64, 0, func(p *intern.Posting) bool {
+			postings = append(postings, p)
+			return true
+		}); err != nil {
+			return err
+		}
+		if postings, err = rewrite(pki.Uid, postings); err != nil {
+			return err
+		}
+		sort.Slice(postings, func(i, j int) bool {
+			return postings[i].Uid < postings[j].Uid
+		})
+		plist := &intern.PostingList{Postings: postings}
+		uids := make([]uint64, 0, len(postings))
+		for _, p := range postings {
+			p.StartTs, p.CommitTs = 0, 0
+			uids = append(uids, p.Uid)
+		}
+		if len(uids) > 0 {
+			plist.Uids = bp128.DeltaPack(uids)
+		}
+		val, meta := marshalPostingList(plist)
+
+		wg.Add(1)
+		txn := pstore.NewTransactionAt(math.MaxUint64, true)
+		txn.SetWithMeta(nk, x.EncryptValue(val), meta)
+		txn.CommitAt(commitTs, func(err error) {
+			if err != nil {
+				atomic.StoreUint32(&hasError, 1)
+			}
+			wg.Done()
+		})
+		txn.Discard()
+	}
+	wg.Wait()
+	if hasError > 0 {
+		return x.Errorf("Error while rewriting values of predicate %s", attr)
+	}
+	return nil
+}
+
+// CopyPredicate writes every version of every data, index, reverse and count list of attr under
+// newName, so that reads at older timestamps find them too. Lists of either predicate cached in
+// memory are evicted.
+func CopyPredicate(attr, newName string) error {
+	belongs := func(key []byte) bool {
+		pk := x.Parse(key)
+		return pk != nil && (pk.Attr == attr || pk.Attr == newName)
+	}
+	CommitLists(belongs)
+	lcache.clear(belongs)
+	defer lcache.clear(belongs)
+
+	prefix := x.PredicatePrefix(attr)
+	newPrefix := x.PredicatePrefix(newName)
+	t := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer t.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := t.NewIterator(iterOpts)
+	defer it.Close()
+
+	var hasError uint32
+	var wg sync.WaitGroup
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		key := item.Key()
+		// Keys of a predicate start with the same prefix, which is all that embeds its name.
+		newKey := append(append([]byte{}, newPrefix...), key[len(prefix):]...)
+		// Deltas are copied as they are, as they only make sense on top of the older versions.
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		val = append([]byte{}, val...)
+		wg.Add(1)
+		txn := pstore.NewTransactionAt(math.MaxUint64, true)
+		txn.SetWithMeta(newKey, val, item.UserMeta())
+		txn.CommitAt(item.Version(), func(err error) {
+			if err != nil {
+				atomic.StoreUint32(&hasError, 1)
+			}
+			wg.Done()
+		})
+		txn.Discard()
+	}
+	wg.Wait()
+	if hasError > 0 {
+		return x.Errorf("Error while copying predicate %s to %s", attr, newName)
+	}
+	return nil
+}
+
 func DeleteAll() error {
 	lcache.clear(func([]byte) bool { return true })
 	return deleteEntries(nil)
 }
 
+// DeleteUids deletes the data of attr for the uids for which del returns true. Their index
+// entries are left as they are, to be rebuilt by the caller.
+func DeleteUids(ctx context.Context, attr string, del func(uid uint64) bool) error {
+	delKey := func(key []byte) bool {
+		pk := x.Parse(key)
+		return pk != nil && pk.Attr == attr && pk.IsData() && del(pk.Uid)
+	}
+	lcache.clear(delKey)
+	pk := x.ParsedKey{Attr: attr}
+	return deleteEntriesIf(pk.DataPrefix(), delKey)
+}
+
 func DeletePredicate(ctx context.Context, attr string) error {
 	lcache.clear(func(key []byte) bool {
 		return compareAttrAndType(key, attr, x.ByteData)
@@ -29,6 +29,7 @@ import (
 
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
@@ -295,6 +296,30 @@ func TestRebuildIndex(t *testing.T) {
 	require.EqualValues(t, 91, uids2[0])
 }
 
+func TestRewriteValues(t *testing.T) {
+	addEdgeToValue(t, "nick", 93, "Mich", uint64(1), uint64(2))
+	values := func(readTs uint64) []string {
+		var out []string
+		require.NoError(t, IterateValues("nick", readTs, func(uid uint64, p *intern.Posting) error {
+			out = append(out, string(p.Value))
+			return nil
+		}))
+		return out
+	}
+	rewrite := func(uid uint64, postings []*intern.Posting) ([]*intern.Posting, error) {
+		for _, p := range postings {
+			p.Value = []byte("Michonne")
+		}
+		return postings, nil
+	}
+
+	// It can't be written under a newer commit.
+	require.Error(t, RewriteValues("nick", 2, rewrite))
+	require.NoError(t, RewriteValues("nick", 5, rewrite))
+	require.Equal(t, []string{"Mich"}, values(4))
+	require.Equal(t, []string{"Michonne"}, values(5))
+}
+
 func TestRebuildReverseEdges(t *testing.T) {
 	schema.ParseBytes([]byte(schemaVal), 1)
 	addEdgeToUID(t, "friend", 1, 23, uint64(10), uint64(11))
@@ -349,3 +374,67 @@ func TestRebuildReverseEdges(t *testing.T) {
 	require.EqualValues(t, 2, uids0[1])
 	require.EqualValues(t, 1, uids1[0])
 }
+
+// storedUids returns the uids in the list at readTs, along with the expired ones.
+func storedUids(t *testing.T, l *List, readTs uint64) []uint64 {
+	var res []uint64
+	require.NoError(t, l.Iterate(readTs, 0, func(p *intern.Posting) bool {
+		res = append(res, p.Uid)
+		return true
+	}))
+	return res
+}
+
+func TestExpiredIndexAndReverse(t *testing.T) {
+	schema.ParseBytes([]byte(schemaVal), 1)
+	past := uint64(time.Now().Unix() - 10)
+	future := uint64(time.Now().Add(time.Hour).Unix())
+	term, ok := tok.GetTokenizer("term")
+	require.True(t, ok)
+	indexKey := x.IndexKey("name2", string(term.Identifier())+"ephemeral")
+
+	for i, expiresAt := range []uint64{past, 0, future} {
+		uid := uint64(30 + i)
+		edge := &intern.DirectedEdge{
+			Value:     []byte("ephemeral"),
+			Attr:      "name2",
+			Entity:    uid,
+			ExpiresAt: expiresAt,
+		}
+		addMutation(t, Get(x.DataKey("name2", uid)), edge, Set, uint64(20+2*i),
+			uint64(21+2*i), true)
+	}
+	// The index entries are written, but the one of the expired value is skipped by reads
+	// until the purge deletes it.
+	require.Equal(t, []uint64{30, 31, 32}, storedUids(t, Get(indexKey), 26))
+	require.Equal(t, []uint64{31, 32}, uids(Get(indexKey), 26))
+	edge := &intern.DirectedEdge{
+		Value:  []byte("ephemeral"),
+		Attr:   "name2",
+		Entity: 30,
+	}
+	addMutation(t, Get(x.DataKey("name2", 30)), edge, Del, 27, 28, true)
+	require.Equal(t, []uint64{31, 32}, storedUids(t, Get(indexKey), 29))
+
+	reverseKey := x.ReverseKey("friend", 40)
+	for i, expiresAt := range []uint64{past, future} {
+		uid := uint64(30 + i)
+		edge := &intern.DirectedEdge{
+			ValueId:   40,
+			Attr:      "friend",
+			Entity:    uid,
+			ExpiresAt: expiresAt,
+		}
+		addMutation(t, Get(x.DataKey("friend", uid)), edge, Set, uint64(30+2*i),
+			uint64(31+2*i), true)
+	}
+	require.Equal(t, []uint64{30, 31}, storedUids(t, Get(reverseKey), 34))
+	require.Equal(t, []uint64{31}, uids(Get(reverseKey), 34))
+	edge = &intern.DirectedEdge{
+		ValueId: 40,
+		Attr:    "friend",
+		Entity:  30,
+	}
+	addMutation(t, Get(x.DataKey("friend", 30)), edge, Del, 35, 36, true)
+	require.Equal(t, []uint64{31}, storedUids(t, Get(reverseKey), 37))
+}
@@ -60,6 +60,8 @@ const (
 	Set uint32 = 0x01
 	// Del means delete in mutation layer. It contributes -1 in Length.
 	Del uint32 = 0x02
+	// Inc adds its value to the current one in mutation layer. It contributes like Set in Length.
+	Inc uint32 = 0x03
 
 	// Metadata Bit which is stored to find out whether the stored value is pl or byte slice.
 	BitUidPosting      byte = 0x01
@@ -197,6 +199,8 @@ func NewPosting(t *intern.DirectedEdge) *intern.Posting {
 		op = Set
 	} else if t.Op == intern.DirectedEdge_DEL {
 		op = Del
+	} else if t.Op == intern.DirectedEdge_INC {
+		op = Inc
 	} else {
 		x.Fatalf("Unhandled operation: %+v", t)
 	}
@@ -219,6 +223,7 @@ func NewPosting(t *intern.DirectedEdge) *intern.Posting {
 		Label:       t.Label,
 		Op:          op,
 		Facets:      t.Facets,
+		ExpiresAt:   t.ExpiresAt,
 	}
 }
 
@@ -244,7 +249,7 @@ func (l *List) SetForDeletion() bool {
 // Ensure that you either abort the uncomitted postings or commit them before calling me.
 func (l *List) updateMutationLayer(startTs uint64, mpost *intern.Posting) bool {
 	l.AssertLock()
-	x.AssertTrue(mpost.Op == Set || mpost.Op == Del)
+	x.AssertTrue(mpost.Op == Set || mpost.Op == Del || mpost.Op == Inc)
 	if mpost.Op == Del && bytes.Equal(mpost.Value, []byte(x.Star)) {
 		l.markdeleteAll = startTs
 		// Remove all mutations done in same transaction.
@@ -325,12 +330,15 @@ func (l *List) addMutation(ctx context.Context, txn *Txn, t *intern.DirectedEdge
 		bytes.Equal(t.Value, []byte(x.Star))
 	doAbort := hasPendingDelete || txn.StartTs < l.commitTs
 	ignoreConflict := false
-	if t.Attr == "_predicate_" {
+	if _, pred := x.ParseNamespaceAttr(t.Attr); pred == x.PredicateListAttr {
 		doAbort = false
 		ignoreConflict = true
 	} else if txn.IgnoreIndexConflict && !x.Parse(l.key).IsData() {
 		doAbort = false
 		ignoreConflict = true
+	} else if t.Op == intern.DirectedEdge_INC {
+		// Increments commute, so they apply on top of whatever got committed since the start.
+		doAbort = false
 	}
 	if doAbort {
 		txn.SetAbort()
@@ -361,6 +369,11 @@ func (l *List) addMutation(ctx context.Context, txn *Txn, t *intern.DirectedEdge
 
 	mpost.Uid = t.ValueId
 	mpost.StartTs = txn.StartTs
+	if mpost.Op == Inc {
+		if merged, err := l.mergeOwnIncrement(mpost); err != nil || merged {
+			return merged, err
+		}
+	}
 	t1 := time.Now()
 	hasMutated := l.updateMutationLayer(txn.StartTs, mpost)
 	atomic.AddInt32(&l.estimatedSize, int32(mpost.Size()+16 /* various overhead */))
@@ -476,6 +489,7 @@ func (l *List) deleteHelper(ctx context.Context) error {
 
 // Iterate will allow you to iterate over this Posting List, while having acquired a read lock.
 // So, please keep this iteration cheap, otherwise mutations would get stuck.
+// Expired postings are included, use Expired to skip them.
 // The iteration will start after the provided UID. The results would not include this UID.
 // The function will loop until either the Posting List is fully iterated, or you return a false
 // in the provided function, which will indicate to the function to break out of the iteration.
@@ -516,9 +530,10 @@ func (l *List) inSnapshot(mpost *intern.Posting, readTs, deleteTs uint64) bool {
 	return commitTs <= readTs && commitTs >= deleteTs
 }
 
-func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Posting) bool) error {
+// deleteTs returns the commit timestamp of the s p * deletion visible at readTs. Postings
+// committed before it are hidden.
+func (l *List) deleteTs(readTs uint64) uint64 {
 	l.AssertRLock()
-	midx := 0
 	var deleteTs uint64
 	if l.markdeleteAll == 0 {
 	} else if l.markdeleteAll == readTs {
@@ -529,8 +544,18 @@ func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Postin
 		// Fixing the pl is difficult with locks.
 		deleteTs = Oracle().CommitTs(l.markdeleteAll)
 	}
+	return deleteTs
+}
+
+func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Posting) bool) error {
+	l.AssertRLock()
+	midx := 0
+	deleteTs := l.deleteTs(readTs)
 	if readTs < l.minTs {
-		return x.Errorf("readTs: %d less than minTs: %d for key: %q", readTs, l.minTs, l.key)
+		if !retainHistory() {
+			return x.Errorf("readTs: %d less than minTs: %d for key: %q", readTs, l.minTs, l.key)
+		}
+		return l.iterateHistory(readTs, afterUid, f)
 	}
 	mlayerLen := len(l.mlayer)
 	if afterUid > 0 {
@@ -561,6 +586,16 @@ func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Postin
 		} else {
 			pp = emptyPosting
 		}
+		if mp.Uid != 0 && mp.Uid != prevUid && l.hasIncrements(midx) {
+			var base *intern.Posting
+			if pp.Uid == mp.Uid {
+				base = pp
+			}
+			var err error
+			if mp, err = l.foldIncrements(midx, readTs, deleteTs, base, nil); err != nil {
+				return err
+			}
+		}
 
 		switch {
 		case prevUid != 0 && mp.Uid == prevUid:
@@ -590,6 +625,50 @@ func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Postin
 	return nil
 }
 
+// Expired tells whether the TTL of the posting has run out by now, given as a unix time.
+func Expired(p *intern.Posting, now int64) bool {
+	return p.ExpiresAt != 0 && p.ExpiresAt <= uint64(now)
+}
+
+// iterateLive is iterate without the expired postings. Reads go through it, while mutations
+// and rollups see every posting, so that the purge can remove expired postings along with
+// their index entries.
+func (l *List) iterateLive(readTs uint64, afterUid uint64, f func(obj *intern.Posting) bool) error {
+	now := time.Now().Unix()
+	return l.iterate(readTs, afterUid, func(p *intern.Posting) bool {
+		if Expired(p, now) {
+			return true
+		}
+		return f(p)
+	})
+}
+
+// hasExpiringPostings tells whether any posting in the immutable layer has a TTL. Such a list
+// can't be read straight from its packed uids.
+func hasExpiringPostings(pl *intern.PostingList) bool {
+	for _, p := range pl.Postings {
+		if p.ExpiresAt != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// iterateHistory iterates over the list as it was at readTs, which is older than the
+// immutable layer. The older versions are read back from disk.
+func (l *List) iterateHistory(readTs uint64, afterUid uint64, f func(obj *intern.Posting) bool) error {
+	if err := CheckReadTs(readTs); err != nil {
+		return err
+	}
+	hl, err := readListAt(l.key, readTs)
+	if err != nil {
+		return err
+	}
+	hl.RLock()
+	defer hl.RUnlock()
+	return hl.iterate(readTs, afterUid, f)
+}
+
 func (l *List) CommitTs() uint64 {
 	l.RLock()
 	defer l.RUnlock()
@@ -609,17 +688,25 @@ func (l *List) length(readTs, afterUid uint64) int {
 	return count
 }
 
-// Length iterates over the mutation layer and counts number of elements.
+// Length iterates over the mutation layer and counts number of elements that haven't expired.
 func (l *List) Length(readTs, afterUid uint64) int {
 	l.RLock()
 	defer l.RUnlock()
-	return l.length(readTs, afterUid)
+	count := 0
+	err := l.iterateLive(readTs, afterUid, func(p *intern.Posting) bool {
+		count++
+		return true
+	})
+	if err != nil {
+		return -1
+	}
+	return count
 }
 
 func doAsyncWrite(commitTs uint64, key []byte, data []byte, meta byte, f func(error)) {
 	txn := pstore.NewTransactionAt(commitTs, true)
 	defer txn.Discard()
-	if err := txn.SetWithMeta(key, data, meta); err != nil {
+	if err := txn.SetWithMeta(key, x.EncryptValue(data), meta); err != nil {
 		f(err)
 	}
 	if err := txn.CommitAt(commitTs, f); err != nil {
@@ -671,6 +758,11 @@ func (l *List) rollup() error {
 	var bp bp128.BPackEncoder
 	buf := make([]uint64, 0, bp128.BlockSize)
 
+	if l.hasPendingIndexedIncrements() {
+		// Their index entries are computed at commit from the value before them, so they
+		// mustn't be folded into the immutable layer yet.
+		return nil
+	}
 	// Pick all committed entries
 	x.AssertTrue(l.minTs <= l.commitTs)
 	err := l.iterate(l.commitTs, 0, func(p *intern.Posting) bool {
@@ -684,7 +776,8 @@ func (l *List) rollup() error {
 			buf = buf[:0]
 		}
 
-		if p.Facets != nil || p.Value != nil || len(p.LangTag) != 0 || len(p.Label) != 0 {
+		if p.Facets != nil || p.Value != nil || len(p.LangTag) != 0 || len(p.Label) != 0 ||
+			p.ExpiresAt != 0 {
 			// I think it's okay to take the pointer from the iterator, because we have a lock
 			// over List; which won't be released until final has been marshalled. Thus, the
 			// underlying data wouldn't be changed.
@@ -784,7 +877,9 @@ func (l *List) syncIfDirty(delFromCache bool) (committed bool, err error) {
 			x.AssertTrue(atomic.LoadInt32(&l.deleteMe) == 1)
 			lcache.delete(l.key)
 		}
-		pstore.PurgeVersionsBelow(l.key, minTs)
+		if ts := purgeTs(l.key, minTs); ts > 0 {
+			pstore.PurgeVersionsBelow(l.key, ts)
+		}
 	}
 
 	doAsyncWrite(minTs, l.key, data, meta, f)
@@ -811,17 +906,20 @@ func (l *List) Uids(opt ListOptions) (*intern.List, error) {
 	// Use approximate length for initial capacity.
 	res := make([]uint64, 0, len(l.mlayer)+bp128.NumIntegers(l.plist.Uids))
 	out := &intern.List{}
-	if len(l.mlayer) == 0 && opt.Intersect != nil {
-		if opt.ReadTs < l.minTs {
+	if len(l.mlayer) == 0 && opt.Intersect != nil && !hasExpiringPostings(l.plist) {
+		if opt.ReadTs >= l.minTs {
+			algo.IntersectCompressedWith(l.plist.Uids, opt.AfterUID, opt.Intersect, out)
+			l.RUnlock()
+			return out, nil
+		}
+		if !retainHistory() {
 			l.RUnlock()
 			return out, ErrTsTooOld
 		}
-		algo.IntersectCompressedWith(l.plist.Uids, opt.AfterUID, opt.Intersect, out)
-		l.RUnlock()
-		return out, nil
+		// Fall through to iterate, which reads the older versions from disk.
 	}
 
-	err := l.iterate(opt.ReadTs, opt.AfterUID, func(p *intern.Posting) bool {
+	err := l.iterateLive(opt.ReadTs, opt.AfterUID, func(p *intern.Posting) bool {
 		if p.PostingType == intern.Posting_REF {
 			res = append(res, p.Uid)
 		}
@@ -846,7 +944,7 @@ func (l *List) Postings(opt ListOptions, postFn func(*intern.Posting) bool) erro
 	l.RLock()
 	defer l.RUnlock()
 
-	return l.iterate(opt.ReadTs, opt.AfterUID, func(p *intern.Posting) bool {
+	return l.iterateLive(opt.ReadTs, opt.AfterUID, func(p *intern.Posting) bool {
 		if p.PostingType != intern.Posting_REF {
 			return true
 		}
@@ -859,7 +957,7 @@ func (l *List) AllUntaggedValues(readTs uint64) ([]types.Val, error) {
 	defer l.RUnlock()
 
 	var vals []types.Val
-	err := l.iterate(readTs, 0, func(p *intern.Posting) bool {
+	err := l.iterateLive(readTs, 0, func(p *intern.Posting) bool {
 		if len(p.LangTag) == 0 {
 			vals = append(vals, types.Val{
 				Tid:   types.TypeID(p.ValType),
@@ -876,7 +974,7 @@ func (l *List) AllValues(readTs uint64) ([]types.Val, error) {
 	defer l.RUnlock()
 
 	var vals []types.Val
-	err := l.iterate(readTs, 0, func(p *intern.Posting) bool {
+	err := l.iterateLive(readTs, 0, func(p *intern.Posting) bool {
 		vals = append(vals, types.Val{
 			Tid:   types.TypeID(p.ValType),
 			Value: p.Value,
@@ -892,7 +990,7 @@ func (l *List) GetLangTags(readTs uint64) ([]string, error) {
 	defer l.RUnlock()
 
 	var tags []string
-	err := l.iterate(readTs, 0, func(p *intern.Posting) bool {
+	err := l.iterateLive(readTs, 0, func(p *intern.Posting) bool {
 		tags = append(tags, string(p.LangTag))
 		return true
 	})
@@ -979,7 +1077,7 @@ func (l *List) postingForLangs(readTs uint64, langs []string) (pos *intern.Posti
 	var found bool
 	// last resort - return value with smallest lang Uid
 	if any {
-		err := l.iterate(readTs, 0, func(p *intern.Posting) bool {
+		err := l.iterateLive(readTs, 0, func(p *intern.Posting) bool {
 			if p.PostingType == intern.Posting_VALUE_LANG {
 				pos = p
 				found = true
@@ -1025,7 +1123,7 @@ func (l *List) findValue(readTs, uid uint64) (rval types.Val, found bool, err er
 
 func (l *List) findPosting(readTs uint64, uid uint64) (found bool, pos *intern.Posting, err error) {
 	// Iterate starts iterating after the given argument, so we pass uid - 1
-	err = l.iterate(readTs, uid-1, func(p *intern.Posting) bool {
+	err = l.iterateLive(readTs, uid-1, func(p *intern.Posting) bool {
 		if p.Uid == uid {
 			pos = p
 			found = true
@@ -1036,6 +1134,33 @@ func (l *List) findPosting(readTs uint64, uid uint64) (found bool, pos *intern.P
 	return found, pos, err
 }
 
+// findStoredValue is findValue, but also finds the value if it has expired. Mutations use it
+// to clean up the index entries of the value they replace, so increments of the reading txn
+// aren't applied to it, as they only get indexed at commit.
+func (l *List) findStoredValue(readTs, uid uint64) (rval types.Val, found bool, err error) {
+	l.AssertRLock()
+	midx := sort.Search(len(l.mlayer), func(idx int) bool {
+		return uid <= l.mlayer[idx].Uid
+	})
+	if midx < len(l.mlayer) && l.mlayer[midx].Uid == uid && l.hasIncrements(midx) &&
+		readTs >= l.minTs {
+		// Increments of the txn itself only get indexed at commit.
+		p, err := l.foldedPosting(readTs, uid, ownPendingIncrement(readTs))
+		if err != nil || p == nil || p.Op == Del {
+			return rval, false, err
+		}
+		return valueToTypesVal(p), true, nil
+	}
+	err = l.iterate(readTs, uid-1, func(p *intern.Posting) bool {
+		if p.Uid == uid {
+			rval = valueToTypesVal(p)
+			found = true
+		}
+		return false
+	})
+	return rval, found, err
+}
+
 // Facets gives facets for the posting representing value.
 func (l *List) Facets(readTs uint64, param *intern.FacetParams, langs []string) (fs []*api.Facet,
 	ferr error) {
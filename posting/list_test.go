@@ -24,13 +24,16 @@ import (
 	"math/rand"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/badger"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -63,6 +66,8 @@ func addMutationHelper(t *testing.T, l *List, edge *intern.DirectedEdge, op uint
 		edge.Op = intern.DirectedEdge_DEL
 	} else if op == Set {
 		edge.Op = intern.DirectedEdge_SET
+	} else if op == Inc {
+		edge.Op = intern.DirectedEdge_INC
 	} else {
 		x.Fatalf("Unhandled op: %v", op)
 	}
@@ -670,6 +675,151 @@ func TestAfterUIDCountWithCommit(t *testing.T) {
 	require.EqualValues(t, 0, ol.Length(txn.StartTs, 300))
 }
 
+func TestReadInPast(t *testing.T) {
+	defer func(retention time.Duration) {
+		Config.HistoryRetention = retention
+		atomic.StoreUint64(&historyHorizon, 0)
+	}(Config.HistoryRetention)
+	Config.HistoryRetention = time.Hour
+
+	key := x.DataKey("history", 1)
+	ol := Get(key)
+	edge := &intern.DirectedEdge{ValueId: 5}
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, edge, Set, txn)
+	require.NoError(t, txn.CommitMutations(context.Background(), 2))
+
+	edge.ValueId = 7
+	txn = &Txn{StartTs: 3}
+	addMutationHelper(t, ol, edge, Set, txn)
+	require.NoError(t, txn.CommitMutations(context.Background(), 4))
+
+	// Rolls up the list at ts 4.
+	merged, err := ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	require.True(t, merged)
+	require.Equal(t, []uint64{5, 7}, listToArray(t, 0, ol, 4))
+
+	noop := func(p *intern.Posting) bool { return true }
+	// The horizon isn't known yet.
+	require.Error(t, ol.Iterate(2, 0, noop))
+
+	SetHistoryHorizon(2)
+	require.Equal(t, []uint64{5}, listToArray(t, 0, ol, 2))
+	require.Equal(t, []uint64{5}, listToArray(t, 0, ol, 3))
+	uids, err := ol.Uids(ListOptions{ReadTs: 2, Intersect: &intern.List{Uids: []uint64{5, 7}}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, uids.Uids)
+
+	// Before the horizon.
+	require.Error(t, ol.Iterate(1, 0, noop))
+
+	Config.HistoryRetention = 0
+	require.Error(t, ol.Iterate(2, 0, noop))
+}
+
+func TestExpiredPostings(t *testing.T) {
+	key := x.DataKey("expiry", 1)
+	ol := Get(key)
+	past := uint64(time.Now().Unix() - 10)
+	future := uint64(time.Now().Unix() + 3600)
+
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 5, ExpiresAt: past}, Set, txn)
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 7, ExpiresAt: future}, Set, txn)
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 9}, Set, txn)
+	require.NoError(t, txn.CommitMutations(context.Background(), 2))
+
+	check := func() {
+		uids, err := ol.Uids(ListOptions{ReadTs: 3})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{7, 9}, uids.Uids)
+		uids, err = ol.Uids(ListOptions{ReadTs: 3, Intersect: &intern.List{Uids: []uint64{5, 7}}})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{7}, uids.Uids)
+		require.Equal(t, 2, ol.Length(3, 0))
+		// Iterate still sees the expired posting, so that it can be purged.
+		require.Equal(t, []uint64{5, 7, 9}, listToArray(t, 0, ol, 3))
+	}
+	check()
+
+	// The expiry survives a rollup, even for postings without a value.
+	merged, err := ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	require.True(t, merged)
+	check()
+
+	vkey := x.DataKey("expiry", 2)
+	vl := Get(vkey)
+	txn = &Txn{StartTs: 3}
+	addMutationHelper(t, vl, &intern.DirectedEdge{Value: []byte("gone"), ExpiresAt: past}, Set, txn)
+	require.NoError(t, txn.CommitMutations(context.Background(), 4))
+	_, err = vl.Value(5)
+	require.Equal(t, ErrNoValue, err)
+}
+
+func TestIncrements(t *testing.T) {
+	ol := Get(x.DataKey("counter", 1))
+	edge := func(n int64) *intern.DirectedEdge {
+		b, err := binaryValue(types.Val{Tid: types.IntID, Value: n})
+		require.NoError(t, err)
+		return &intern.DirectedEdge{Value: b, ValueType: intern.Posting_INT}
+	}
+	check := func(want int64, readTs uint64) {
+		v, err := ol.Value(readTs)
+		require.NoError(t, err)
+		v, err = types.Convert(v, types.IntID)
+		require.NoError(t, err)
+		require.Equal(t, want, v.Value)
+	}
+	commit := func(txn *Txn, commitTs uint64) {
+		require.NoError(t, txn.CommitMutations(context.Background(), commitTs))
+	}
+
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, edge(10), Set, txn)
+	commit(txn, 2)
+
+	// Concurrent increments don't conflict, and commit out of order.
+	a, b := &Txn{StartTs: 3}, &Txn{StartTs: 4}
+	addMutationHelper(t, ol, edge(5), Inc, a)
+	addMutationHelper(t, ol, edge(2), Inc, b)
+	check(15, 3)
+	check(12, 4)
+	commit(b, 6)
+	check(10, 5)
+	check(12, 6)
+	commit(a, 5)
+	check(15, 5)
+	check(17, 7)
+
+	// Increments of the same txn add up.
+	txn = &Txn{StartTs: 8}
+	addMutationHelper(t, ol, edge(1), Inc, txn)
+	addMutationHelper(t, ol, edge(1), Inc, txn)
+	check(19, 8)
+	commit(txn, 9)
+	check(19, 10)
+
+	// A set replaces the increments committed before it, not the ones after.
+	inc, set := &Txn{StartTs: 10}, &Txn{StartTs: 11}
+	addMutationHelper(t, ol, edge(3), Inc, inc)
+	addMutationHelper(t, ol, edge(100), Set, set)
+	commit(set, 12)
+	commit(inc, 13)
+	check(100, 12)
+	check(103, 14)
+
+	merged, err := ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	require.True(t, merged)
+	check(103, 14)
+	txn = &Txn{StartTs: 14}
+	addMutationHelper(t, ol, edge(-4), Inc, txn)
+	commit(txn, 15)
+	check(99, 16)
+}
+
 var ps *badger.ManagedDB
 
 func TestMain(m *testing.M) {
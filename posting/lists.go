@@ -18,6 +18,7 @@
 package posting
 
 import (
+	"bytes"
 	"crypto/md5"
 	"fmt"
 	"io/ioutil"
@@ -265,6 +266,26 @@ func EvictLRU() {
 	lcache.Reset()
 }
 
+// CachedKeys returns the keys with the prefix of the lists in memory which have postings
+// visible at readTs. Lists committed in memory are written to disk later, so readers going by
+// the keys on disk have to add these.
+func CachedKeys(prefix []byte, readTs uint64) [][]byte {
+	var lists []*List
+	lcache.iterate(func(l *List) bool {
+		if bytes.HasPrefix(l.key, prefix) {
+			lists = append(lists, l)
+		}
+		return true
+	})
+	var keys [][]byte
+	for _, l := range lists {
+		if l.Length(readTs, 0) > 0 {
+			keys = append(keys, l.key)
+		}
+	}
+	return keys
+}
+
 func CommitLists(commit func(key []byte) bool) {
 	// We iterate over lru and pushing values (List) into this
 	// channel. Then goroutines right below will commit these lists to data store.
@@ -20,6 +20,7 @@ package posting
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math"
 	"sort"
 	"strconv"
@@ -30,6 +31,7 @@ import (
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	farm "github.com/dgryski/go-farm"
 )
@@ -58,7 +60,8 @@ func Txns() *transactions {
 type delta struct {
 	key            []byte
 	posting        *intern.Posting
-	ignoreConflict bool // Ignore for conflict detection.
+	ignoreConflict bool   // Ignore for conflict detection.
+	startTs        uint64 // StartTs of the txn which added the posting.
 }
 type Txn struct {
 	StartTs             uint64
@@ -91,6 +94,20 @@ func (t *transactions) MinTs() uint64 {
 	return minTs
 }
 
+// MinPendingCommitTs returns the lowest commit timestamp among the transactions Zero committed
+// which are still pending here, or zero if there is none.
+func (t *transactions) MinPendingCommitTs() uint64 {
+	t.RLock()
+	defer t.RUnlock()
+	var minTs uint64
+	for startTs := range t.m {
+		if ts := Oracle().CommitTs(startTs); ts > 0 && (ts < minTs || minTs == 0) {
+			minTs = ts
+		}
+	}
+	return minTs
+}
+
 // Returns startTs of all pending transactions started upto 10000 raft log
 // entries after last snapshot if the memory consumed by all raft log entries
 // is high.
@@ -213,7 +230,8 @@ func (t *Txn) ShouldAbort() bool {
 func (t *Txn) AddDelta(key []byte, p *intern.Posting, ignore bool) {
 	t.Lock()
 	defer t.Unlock()
-	t.deltas = append(t.deltas, delta{key: key, posting: p, ignoreConflict: ignore})
+	t.deltas = append(t.deltas, delta{key: key, posting: p, ignoreConflict: ignore,
+		startTs: t.StartTs})
 }
 
 func (t *Txn) Fill(ctx *api.TxnContext) {
@@ -229,7 +247,12 @@ func (t *Txn) fill(ctx *api.TxnContext) {
 			continue // Ignore for conflict detection.
 		}
 		fp := farm.Fingerprint64(d.key)
-		ctx.Keys = append(ctx.Keys, strconv.FormatUint(fp, 36))
+		key := strconv.FormatUint(fp, 36)
+		if d.posting.Op == Inc {
+			// Still makes concurrent writes of the key abort, but not other increments.
+			key = x.IncrementKeyPrefix + key
+		}
+		ctx.Keys = append(ctx.Keys, key)
 	}
 }
 
@@ -238,26 +261,31 @@ func (tx *Txn) CommitMutations(ctx context.Context, commitTs uint64) error {
 	tx.Lock()
 	defer tx.Unlock()
 
+	deltas := tx.deltas
+	if idx := tx.indexIncrements(ctx, commitTs); idx != nil {
+		// Written along with the deltas of tx, so that a key touched by both gets one delta.
+		deltas = append(deltas[:len(deltas):len(deltas)], idx.deltas...)
+	}
 	txn := pstore.NewTransactionAt(commitTs, true)
 	defer txn.Discard()
 	// Sort by keys so that we have all postings for same pl side by side.
-	sort.SliceStable(tx.deltas, func(i, j int) bool {
-		return bytes.Compare(tx.deltas[i].key, tx.deltas[j].key) < 0
+	sort.SliceStable(deltas, func(i, j int) bool {
+		return bytes.Compare(deltas[i].key, deltas[j].key) < 0
 	})
 	var prevKey []byte
 	var pl *intern.PostingList
 	var plist *List
 	i := 0
-	for i < len(tx.deltas) {
-		d := tx.deltas[i]
+	for i < len(deltas) {
+		d := deltas[i]
 		if !bytes.Equal(prevKey, d.key) {
 			plist = Get(d.key)
-			if plist.AlreadyCommitted(tx.StartTs) {
+			if plist.AlreadyCommitted(d.startTs) {
 				// Delta already exists, so skip the key
 				// There won't be any race from lru eviction, because we don't
 				// commit in memory unless we write delta to disk.
 				i++
-				for i < len(tx.deltas) && bytes.Equal(tx.deltas[i].key, d.key) {
+				for i < len(deltas) && bytes.Equal(deltas[i].key, d.key) {
 					i++
 				}
 				continue
@@ -294,6 +322,7 @@ func (tx *Txn) CommitMutations(ctx context.Context, commitTs uint64) error {
 		val, err := pl.Marshal()
 		plist.Unlock()
 		x.Check(err)
+		val = x.EncryptValue(val)
 		if err = txn.SetWithMeta([]byte(d.key), val, meta); err == badger.ErrTxnTooBig {
 			if err := txn.CommitAt(commitTs, nil); err != nil {
 				return err
@@ -310,23 +339,23 @@ func (tx *Txn) CommitMutations(ctx context.Context, commitTs uint64) error {
 	if err := txn.CommitAt(commitTs, nil); err != nil {
 		return err
 	}
-	return tx.commitMutationsMemory(ctx, commitTs)
+	return commitDeltasMemory(ctx, deltas, commitTs)
 }
 
 func (tx *Txn) CommitMutationsMemory(ctx context.Context, commitTs uint64) error {
 	tx.Lock()
 	defer tx.Unlock()
-	return tx.commitMutationsMemory(ctx, commitTs)
+	return commitDeltasMemory(ctx, tx.deltas, commitTs)
 }
 
-func (tx *Txn) commitMutationsMemory(ctx context.Context, commitTs uint64) error {
-	for _, d := range tx.deltas {
+func commitDeltasMemory(ctx context.Context, deltas []delta, commitTs uint64) error {
+	for _, d := range deltas {
 		plist := Get(d.key)
-		err := plist.CommitMutation(ctx, tx.StartTs, commitTs)
+		err := plist.CommitMutation(ctx, d.startTs, commitTs)
 		for err == ErrRetry {
 			time.Sleep(5 * time.Millisecond)
 			plist = Get(d.key)
-			err = plist.CommitMutation(ctx, tx.StartTs, commitTs)
+			err = plist.CommitMutation(ctx, d.startTs, commitTs)
 		}
 		if err != nil {
 			return err
@@ -354,9 +383,64 @@ func (tx *Txn) AbortMutations(ctx context.Context) error {
 	return nil
 }
 
+// Changes returns the edges this transaction wrote to data keys, split into the ones it
+// set and the ones it deleted. Index, reverse and count keys are left out. Increments are
+// reported as set edges with Inc and their delta as value.
+func (tx *Txn) Changes() (set, del []*api.NQuad) {
+	tx.Lock()
+	defer tx.Unlock()
+	for _, d := range tx.deltas {
+		pk := x.Parse(d.key)
+		if pk == nil || !pk.IsData() {
+			continue
+		}
+		p := d.posting
+		nq := &api.NQuad{
+			Subject:   fmt.Sprintf("%#x", pk.Uid),
+			Predicate: pk.Attr,
+			Label:     p.Label,
+			Lang:      string(p.LangTag),
+			Facets:    p.Facets,
+			Inc:       p.Op == Inc,
+			ExpiresAt: p.ExpiresAt,
+		}
+		if p.PostingType == intern.Posting_REF {
+			nq.ObjectId = fmt.Sprintf("%#x", p.Uid)
+		} else {
+			nq.ObjectValue = objectValue(p)
+		}
+		if p.Op == Del {
+			del = append(del, nq)
+		} else {
+			set = append(set, nq)
+		}
+	}
+	return set, del
+}
+
+func objectValue(p *intern.Posting) *api.Value {
+	tid := types.TypeID(p.ValType)
+	src := types.Val{Tid: types.BinaryID, Value: p.Value}
+	if dst, err := types.Convert(src, tid); err == nil {
+		if v, err := types.ObjectValue(tid, dst.Value); err == nil {
+			return v
+		}
+	}
+	return &api.Value{&api.Value_BytesVal{p.Value}}
+}
+
+// itemValue returns the value of the item, decrypted if encryption is enabled.
+func itemValue(item *badger.Item) ([]byte, error) {
+	val, err := item.Value()
+	if err != nil {
+		return nil, err
+	}
+	return x.DecryptValue(val)
+}
+
 func unmarshalOrCopy(plist *intern.PostingList, item *badger.Item) error {
 	// It's delta
-	val, err := item.Value()
+	val, err := itemValue(item)
 	if err != nil {
 		return err
 	}
@@ -392,10 +476,6 @@ func ReadPostingList(key []byte, it *badger.Iterator) (*List, error) {
 			l.commitTs = item.Version()
 		}
 
-		val, err := item.Value()
-		if err != nil {
-			return nil, err
-		}
 		if item.UserMeta()&BitCompletePosting > 0 {
 			if err := unmarshalOrCopy(l.plist, item); err != nil {
 				return nil, err
@@ -404,6 +484,10 @@ func ReadPostingList(key []byte, it *badger.Iterator) (*List, error) {
 			it.Next()
 			break
 		} else if item.UserMeta()&bitDeltaPosting > 0 {
+			val, err := itemValue(item)
+			if err != nil {
+				return nil, err
+			}
 			var pl intern.PostingList
 			x.Check(pl.Unmarshal(val))
 			for _, mpost := range pl.Postings {
@@ -465,6 +549,19 @@ func getNew(key []byte, pstore *badger.ManagedDB) (*List, error) {
 	return l, err
 }
 
+// readListAt constructs the posting list as of readTs from the disk.
+func readListAt(key []byte, readTs uint64) (*List, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := txn.NewIterator(iterOpts)
+	defer it.Close()
+	it.Seek(key)
+	return ReadPostingList(key, it)
+}
+
 type bTreeIterator struct {
 	keys    [][]byte
 	idx     int
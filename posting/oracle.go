@@ -40,6 +40,8 @@ type oracle struct {
 	commits    map[uint64]uint64
 	aborts     map[uint64]struct{}
 	maxpending uint64
+	// The latest commit timestamp at or below maxpending.
+	maxassigned uint64
 
 	// Used for waiting logic.
 	waiters map[uint64][]chan struct{}
@@ -81,6 +83,14 @@ func (o *oracle) MaxPending() uint64 {
 	return o.maxpending
 }
 
+// MaxAssigned returns the latest commit timestamp this server knows every commit up to. Unlike
+// MaxPending, it can't be the start of a txn, whose uncommitted writes would be read at it.
+func (o *oracle) MaxAssigned() uint64 {
+	o.RLock()
+	defer o.RUnlock()
+	return o.maxassigned
+}
+
 func (o *oracle) CurrentState() *intern.OracleDelta {
 	od := new(intern.OracleDelta)
 	od.Commits = make(map[uint64]uint64)
@@ -117,17 +127,21 @@ func (o *oracle) ProcessOracleDelta(od *intern.OracleDelta) {
 	for _, startTs := range od.Aborts {
 		o.aborts[startTs] = struct{}{}
 	}
-	if od.MaxPending <= o.maxpending {
-		return
-	}
-	for startTs, toNotify := range o.waiters {
-		if startTs > od.MaxPending {
-			continue
+	if od.MaxPending > o.maxpending {
+		for startTs, toNotify := range o.waiters {
+			if startTs > od.MaxPending {
+				continue
+			}
+			for _, ch := range toNotify {
+				close(ch)
+			}
+			delete(o.waiters, startTs)
 		}
-		for _, ch := range toNotify {
-			close(ch)
+		o.maxpending = od.MaxPending
+	}
+	for _, commitTs := range od.Commits {
+		if commitTs <= o.maxpending && commitTs > o.maxassigned {
+			o.maxassigned = commitTs
 		}
-		delete(o.waiters, startTs)
 	}
-	o.maxpending = od.MaxPending
 }
@@ -12,11 +12,16 @@
 		Response
 		Assigned
 		Mutation
+		DeleteByQueryRequest
+		VarDelete
+		DeleteProgress
 		AssignedIds
 		Operation
 		Payload
 		TxnContext
 		Check
+		SubscribeRequest
+		ChangeEvent
 		Version
 		LinRead
 		Latency
@@ -24,6 +29,8 @@
 		Value
 		Facet
 		SchemaNode
+		LoginRequest
+		LoginResponse
 */
 package api
 
@@ -77,13 +84,22 @@ var Facet_ValType_value = map[string]int32{
 func (x Facet_ValType) String() string {
 	return proto.EnumName(Facet_ValType_name, int32(x))
 }
-func (Facet_ValType) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{14, 0} }
+func (Facet_ValType) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{19, 0} }
 
 type Request struct {
 	Query   string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	Vars    map[string]string `protobuf:"bytes,2,rep,name=vars" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	StartTs uint64            `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 	LinRead *LinRead          `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	// Read the data as it was at an older timestamp, or at the timestamp Zero handed
+	// out at the given Unix time (in seconds). Needs history retention on the server.
+	AsOfTs   uint64 `protobuf:"varint,15,opt,name=as_of_ts,json=asOfTs,proto3" json:"as_of_ts,omitempty"`
+	AsOfTime int64  `protobuf:"varint,16,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`
+	// Read-only queries share a recently leased timestamp, instead of leasing one from
+	// Zero each. Best-effort queries read at the latest timestamp this server knows of,
+	// without waiting to catch up. Mutations aren't allowed in either.
+	ReadOnly   bool `protobuf:"varint,17,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	BestEffort bool `protobuf:"varint,18,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -119,6 +135,34 @@ func (m *Request) GetLinRead() *LinRead {
 	return nil
 }
 
+func (m *Request) GetAsOfTs() uint64 {
+	if m != nil {
+		return m.AsOfTs
+	}
+	return 0
+}
+
+func (m *Request) GetAsOfTime() int64 {
+	if m != nil {
+		return m.AsOfTime
+	}
+	return 0
+}
+
+func (m *Request) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *Request) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
 type Response struct {
 	Json    []byte        `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
 	Schema  []*SchemaNode `protobuf:"bytes,2,rep,name=schema" json:"schema,omitempty"`
@@ -162,6 +206,8 @@ func (m *Response) GetLatency() *Latency {
 type Assigned struct {
 	Uids    map[string]string `protobuf:"bytes,1,rep,name=uids" json:"uids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Context *TxnContext       `protobuf:"bytes,2,opt,name=context" json:"context,omitempty"`
+	// Things the client should know about how the mutation ran, like costly operations.
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings" json:"warnings,omitempty"`
 }
 
 func (m *Assigned) Reset()                    { *m = Assigned{} }
@@ -183,6 +229,13 @@ func (m *Assigned) GetContext() *TxnContext {
 	return nil
 }
 
+func (m *Assigned) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type Mutation struct {
 	SetJson             []byte   `protobuf:"bytes,1,opt,name=set_json,json=setJson,proto3" json:"set_json,omitempty"`
 	DeleteJson          []byte   `protobuf:"bytes,2,opt,name=delete_json,json=deleteJson,proto3" json:"delete_json,omitempty"`
@@ -193,6 +246,9 @@ type Mutation struct {
 	StartTs             uint64   `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 	CommitNow           bool     `protobuf:"varint,14,opt,name=commit_now,json=commitNow,proto3" json:"commit_now,omitempty"`
 	IgnoreIndexConflict bool     `protobuf:"varint,15,opt,name=ignore_index_conflict,json=ignoreIndexConflict,proto3" json:"ignore_index_conflict,omitempty"`
+	Ttl                 uint64   `protobuf:"varint,16,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	DeleteIncoming      bool     `protobuf:"varint,17,opt,name=delete_incoming,json=deleteIncoming,proto3" json:"delete_incoming,omitempty"`
+	ReadOnly            bool     `protobuf:"varint,18,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
 }
 
 func (m *Mutation) Reset()                    { *m = Mutation{} }
@@ -263,6 +319,152 @@ func (m *Mutation) GetIgnoreIndexConflict() bool {
 	return false
 }
 
+func (m *Mutation) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *Mutation) GetDeleteIncoming() bool {
+	if m != nil {
+		return m.DeleteIncoming
+	}
+	return false
+}
+
+func (m *Mutation) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+type DeleteByQueryRequest struct {
+	Query   string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Vars    map[string]string `protobuf:"bytes,2,rep,name=vars" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Deletes []*VarDelete      `protobuf:"bytes,3,rep,name=deletes" json:"deletes,omitempty"`
+	// Only count the nodes that would be deleted.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Deletions per transaction. Zero uses the server default.
+	BatchSize uint32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Also delete the edges pointing at nodes whose predicates are all deleted.
+	DeleteIncoming bool `protobuf:"varint,6,opt,name=delete_incoming,json=deleteIncoming,proto3" json:"delete_incoming,omitempty"`
+}
+
+func (m *DeleteByQueryRequest) Reset()                    { *m = DeleteByQueryRequest{} }
+func (m *DeleteByQueryRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteByQueryRequest) ProtoMessage()               {}
+func (*DeleteByQueryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{4} }
+
+func (m *DeleteByQueryRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *DeleteByQueryRequest) GetVars() map[string]string {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+func (m *DeleteByQueryRequest) GetDeletes() []*VarDelete {
+	if m != nil {
+		return m.Deletes
+	}
+	return nil
+}
+
+func (m *DeleteByQueryRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *DeleteByQueryRequest) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *DeleteByQueryRequest) GetDeleteIncoming() bool {
+	if m != nil {
+		return m.DeleteIncoming
+	}
+	return false
+}
+
+// VarDelete deletes predicates of the nodes bound to a query variable.
+type VarDelete struct {
+	Var string `protobuf:"bytes,1,opt,name=var,proto3" json:"var,omitempty"`
+	// Predicates to delete, like S P *. Empty deletes all of them, like S * *.
+	Predicates []string `protobuf:"bytes,2,rep,name=predicates" json:"predicates,omitempty"`
+}
+
+func (m *VarDelete) Reset()                    { *m = VarDelete{} }
+func (m *VarDelete) String() string            { return proto.CompactTextString(m) }
+func (*VarDelete) ProtoMessage()               {}
+func (*VarDelete) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{5} }
+
+func (m *VarDelete) GetVar() string {
+	if m != nil {
+		return m.Var
+	}
+	return ""
+}
+
+func (m *VarDelete) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+type DeleteProgress struct {
+	Matched uint64 `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Deleted uint64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Batches uint64 `protobuf:"varint,3,opt,name=batches,proto3" json:"batches,omitempty"`
+	Done    bool   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *DeleteProgress) Reset()                    { *m = DeleteProgress{} }
+func (m *DeleteProgress) String() string            { return proto.CompactTextString(m) }
+func (*DeleteProgress) ProtoMessage()               {}
+func (*DeleteProgress) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{6} }
+
+func (m *DeleteProgress) GetMatched() uint64 {
+	if m != nil {
+		return m.Matched
+	}
+	return 0
+}
+
+func (m *DeleteProgress) GetDeleted() uint64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *DeleteProgress) GetBatches() uint64 {
+	if m != nil {
+		return m.Batches
+	}
+	return 0
+}
+
+func (m *DeleteProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
 type AssignedIds struct {
 	StartId uint64 `protobuf:"varint,1,opt,name=startId,proto3" json:"startId,omitempty"`
 	EndId   uint64 `protobuf:"varint,2,opt,name=endId,proto3" json:"endId,omitempty"`
@@ -271,7 +473,7 @@ type AssignedIds struct {
 func (m *AssignedIds) Reset()                    { *m = AssignedIds{} }
 func (m *AssignedIds) String() string            { return proto.CompactTextString(m) }
 func (*AssignedIds) ProtoMessage()               {}
-func (*AssignedIds) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{4} }
+func (*AssignedIds) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{7} }
 
 func (m *AssignedIds) GetStartId() uint64 {
 	if m != nil {
@@ -292,12 +494,18 @@ type Operation struct {
 	DropAttr string `protobuf:"bytes,2,opt,name=drop_attr,json=dropAttr,proto3" json:"drop_attr,omitempty"`
 	DropAll  bool   `protobuf:"varint,3,opt,name=drop_all,json=dropAll,proto3" json:"drop_all,omitempty"`
 	StartTs  uint64 `protobuf:"varint,4,opt,name=startTs,proto3" json:"startTs,omitempty"`
+	// If the schema changes the type of a predicate, drop the values that can't be
+	// converted to the new type instead of rejecting the change.
+	DropInvalid bool `protobuf:"varint,5,opt,name=drop_invalid,json=dropInvalid,proto3" json:"drop_invalid,omitempty"`
+	// Rename the predicate rename_attr to rename_to, along with its indexes and schema.
+	RenameAttr string `protobuf:"bytes,6,opt,name=rename_attr,json=renameAttr,proto3" json:"rename_attr,omitempty"`
+	RenameTo   string `protobuf:"bytes,7,opt,name=rename_to,json=renameTo,proto3" json:"rename_to,omitempty"`
 }
 
 func (m *Operation) Reset()                    { *m = Operation{} }
 func (m *Operation) String() string            { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()               {}
-func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{5} }
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
 
 func (m *Operation) GetSchema() string {
 	if m != nil {
@@ -327,15 +535,37 @@ func (m *Operation) GetStartTs() uint64 {
 	return 0
 }
 
+func (m *Operation) GetDropInvalid() bool {
+	if m != nil {
+		return m.DropInvalid
+	}
+	return false
+}
+
+func (m *Operation) GetRenameAttr() string {
+	if m != nil {
+		return m.RenameAttr
+	}
+	return ""
+}
+
+func (m *Operation) GetRenameTo() string {
+	if m != nil {
+		return m.RenameTo
+	}
+	return ""
+}
+
 // Worker services.
 type Payload struct {
-	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Data     []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
 }
 
 func (m *Payload) Reset()                    { *m = Payload{} }
 func (m *Payload) String() string            { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()               {}
-func (*Payload) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{6} }
+func (*Payload) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
 
 func (m *Payload) GetData() []byte {
 	if m != nil {
@@ -344,18 +574,28 @@ func (m *Payload) GetData() []byte {
 	return nil
 }
 
+func (m *Payload) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type TxnContext struct {
-	StartTs  uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	CommitTs uint64   `protobuf:"varint,2,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
-	Aborted  bool     `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
-	Keys     []string `protobuf:"bytes,4,rep,name=keys" json:"keys,omitempty"`
-	LinRead  *LinRead `protobuf:"bytes,13,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	StartTs    uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs   uint64   `protobuf:"varint,2,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Aborted    bool     `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Keys       []string `protobuf:"bytes,4,rep,name=keys" json:"keys,omitempty"`
+	ReadOnly   bool     `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	BestEffort bool     `protobuf:"varint,6,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	Warnings   []string `protobuf:"bytes,7,rep,name=warnings" json:"warnings,omitempty"`
+	LinRead    *LinRead `protobuf:"bytes,13,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
 }
 
 func (m *TxnContext) Reset()                    { *m = TxnContext{} }
 func (m *TxnContext) String() string            { return proto.CompactTextString(m) }
 func (*TxnContext) ProtoMessage()               {}
-func (*TxnContext) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{7} }
+func (*TxnContext) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
 
 func (m *TxnContext) GetStartTs() uint64 {
 	if m != nil {
@@ -385,6 +625,27 @@ func (m *TxnContext) GetKeys() []string {
 	return nil
 }
 
+func (m *TxnContext) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *TxnContext) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+func (m *TxnContext) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 func (m *TxnContext) GetLinRead() *LinRead {
 	if m != nil {
 		return m.LinRead
@@ -398,7 +659,97 @@ type Check struct {
 func (m *Check) Reset()                    { *m = Check{} }
 func (m *Check) String() string            { return proto.CompactTextString(m) }
 func (*Check) ProtoMessage()               {}
-func (*Check) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
+func (*Check) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+
+type SubscribeRequest struct {
+	// Resume after the event with this commit_ts. Zero starts at the next commit. Events
+	// dropped from the buffer are read back from the change log (--cdc) if there is one.
+	AfterTs uint64 `protobuf:"varint,1,opt,name=after_ts,json=afterTs,proto3" json:"after_ts,omitempty"`
+	// Only stream edges of these predicates. Empty streams all of them.
+	Predicates []string `protobuf:"bytes,2,rep,name=predicates" json:"predicates,omitempty"`
+	// Start with the events committed after this timestamp, like the read_ts of a backup,
+	// when after_ts isn't set. Fails if some of them are neither buffered nor in the change log.
+	SinceTs uint64 `protobuf:"varint,3,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()               {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+
+func (m *SubscribeRequest) GetAfterTs() uint64 {
+	if m != nil {
+		return m.AfterTs
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetSinceTs() uint64 {
+	if m != nil {
+		return m.SinceTs
+	}
+	return 0
+}
+
+// ChangeEvent holds the edges written by one committed transaction. Subscriptions send
+// them in commit_ts order, with the edges of every group the transaction wrote to, and
+// group_id set only if there was one. Events without edges mark progress: every
+// transaction committed up to their commit_ts was sent. The commit_ts doubles as the
+// resume cursor.
+type ChangeEvent struct {
+	CommitTs uint64   `protobuf:"varint,1,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	StartTs  uint64   `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	GroupId  uint32   `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Set      []*NQuad `protobuf:"bytes,4,rep,name=set" json:"set,omitempty"`
+	Del      []*NQuad `protobuf:"bytes,5,rep,name=del" json:"del,omitempty"`
+}
+
+func (m *ChangeEvent) Reset()                    { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string            { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()               {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+
+func (m *ChangeEvent) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetSet() []*NQuad {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetDel() []*NQuad {
+	if m != nil {
+		return m.Del
+	}
+	return nil
+}
 
 type Version struct {
 	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
@@ -407,7 +758,7 @@ type Version struct {
 func (m *Version) Reset()                    { *m = Version{} }
 func (m *Version) String() string            { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()               {}
-func (*Version) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
+func (*Version) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
 
 func (m *Version) GetTag() string {
 	if m != nil {
@@ -423,7 +774,7 @@ type LinRead struct {
 func (m *LinRead) Reset()                    { *m = LinRead{} }
 func (m *LinRead) String() string            { return proto.CompactTextString(m) }
 func (*LinRead) ProtoMessage()               {}
-func (*LinRead) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+func (*LinRead) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
 
 func (m *LinRead) GetIds() map[uint32]uint64 {
 	if m != nil {
@@ -441,7 +792,7 @@ type Latency struct {
 func (m *Latency) Reset()                    { *m = Latency{} }
 func (m *Latency) String() string            { return proto.CompactTextString(m) }
 func (*Latency) ProtoMessage()               {}
-func (*Latency) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+func (*Latency) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
 
 func (m *Latency) GetParsingNs() uint64 {
 	if m != nil {
@@ -472,12 +823,16 @@ type NQuad struct {
 	Label       string   `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 	Lang        string   `protobuf:"bytes,6,opt,name=lang,proto3" json:"lang,omitempty"`
 	Facets      []*Facet `protobuf:"bytes,7,rep,name=facets" json:"facets,omitempty"`
+	Ttl         uint64   `protobuf:"varint,8,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Inc         bool     `protobuf:"varint,9,opt,name=inc,proto3" json:"inc,omitempty"`
+	// Unix time (in seconds) at which the edge expires. Takes precedence over ttl.
+	ExpiresAt uint64 `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *NQuad) Reset()                    { *m = NQuad{} }
 func (m *NQuad) String() string            { return proto.CompactTextString(m) }
 func (*NQuad) ProtoMessage()               {}
-func (*NQuad) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+func (*NQuad) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
 
 func (m *NQuad) GetSubject() string {
 	if m != nil {
@@ -528,6 +883,27 @@ func (m *NQuad) GetFacets() []*Facet {
 	return nil
 }
 
+func (m *NQuad) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *NQuad) GetInc() bool {
+	if m != nil {
+		return m.Inc
+	}
+	return false
+}
+
+func (m *NQuad) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type Value struct {
 	// Types that are valid to be assigned to Val:
 	//	*Value_DefaultVal
@@ -547,7 +923,7 @@ type Value struct {
 func (m *Value) Reset()                    { *m = Value{} }
 func (m *Value) String() string            { return proto.CompactTextString(m) }
 func (*Value) ProtoMessage()               {}
-func (*Value) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+func (*Value) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
 
 type isValue_Val interface {
 	isValue_Val()
@@ -897,7 +1273,7 @@ type Facet struct {
 func (m *Facet) Reset()                    { *m = Facet{} }
 func (m *Facet) String() string            { return proto.CompactTextString(m) }
 func (*Facet) ProtoMessage()               {}
-func (*Facet) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+func (*Facet) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
 
 func (m *Facet) GetKey() string {
 	if m != nil {
@@ -935,19 +1311,23 @@ func (m *Facet) GetAlias() string {
 }
 
 type SchemaNode struct {
-	Predicate string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
-	Type      string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Index     bool     `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Tokenizer []string `protobuf:"bytes,4,rep,name=tokenizer" json:"tokenizer,omitempty"`
-	Reverse   bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Count     bool     `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
-	List      bool     `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
+	Predicate  string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	Type       string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Index      bool     `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Tokenizer  []string `protobuf:"bytes,4,rep,name=tokenizer" json:"tokenizer,omitempty"`
+	Reverse    bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Count      bool     `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	List       bool     `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
+	Constraint string   `protobuf:"bytes,8,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	Pattern    string   `protobuf:"bytes,9,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Enum       []string `protobuf:"bytes,10,rep,name=enum" json:"enum,omitempty"`
+	Ttl        string   `protobuf:"bytes,11,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
 func (m *SchemaNode) Reset()                    { *m = SchemaNode{} }
 func (m *SchemaNode) String() string            { return proto.CompactTextString(m) }
 func (*SchemaNode) ProtoMessage()               {}
-func (*SchemaNode) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+func (*SchemaNode) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
 
 func (m *SchemaNode) GetPredicate() string {
 	if m != nil {
@@ -998,42 +1378,135 @@ func (m *SchemaNode) GetList() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Request)(nil), "api.Request")
-	proto.RegisterType((*Response)(nil), "api.Response")
-	proto.RegisterType((*Assigned)(nil), "api.Assigned")
-	proto.RegisterType((*Mutation)(nil), "api.Mutation")
-	proto.RegisterType((*AssignedIds)(nil), "api.AssignedIds")
-	proto.RegisterType((*Operation)(nil), "api.Operation")
-	proto.RegisterType((*Payload)(nil), "api.Payload")
-	proto.RegisterType((*TxnContext)(nil), "api.TxnContext")
-	proto.RegisterType((*Check)(nil), "api.Check")
-	proto.RegisterType((*Version)(nil), "api.Version")
-	proto.RegisterType((*LinRead)(nil), "api.LinRead")
-	proto.RegisterType((*Latency)(nil), "api.Latency")
-	proto.RegisterType((*NQuad)(nil), "api.NQuad")
-	proto.RegisterType((*Value)(nil), "api.Value")
-	proto.RegisterType((*Facet)(nil), "api.Facet")
-	proto.RegisterType((*SchemaNode)(nil), "api.SchemaNode")
-	proto.RegisterEnum("api.Facet_ValType", Facet_ValType_name, Facet_ValType_value)
+func (m *SchemaNode) GetConstraint() string {
+	if m != nil {
+		return m.Constraint
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for Dgraph service
+func (m *SchemaNode) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
 
-type DgraphClient interface {
-	Query(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
-	Mutate(ctx context.Context, in *Mutation, opts ...grpc.CallOption) (*Assigned, error)
-	Alter(ctx context.Context, in *Operation, opts ...grpc.CallOption) (*Payload, error)
-	CommitOrAbort(ctx context.Context, in *TxnContext, opts ...grpc.CallOption) (*TxnContext, error)
-	CheckVersion(ctx context.Context, in *Check, opts ...grpc.CallOption) (*Version, error)
+func (m *SchemaNode) GetEnum() []string {
+	if m != nil {
+		return m.Enum
+	}
+	return nil
+}
+
+func (m *SchemaNode) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	Userid   string `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (m *LoginRequest) Reset()                    { *m = LoginRequest{} }
+func (m *LoginRequest) String() string            { return proto.CompactTextString(m) }
+func (*LoginRequest) ProtoMessage()               {}
+func (*LoginRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+
+func (m *LoginRequest) GetUserid() string {
+	if m != nil {
+		return m.Userid
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	// Sent along with requests as the accesstoken gRPC metadata, or the
+	// X-Dgraph-AccessToken HTTP header.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Unix time (in seconds) after which the token is rejected.
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *LoginResponse) Reset()                    { *m = LoginResponse{} }
+func (m *LoginResponse) String() string            { return proto.CompactTextString(m) }
+func (*LoginResponse) ProtoMessage()               {}
+func (*LoginResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+
+func (m *LoginResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Request)(nil), "api.Request")
+	proto.RegisterType((*Response)(nil), "api.Response")
+	proto.RegisterType((*Assigned)(nil), "api.Assigned")
+	proto.RegisterType((*Mutation)(nil), "api.Mutation")
+	proto.RegisterType((*DeleteByQueryRequest)(nil), "api.DeleteByQueryRequest")
+	proto.RegisterType((*VarDelete)(nil), "api.VarDelete")
+	proto.RegisterType((*DeleteProgress)(nil), "api.DeleteProgress")
+	proto.RegisterType((*AssignedIds)(nil), "api.AssignedIds")
+	proto.RegisterType((*Operation)(nil), "api.Operation")
+	proto.RegisterType((*Payload)(nil), "api.Payload")
+	proto.RegisterType((*TxnContext)(nil), "api.TxnContext")
+	proto.RegisterType((*Check)(nil), "api.Check")
+	proto.RegisterType((*SubscribeRequest)(nil), "api.SubscribeRequest")
+	proto.RegisterType((*ChangeEvent)(nil), "api.ChangeEvent")
+	proto.RegisterType((*Version)(nil), "api.Version")
+	proto.RegisterType((*LinRead)(nil), "api.LinRead")
+	proto.RegisterType((*Latency)(nil), "api.Latency")
+	proto.RegisterType((*NQuad)(nil), "api.NQuad")
+	proto.RegisterType((*Value)(nil), "api.Value")
+	proto.RegisterType((*Facet)(nil), "api.Facet")
+	proto.RegisterType((*SchemaNode)(nil), "api.SchemaNode")
+	proto.RegisterType((*LoginRequest)(nil), "api.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "api.LoginResponse")
+	proto.RegisterEnum("api.Facet_ValType", Facet_ValType_name, Facet_ValType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Dgraph service
+
+type DgraphClient interface {
+	Query(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	Mutate(ctx context.Context, in *Mutation, opts ...grpc.CallOption) (*Assigned, error)
+	Alter(ctx context.Context, in *Operation, opts ...grpc.CallOption) (*Payload, error)
+	CommitOrAbort(ctx context.Context, in *TxnContext, opts ...grpc.CallOption) (*TxnContext, error)
+	CheckVersion(ctx context.Context, in *Check, opts ...grpc.CallOption) (*Version, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Dgraph_SubscribeClient, error)
+	// Watch runs the query, and sends a new response every time its result changes.
+	Watch(ctx context.Context, in *Request, opts ...grpc.CallOption) (Dgraph_WatchClient, error)
+	// DeleteByQuery deletes the nodes bound to variables of a query, sending its progress.
+	DeleteByQuery(ctx context.Context, in *DeleteByQueryRequest, opts ...grpc.CallOption) (Dgraph_DeleteByQueryClient, error)
+	// Login checks the password of a user, and returns an access token for its groups.
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type dgraphClient struct {
@@ -1089,6 +1562,111 @@ func (c *dgraphClient) CheckVersion(ctx context.Context, in *Check, opts ...grpc
 	return out, nil
 }
 
+func (c *dgraphClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Dgraph_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Dgraph_serviceDesc.Streams[0], c.cc, "/api.Dgraph/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dgraphSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dgraph_SubscribeClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type dgraphSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *dgraphSubscribeClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dgraphClient) Watch(ctx context.Context, in *Request, opts ...grpc.CallOption) (Dgraph_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Dgraph_serviceDesc.Streams[1], c.cc, "/api.Dgraph/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dgraphWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dgraph_WatchClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type dgraphWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *dgraphWatchClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dgraphClient) DeleteByQuery(ctx context.Context, in *DeleteByQueryRequest, opts ...grpc.CallOption) (Dgraph_DeleteByQueryClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Dgraph_serviceDesc.Streams[2], c.cc, "/api.Dgraph/DeleteByQuery", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dgraphDeleteByQueryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dgraph_DeleteByQueryClient interface {
+	Recv() (*DeleteProgress, error)
+	grpc.ClientStream
+}
+
+type dgraphDeleteByQueryClient struct {
+	grpc.ClientStream
+}
+
+func (x *dgraphDeleteByQueryClient) Recv() (*DeleteProgress, error) {
+	m := new(DeleteProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dgraphClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := grpc.Invoke(ctx, "/api.Dgraph/Login", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Dgraph service
 
 type DgraphServer interface {
@@ -1097,6 +1675,13 @@ type DgraphServer interface {
 	Alter(context.Context, *Operation) (*Payload, error)
 	CommitOrAbort(context.Context, *TxnContext) (*TxnContext, error)
 	CheckVersion(context.Context, *Check) (*Version, error)
+	Subscribe(*SubscribeRequest, Dgraph_SubscribeServer) error
+	// Watch runs the query, and sends a new response every time its result changes.
+	Watch(*Request, Dgraph_WatchServer) error
+	// DeleteByQuery deletes the nodes bound to variables of a query, sending its progress.
+	DeleteByQuery(*DeleteByQueryRequest, Dgraph_DeleteByQueryServer) error
+	// Login checks the password of a user, and returns an access token for its groups.
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 }
 
 func RegisterDgraphServer(s *grpc.Server, srv DgraphServer) {
@@ -1193,6 +1778,87 @@ func _Dgraph_CheckVersion_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dgraph_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DgraphServer).Subscribe(m, &dgraphSubscribeServer{stream})
+}
+
+type Dgraph_SubscribeServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type dgraphSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *dgraphSubscribeServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dgraph_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DgraphServer).Watch(m, &dgraphWatchServer{stream})
+}
+
+type Dgraph_WatchServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type dgraphWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *dgraphWatchServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dgraph_DeleteByQuery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeleteByQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DgraphServer).DeleteByQuery(m, &dgraphDeleteByQueryServer{stream})
+}
+
+type Dgraph_DeleteByQueryServer interface {
+	Send(*DeleteProgress) error
+	grpc.ServerStream
+}
+
+type dgraphDeleteByQueryServer struct {
+	grpc.ServerStream
+}
+
+func (x *dgraphDeleteByQueryServer) Send(m *DeleteProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dgraph_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DgraphServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dgraph/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DgraphServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dgraph_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Dgraph",
 	HandlerType: (*DgraphServer)(nil),
@@ -1217,8 +1883,28 @@ var _Dgraph_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckVersion",
 			Handler:    _Dgraph_CheckVersion_Handler,
 		},
+		{
+			MethodName: "Login",
+			Handler:    _Dgraph_Login_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Dgraph_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _Dgraph_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DeleteByQuery",
+			Handler:       _Dgraph_DeleteByQuery_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
 
@@ -1275,6 +1961,42 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.AsOfTs != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.AsOfTs))
+	}
+	if m.AsOfTime != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.AsOfTime))
+	}
+	if m.ReadOnly {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.BestEffort {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1376,6 +2098,21 @@ func (m *Assigned) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1467,38 +2204,41 @@ func (m *Mutation) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Ttl != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Ttl))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.StartId != 0 {
-		dAtA[i] = 0x8
+	if m.DeleteIncoming {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.DeleteIncoming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.StartId))
 	}
-	if m.EndId != 0 {
-		dAtA[i] = 0x10
+	if m.ReadOnly {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.EndId))
 	}
 	return i, nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
+func (m *DeleteByQueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1508,19 +2248,207 @@ func (m *Operation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteByQueryRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Schema) > 0 {
+	if len(m.Query) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Schema)))
-		i += copy(dAtA[i:], m.Schema)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
 	}
-	if len(m.DropAttr) > 0 {
-		dAtA[i] = 0x12
+	if len(m.Vars) > 0 {
+		for k, _ := range m.Vars {
+			dAtA[i] = 0x12
+			i++
+			v := m.Vars[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Deletes) > 0 {
+		for _, msg := range m.Deletes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DryRun {
+		dAtA[i] = 0x20
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.BatchSize != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BatchSize))
+	}
+	if m.DeleteIncoming {
+		dAtA[i] = 0x30
+		i++
+		if m.DeleteIncoming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *VarDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VarDelete) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Var) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Var)))
+		i += copy(dAtA[i:], m.Var)
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Matched != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Matched))
+	}
+	if m.Deleted != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Deleted))
+	}
+	if m.Batches != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Batches))
+	}
+	if m.Done {
+		dAtA[i] = 0x20
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.StartId))
+	}
+	if m.EndId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.EndId))
+	}
+	return i, nil
+}
+
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Schema)))
+		i += copy(dAtA[i:], m.Schema)
+	}
+	if len(m.DropAttr) > 0 {
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(len(m.DropAttr)))
 		i += copy(dAtA[i:], m.DropAttr)
@@ -1540,6 +2468,28 @@ func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.StartTs))
 	}
+	if m.DropInvalid {
+		dAtA[i] = 0x28
+		i++
+		if m.DropInvalid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RenameAttr) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RenameAttr)))
+		i += copy(dAtA[i:], m.RenameAttr)
+	}
+	if len(m.RenameTo) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RenameTo)))
+		i += copy(dAtA[i:], m.RenameTo)
+	}
 	return i, nil
 }
 
@@ -1564,6 +2514,21 @@ func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
 		i += copy(dAtA[i:], m.Data)
 	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1617,6 +2582,41 @@ func (m *TxnContext) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.ReadOnly {
+		dAtA[i] = 0x28
+		i++
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.BestEffort {
+		dAtA[i] = 0x30
+		i++
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	if m.LinRead != nil {
 		dAtA[i] = 0x6a
 		i++
@@ -1648,7 +2648,7 @@ func (m *Check) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Version) Marshal() (dAtA []byte, err error) {
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1658,21 +2658,40 @@ func (m *Version) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tag) > 0 {
-		dAtA[i] = 0xa
+	if m.AfterTs != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Tag)))
-		i += copy(dAtA[i:], m.Tag)
+		i = encodeVarintApi(dAtA, i, uint64(m.AfterTs))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.SinceTs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SinceTs))
 	}
 	return i, nil
 }
 
-func (m *LinRead) Marshal() (dAtA []byte, err error) {
+func (m *ChangeEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1682,22 +2701,103 @@ func (m *LinRead) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinRead) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeEvent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		for k, _ := range m.Ids {
-			dAtA[i] = 0xa
-			i++
-			v := m.Ids[k]
-			mapSize := 1 + sovApi(uint64(k)) + 1 + sovApi(uint64(v))
-			i = encodeVarintApi(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintApi(dAtA, i, uint64(k))
-			dAtA[i] = 0x10
+	if m.CommitTs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.CommitTs))
+	}
+	if m.StartTs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.StartTs))
+	}
+	if m.GroupId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.GroupId))
+	}
+	if len(m.Set) > 0 {
+		for _, msg := range m.Set {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Del) > 0 {
+		for _, msg := range m.Del {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Tag)))
+		i += copy(dAtA[i:], m.Tag)
+	}
+	return i, nil
+}
+
+func (m *LinRead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinRead) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for k, _ := range m.Ids {
+			dAtA[i] = 0xa
+			i++
+			v := m.Ids[k]
+			mapSize := 1 + sovApi(uint64(k)) + 1 + sovApi(uint64(v))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x10
 			i++
 			i = encodeVarintApi(dAtA, i, uint64(v))
 		}
@@ -1805,6 +2905,26 @@ func (m *NQuad) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Ttl))
+	}
+	if m.Inc {
+		dAtA[i] = 0x48
+		i++
+		if m.Inc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ExpiresAt))
+	}
 	return i, nil
 }
 
@@ -2068,6 +3188,98 @@ func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Constraint) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Constraint)))
+		i += copy(dAtA[i:], m.Constraint)
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if len(m.Enum) > 0 {
+		for _, s := range m.Enum {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Ttl) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Ttl)))
+		i += copy(dAtA[i:], m.Ttl)
+	}
+	return i, nil
+}
+
+func (m *LoginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Userid) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Userid)))
+		i += copy(dAtA[i:], m.Userid)
+	}
+	if len(m.Password) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Password)))
+		i += copy(dAtA[i:], m.Password)
+	}
+	return i, nil
+}
+
+func (m *LoginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessToken) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.AccessToken)))
+		i += copy(dAtA[i:], m.AccessToken)
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ExpiresAt))
+	}
 	return i, nil
 }
 
@@ -2120,6 +3332,18 @@ func (m *Request) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.AsOfTs != 0 {
+		n += 1 + sovApi(uint64(m.AsOfTs))
+	}
+	if m.AsOfTime != 0 {
+		n += 2 + sovApi(uint64(m.AsOfTime))
+	}
+	if m.ReadOnly {
+		n += 3
+	}
+	if m.BestEffort {
+		n += 3
+	}
 	return n
 }
 
@@ -2162,6 +3386,12 @@ func (m *Assigned) Size() (n int) {
 		l = m.Context.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2205,6 +3435,82 @@ func (m *Mutation) Size() (n int) {
 	if m.IgnoreIndexConflict {
 		n += 2
 	}
+	if m.Ttl != 0 {
+		n += 2 + sovApi(uint64(m.Ttl))
+	}
+	if m.DeleteIncoming {
+		n += 3
+	}
+	if m.ReadOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *DeleteByQueryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Vars) > 0 {
+		for k, v := range m.Vars {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Deletes) > 0 {
+		for _, e := range m.Deletes {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovApi(uint64(m.BatchSize))
+	}
+	if m.DeleteIncoming {
+		n += 2
+	}
+	return n
+}
+
+func (m *VarDelete) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Var)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteProgress) Size() (n int) {
+	var l int
+	_ = l
+	if m.Matched != 0 {
+		n += 1 + sovApi(uint64(m.Matched))
+	}
+	if m.Deleted != 0 {
+		n += 1 + sovApi(uint64(m.Deleted))
+	}
+	if m.Batches != 0 {
+		n += 1 + sovApi(uint64(m.Batches))
+	}
+	if m.Done {
+		n += 2
+	}
 	return n
 }
 
@@ -2237,6 +3543,17 @@ func (m *Operation) Size() (n int) {
 	if m.StartTs != 0 {
 		n += 1 + sovApi(uint64(m.StartTs))
 	}
+	if m.DropInvalid {
+		n += 2
+	}
+	l = len(m.RenameAttr)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.RenameTo)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
@@ -2247,6 +3564,12 @@ func (m *Payload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2268,6 +3591,18 @@ func (m *TxnContext) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	if m.LinRead != nil {
 		l = m.LinRead.Size()
 		n += 1 + l + sovApi(uint64(l))
@@ -2281,21 +3616,66 @@ func (m *Check) Size() (n int) {
 	return n
 }
 
-func (m *Version) Size() (n int) {
+func (m *SubscribeRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.AfterTs != 0 {
+		n += 1 + sovApi(uint64(m.AfterTs))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovApi(uint64(m.SinceTs))
 	}
 	return n
 }
 
-func (m *LinRead) Size() (n int) {
+func (m *ChangeEvent) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		for k, v := range m.Ids {
+	if m.CommitTs != 0 {
+		n += 1 + sovApi(uint64(m.CommitTs))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovApi(uint64(m.StartTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovApi(uint64(m.GroupId))
+	}
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Del) > 0 {
+		for _, e := range m.Del {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *LinRead) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for k, v := range m.Ids {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + sovApi(uint64(v))
@@ -2353,6 +3733,15 @@ func (m *NQuad) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
+	if m.Ttl != 0 {
+		n += 1 + sovApi(uint64(m.Ttl))
+	}
+	if m.Inc {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovApi(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -2502,6 +3891,51 @@ func (m *SchemaNode) Size() (n int) {
 	if m.List {
 		n += 2
 	}
+	l = len(m.Constraint)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Enum) > 0 {
+		for _, s := range m.Enum {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.Ttl)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *LoginRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Userid)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *LoginResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovApi(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -2744,6 +4178,84 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOfTs", wireType)
+			}
+			m.AsOfTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AsOfTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOfTime", wireType)
+			}
+			m.AsOfTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AsOfTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3121,6 +4633,35 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3416,61 +4957,30 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IgnoreIndexConflict = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssignedIds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteIncoming", wireType)
 			}
-			m.StartId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3480,16 +4990,17 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartId |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.DeleteIncoming = bool(v != 0)
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			m.EndId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3499,11 +5010,12 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndId |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3525,7 +5037,7 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *DeleteByQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3548,15 +5060,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteByQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3581,13 +5093,13 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAttr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3597,24 +5109,142 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropAttr = string(dAtA[iNdEx:postIndex])
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthApi
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Vars == nil {
+				m.Vars = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthApi
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Vars[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Vars[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deletes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deletes = append(m.Deletes, &VarDelete{})
+			if err := m.Deletes[len(m.Deletes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3631,12 +5261,12 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DropAll = bool(v != 0)
-		case 4:
+			m.DryRun = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			m.StartTs = 0
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3646,11 +5276,31 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				m.BatchSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteIncoming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DeleteIncoming = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3672,7 +5322,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payload) Unmarshal(dAtA []byte) error {
+func (m *VarDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3695,17 +5345,17 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+			return fmt.Errorf("proto: VarDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VarDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Var", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3715,22 +5365,49 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Var = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3753,7 +5430,7 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnContext) Unmarshal(dAtA []byte) error {
+func (m *DeleteProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3776,17 +5453,17 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
 			}
-			m.StartTs = 0
+			m.Matched = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3796,16 +5473,16 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				m.Matched |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			m.CommitTs = 0
+			m.Deleted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3815,16 +5492,16 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				m.Deleted |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			var v int
+			m.Batches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3834,46 +5511,16 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Batches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Aborted = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3883,25 +5530,12 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Done = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3923,7 +5557,7 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Check) Unmarshal(dAtA []byte) error {
+func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3946,13 +5580,51 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Check: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+			}
+			m.StartId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
+			}
+			m.EndId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
 			if err != nil {
@@ -3973,7 +5645,7 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3996,15 +5668,15 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4029,63 +5701,62 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAttr", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LinRead) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.DropAttr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinRead: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinRead: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			m.DropAll = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var msglen int
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4095,19 +5766,16 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropInvalid", wireType)
 			}
-			var keykey uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4117,12 +5785,17 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint32
+			m.DropInvalid = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameAttr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4132,50 +5805,49 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Ids == nil {
-				m.Ids = make(map[uint32]uint64)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenameAttr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				var mapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				m.Ids[mapkey] = mapvalue
-			} else {
-				var mapvalue uint64
-				m.Ids[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RenameTo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4198,7 +5870,7 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Latency) Unmarshal(dAtA []byte) error {
+func (m *Payload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4221,17 +5893,17 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Latency: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Latency: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParsingNs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.ParsingNs = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4241,35 +5913,1597 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParsingNs |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingNs", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ProcessingNs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aborted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Check) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Check: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterTs", wireType)
+			}
+			m.AfterTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+			}
+			m.SinceTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, &NQuad{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Del", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Del = append(m.Del, &NQuad{})
+			if err := m.Del[len(m.Del)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinRead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinRead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinRead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Ids == nil {
+				m.Ids = make(map[uint32]uint64)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids[mapkey] = mapvalue
+			} else {
+				var mapvalue uint64
+				m.Ids[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Latency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Latency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Latency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParsingNs", wireType)
+			}
+			m.ParsingNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParsingNs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingNs", wireType)
+			}
+			m.ProcessingNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessingNs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodingNs", wireType)
+			}
+			m.EncodingNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncodingNs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NQuad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NQuad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NQuad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectValue == nil {
+				m.ObjectValue = &Value{}
+			}
+			if err := m.ObjectValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Facets = append(m.Facets, &Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inc = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultVal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProcessingNs |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = &Value_DefaultVal{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_BytesVal{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntVal", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Val = &Value_IntVal{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Val = &Value_BoolVal{b}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = &Value_StrVal{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleVal", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Val = &Value_DoubleVal{float64(math.Float64frombits(v))}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_GeoVal{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_DateVal{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatetimeVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_DatetimeVal{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordVal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = &Value_PasswordVal{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodingNs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidVal", wireType)
 			}
-			m.EncodingNs = 0
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4279,11 +7513,12 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EncodingNs |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Val = &Value_UidVal{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4305,7 +7540,7 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NQuad) Unmarshal(dAtA []byte) error {
+func (m *Facet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4328,15 +7563,15 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NQuad: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NQuad: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4361,13 +7596,13 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4377,26 +7612,28 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
 			}
-			var stringLen uint64
+			m.ValType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4406,57 +7643,14 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ValType |= (Facet_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectValue", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectValue == nil {
-				m.ObjectValue = &Value{}
-			}
-			if err := m.ObjectValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4481,11 +7675,11 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4510,38 +7704,7 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Facets = append(m.Facets, &Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4564,7 +7727,7 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Value) Unmarshal(dAtA []byte) error {
+func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4587,15 +7750,15 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4620,13 +7783,13 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = &Value_DefaultVal{string(dAtA[iNdEx:postIndex])}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4636,27 +7799,26 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_BytesVal{v}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4666,17 +7828,17 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Val = &Value_IntVal{v}
+			m.Index = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4686,18 +7848,26 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Val = &Value_BoolVal{b}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4707,44 +7877,37 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Val = &Value_StrVal{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
+			m.Reverse = bool(v != 0)
 		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleVal", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Val = &Value_DoubleVal{float64(math.Float64frombits(v))}
+			m.Count = bool(v != 0)
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeoVal", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4754,27 +7917,17 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_GeoVal{v}
-			iNdEx = postIndex
+			m.List = bool(v != 0)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4784,27 +7937,26 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_DateVal{v}
+			m.Constraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatetimeVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4814,25 +7966,24 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_DatetimeVal{v}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4857,13 +8008,13 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = &Value_PasswordVal{string(dAtA[iNdEx:postIndex])}
+			m.Enum = append(m.Enum, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidVal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4873,12 +8024,21 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Val = &Value_UidVal{v}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ttl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4900,7 +8060,7 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Facet) Unmarshal(dAtA []byte) error {
+func (m *LoginRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4923,15 +8083,15 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Facet: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoginRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Userid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4956,90 +8116,11 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Userid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
-			}
-			m.ValType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValType |= (Facet_ValType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5064,7 +8145,7 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5087,7 +8168,7 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaNode) Unmarshal(dAtA []byte) error {
+func (m *LoginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5110,15 +8191,15 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5143,131 +8224,13 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.AccessToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Index = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Count = bool(v != 0)
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var v int
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5277,12 +8240,11 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -5412,88 +8374,125 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 1326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xc1, 0x6e, 0x1b, 0x37,
-	0x10, 0xd5, 0x6a, 0x25, 0xed, 0xee, 0x48, 0x4e, 0x04, 0xb6, 0x49, 0x37, 0x76, 0xec, 0x38, 0x1b,
-	0x20, 0x56, 0x53, 0xc4, 0x07, 0x07, 0x68, 0x8b, 0x02, 0x3d, 0xc8, 0x4e, 0x52, 0xab, 0x48, 0xe4,
-	0x84, 0x51, 0x7d, 0x15, 0x28, 0x91, 0x51, 0x36, 0x5e, 0x2f, 0x95, 0x25, 0xd7, 0xb1, 0xfa, 0x19,
-	0x3d, 0x15, 0xe8, 0x3f, 0xf4, 0xd8, 0x6f, 0xc8, 0xad, 0xbd, 0xf6, 0xd6, 0xa6, 0xfd, 0x90, 0x82,
-	0x43, 0xae, 0x65, 0xa7, 0x41, 0x8a, 0xde, 0x38, 0xef, 0x0d, 0xc9, 0x99, 0xc7, 0x99, 0xd9, 0x85,
-	0x88, 0xcd, 0xd3, 0xed, 0x79, 0x21, 0xb5, 0x24, 0x3e, 0x9b, 0xa7, 0xc9, 0x1b, 0x0f, 0x02, 0x2a,
-	0x5e, 0x95, 0x42, 0x69, 0xf2, 0x31, 0x34, 0x5f, 0x95, 0xa2, 0x58, 0xc4, 0xde, 0xa6, 0xd7, 0x8b,
-	0xa8, 0x35, 0xc8, 0x1d, 0x68, 0x9c, 0xb0, 0x42, 0xc5, 0xf5, 0x4d, 0xbf, 0xd7, 0xde, 0xb9, 0xba,
-	0x6d, 0x0e, 0x70, 0x3b, 0xb6, 0x0f, 0x59, 0xa1, 0x1e, 0xe4, 0xba, 0x58, 0x50, 0xf4, 0x21, 0xd7,
-	0x20, 0x54, 0x9a, 0x15, 0x7a, 0xac, 0x55, 0xbc, 0xb2, 0xe9, 0xf5, 0x1a, 0x34, 0x40, 0x7b, 0xa4,
-	0xc8, 0x16, 0x84, 0x59, 0x9a, 0x8f, 0x0b, 0xc1, 0x78, 0x7c, 0x69, 0xd3, 0xeb, 0xb5, 0x77, 0x3a,
-	0x78, 0xd4, 0xa3, 0x34, 0xa7, 0x82, 0x71, 0x1a, 0x64, 0x76, 0xb1, 0xfa, 0x05, 0x44, 0x67, 0xc7,
-	0x92, 0x2e, 0xf8, 0x47, 0xa2, 0x0a, 0xc8, 0x2c, 0x4d, 0x90, 0x27, 0x2c, 0x2b, 0x45, 0x5c, 0xb7,
-	0x41, 0xa2, 0xf1, 0x55, 0xfd, 0x4b, 0x2f, 0xf9, 0xc1, 0x83, 0x90, 0x0a, 0x35, 0x97, 0xb9, 0x12,
-	0x84, 0x40, 0xe3, 0xa5, 0x92, 0x39, 0xee, 0xec, 0x50, 0x5c, 0x93, 0x2d, 0x68, 0xa9, 0xe9, 0x0b,
-	0x71, 0xcc, 0x5c, 0x2e, 0x97, 0x31, 0x80, 0x67, 0x08, 0x0d, 0x25, 0x17, 0xd4, 0xd1, 0xe4, 0x26,
-	0xf8, 0xfa, 0x34, 0x8f, 0x7d, 0x0c, 0xd3, 0x7a, 0x8d, 0x4e, 0xf3, 0x3d, 0x99, 0x6b, 0x71, 0xaa,
-	0xa9, 0xe1, 0xc8, 0x6d, 0x08, 0x32, 0xa6, 0x45, 0x3e, 0x5d, 0xc4, 0x9d, 0xf3, 0xd9, 0x58, 0x8c,
-	0x56, 0x64, 0xf2, 0x93, 0x07, 0x61, 0x5f, 0xa9, 0x74, 0x96, 0x0b, 0x4e, 0x3e, 0x83, 0x46, 0x99,
-	0x72, 0x15, 0x7b, 0x78, 0xfd, 0x27, 0xb8, 0xa3, 0x22, 0xb7, 0xbf, 0x4b, 0x79, 0xa5, 0xa5, 0x71,
-	0x22, 0x9f, 0x42, 0x30, 0xb5, 0x37, 0x62, 0xaa, 0xef, 0x09, 0xa4, 0xe2, 0x8d, 0x64, 0x67, 0xbb,
-	0xff, 0x97, 0x64, 0x3f, 0xd7, 0x21, 0x7c, 0x5c, 0x6a, 0xa6, 0x53, 0x99, 0xe3, 0xe3, 0x09, 0x3d,
-	0x3e, 0x27, 0x5b, 0xa0, 0x84, 0xfe, 0xd6, 0x28, 0x77, 0x03, 0xda, 0x5c, 0x64, 0x42, 0x0b, 0xcb,
-	0xd6, 0x91, 0x05, 0x0b, 0xa1, 0xc3, 0x3a, 0x80, 0xd9, 0x9b, 0xbf, 0x2a, 0x19, 0x57, 0x28, 0x5c,
-	0x87, 0x46, 0x4a, 0xe8, 0x21, 0x02, 0x86, 0xe6, 0x22, 0xab, 0xe8, 0x86, 0xa5, 0xb9, 0xc8, 0x1c,
-	0x7d, 0x1d, 0x7c, 0x25, 0x74, 0x0c, 0x28, 0x0b, 0x60, 0x9a, 0xc3, 0xa7, 0x25, 0xe3, 0xd4, 0xc0,
-	0x86, 0xe5, 0x22, 0x8b, 0xdb, 0xff, 0x66, 0xb9, 0xc8, 0x3e, 0x54, 0x72, 0xeb, 0x00, 0x53, 0x79,
-	0x7c, 0x9c, 0xea, 0x71, 0x2e, 0x5f, 0x63, 0xd1, 0x85, 0x34, 0xb2, 0xc8, 0x50, 0xbe, 0x26, 0x3b,
-	0x70, 0x25, 0x9d, 0xe5, 0xb2, 0x10, 0xe3, 0x34, 0xe7, 0xe2, 0x74, 0x3c, 0x95, 0xf9, 0xf3, 0x2c,
-	0x9d, 0xea, 0xf8, 0x32, 0x7a, 0x7e, 0x64, 0xc9, 0x81, 0xe1, 0xf6, 0x1c, 0x95, 0x7c, 0x0d, 0xed,
-	0xea, 0xc1, 0x06, 0x5c, 0x91, 0x18, 0xec, 0x65, 0x03, 0x8e, 0x8a, 0x55, 0x77, 0x0f, 0xb8, 0xd1,
-	0x5c, 0xe4, 0x7c, 0xc0, 0x51, 0xab, 0x06, 0xb5, 0x46, 0x52, 0x42, 0x74, 0x30, 0x17, 0x85, 0xd5,
-	0xfb, 0xea, 0x59, 0x39, 0xda, 0xb7, 0xaa, 0xaa, 0x6f, 0x0d, 0x22, 0x5e, 0xc8, 0xf9, 0x98, 0x69,
-	0x5d, 0xb8, 0x27, 0x0b, 0x0d, 0xd0, 0xd7, 0xba, 0x30, 0xe9, 0x5a, 0x32, 0xcb, 0x50, 0xe6, 0x90,
-	0x06, 0xc8, 0x65, 0xd9, 0x59, 0x30, 0x23, 0xab, 0xf0, 0x52, 0x88, 0x64, 0x1d, 0x82, 0x27, 0x6c,
-	0x91, 0x49, 0xc6, 0x4d, 0x5f, 0xdc, 0x67, 0x9a, 0x55, 0x7d, 0x61, 0xd6, 0xa6, 0x46, 0x61, 0x59,
-	0x56, 0x17, 0x14, 0xf5, 0x2e, 0x2a, 0xba, 0x06, 0x4e, 0x3f, 0xc3, 0xd9, 0xcc, 0x42, 0x0b, 0x8c,
-	0x50, 0x0c, 0x36, 0x91, 0x85, 0x16, 0xbc, 0x8a, 0xcc, 0x99, 0xe6, 0xd2, 0x23, 0xb1, 0x30, 0x61,
-	0xf9, 0xbd, 0x88, 0xe2, 0xfa, 0xc2, 0x3c, 0x58, 0xf9, 0xc0, 0x3c, 0x48, 0x02, 0x68, 0xee, 0xbd,
-	0x10, 0xd3, 0xa3, 0x64, 0x0d, 0x82, 0x43, 0x51, 0x28, 0x23, 0x5d, 0x17, 0x7c, 0xcd, 0x66, 0x55,
-	0x8d, 0x6b, 0x36, 0x4b, 0x5e, 0x42, 0xe0, 0x76, 0x92, 0x2d, 0xf0, 0x97, 0x4d, 0x76, 0xe5, 0xfc,
-	0xa1, 0xdb, 0x83, 0xaa, 0xc5, 0x8c, 0xc7, 0xea, 0xe7, 0x10, 0x0e, 0xde, 0xd3, 0x35, 0x2b, 0xef,
-	0xe9, 0x9a, 0xc6, 0xf9, 0xae, 0xc9, 0x21, 0x70, 0x7d, 0x6e, 0x4a, 0x6c, 0xce, 0x0a, 0x95, 0xe6,
-	0xb3, 0x71, 0x5e, 0xa9, 0x15, 0x39, 0x64, 0xa8, 0xc8, 0x2d, 0x58, 0x99, 0x17, 0x72, 0x2a, 0x54,
-	0xe5, 0x61, 0xcf, 0xea, 0x2c, 0xc1, 0xa1, 0x32, 0xcd, 0x25, 0xf2, 0xa9, 0xe4, 0xce, 0xc5, 0x47,
-	0x17, 0xa8, 0xa0, 0xa1, 0x4a, 0x7e, 0xf7, 0xa0, 0x89, 0x15, 0x8f, 0x4f, 0x5c, 0x4e, 0x5e, 0x8a,
-	0xa9, 0x76, 0xb9, 0x57, 0x26, 0xb9, 0x0e, 0xd1, 0xbc, 0x10, 0x3c, 0x9d, 0x32, 0x5d, 0xf5, 0xf9,
-	0x12, 0x30, 0xef, 0x26, 0xd1, 0x6f, 0x9c, 0xda, 0xc7, 0x89, 0x68, 0x68, 0x81, 0x01, 0x27, 0x77,
-	0xa1, 0xe3, 0x48, 0x9b, 0x6f, 0x03, 0x5f, 0xc3, 0x36, 0xda, 0xa1, 0x41, 0x68, 0xdb, 0xf2, 0x68,
-	0x18, 0x5d, 0x32, 0x36, 0x11, 0x59, 0xdc, 0xb4, 0xd3, 0x04, 0x0d, 0xf3, 0xc4, 0x19, 0xcb, 0x67,
-	0x71, 0x0b, 0x41, 0x5c, 0x93, 0x04, 0x5a, 0xcf, 0xd9, 0x54, 0x68, 0x15, 0x07, 0xe7, 0x7a, 0xf7,
-	0xa1, 0x81, 0xa8, 0x63, 0x92, 0x3f, 0xeb, 0xd0, 0xb4, 0xe7, 0xde, 0x34, 0x33, 0xe6, 0x39, 0x2b,
-	0x33, 0x8c, 0xc3, 0xe6, 0xb7, 0x5f, 0x33, 0x53, 0x06, 0xc1, 0x43, 0x96, 0x91, 0x75, 0x88, 0x26,
-	0x0b, 0x2d, 0x14, 0x3a, 0xe0, 0x10, 0xda, 0xaf, 0xd1, 0x10, 0x21, 0x43, 0x5f, 0x83, 0x20, 0xcd,
-	0xed, 0x6e, 0x93, 0xa3, 0xbf, 0x5f, 0xa3, 0xad, 0x34, 0xc7, 0x9d, 0x6b, 0x10, 0x4e, 0xa4, 0xcc,
-	0x90, 0x33, 0xf9, 0x85, 0xfb, 0x35, 0x1a, 0x18, 0xc4, 0xed, 0x53, 0xba, 0x40, 0xae, 0xe9, 0x6e,
-	0x6d, 0x29, 0x5d, 0x18, 0xea, 0x06, 0x00, 0x97, 0xe5, 0x24, 0x13, 0xc8, 0x9a, 0xe4, 0xbc, 0xfd,
-	0x1a, 0x8d, 0x2c, 0xe6, 0xf6, 0xce, 0x84, 0x44, 0x36, 0x70, 0x01, 0xb5, 0x66, 0x42, 0xba, 0x3b,
-	0x39, 0xd3, 0x76, 0x67, 0xe8, 0xb8, 0xc0, 0x20, 0x86, 0xbc, 0x05, 0x1d, 0xb3, 0xd4, 0xe9, 0xb1,
-	0x75, 0x88, 0x9c, 0x43, 0xbb, 0x42, 0x9d, 0xd3, 0x9c, 0x29, 0xf5, 0x5a, 0x16, 0x1c, 0x9d, 0xc0,
-	0x45, 0xd7, 0xae, 0x50, 0x17, 0x41, 0x99, 0x5a, 0xbe, 0x6d, 0x4a, 0xc7, 0x44, 0x50, 0xa6, 0x86,
-	0xda, 0x6d, 0x82, 0x7f, 0xc2, 0xb2, 0xe4, 0x57, 0x0f, 0x9a, 0xa8, 0xfa, 0x7f, 0x7d, 0x1b, 0x3a,
-	0xae, 0xca, 0xc9, 0x5d, 0x08, 0x4f, 0x58, 0x36, 0xd6, 0x8b, 0xb9, 0x40, 0x29, 0x2f, 0xed, 0x90,
-	0xe5, 0xdb, 0x99, 0xa2, 0x18, 0x2d, 0xe6, 0x82, 0x06, 0x27, 0x76, 0x61, 0x26, 0x99, 0x96, 0x47,
-	0x22, 0xaf, 0x3a, 0xdc, 0x59, 0xe6, 0x70, 0x96, 0xa5, 0x4c, 0x55, 0xa5, 0x82, 0x46, 0xd2, 0x87,
-	0xc0, 0x9d, 0x40, 0x00, 0x5a, 0xcf, 0x46, 0x74, 0x30, 0xfc, 0xa6, 0x5b, 0x23, 0x01, 0xf8, 0x83,
-	0xe1, 0xa8, 0xeb, 0x91, 0x08, 0x9a, 0x0f, 0x1f, 0x1d, 0xf4, 0x47, 0xdd, 0x3a, 0x09, 0xa1, 0xb1,
-	0x7b, 0x70, 0xf0, 0xa8, 0xeb, 0x93, 0x0e, 0x84, 0xf7, 0xfb, 0xa3, 0x07, 0xa3, 0xc1, 0xe3, 0x07,
-	0xdd, 0x46, 0xf2, 0x8b, 0x07, 0xb0, 0xfc, 0x6e, 0x5f, 0x2c, 0x7e, 0xef, 0xdd, 0xe2, 0x27, 0xd0,
-	0xc0, 0x44, 0x6c, 0x57, 0xe0, 0xda, 0x44, 0x86, 0x43, 0xdf, 0x4d, 0x2a, 0x6b, 0x98, 0x73, 0x30,
-	0xf2, 0xf4, 0x7b, 0x51, 0xb8, 0x54, 0x96, 0x80, 0x69, 0xbe, 0x42, 0x9c, 0x88, 0x42, 0x09, 0xcc,
-	0x27, 0xa4, 0x95, 0x69, 0x4e, 0x9b, 0xca, 0x32, 0xd7, 0x58, 0x20, 0x21, 0xb5, 0x06, 0xb6, 0x44,
-	0xaa, 0x34, 0xd6, 0x45, 0x48, 0x71, 0xbd, 0xf3, 0xb7, 0x07, 0xad, 0xfb, 0xb3, 0x82, 0xcd, 0x5f,
-	0x90, 0xdb, 0xd0, 0x7c, 0x8a, 0x3f, 0x58, 0x9d, 0xf3, 0xbf, 0x54, 0xab, 0x2b, 0xce, 0xb2, 0xff,
-	0x31, 0x49, 0x8d, 0xf4, 0xa0, 0x85, 0x9f, 0x68, 0x41, 0x2c, 0x55, 0x7d, 0xaf, 0x9d, 0x67, 0xf5,
-	0x39, 0x4a, 0x6a, 0x64, 0x0b, 0x9a, 0xfd, 0x4c, 0x8b, 0x82, 0x5c, 0x42, 0xe6, 0xec, 0x4b, 0xb3,
-	0x6a, 0x6f, 0x70, 0x9f, 0x80, 0xa4, 0x46, 0xee, 0xc1, 0xca, 0x1e, 0x4e, 0xed, 0x83, 0xa2, 0x6f,
-	0x46, 0x34, 0x79, 0xf7, 0xd7, 0x62, 0xf5, 0x5d, 0x20, 0xa9, 0x91, 0x3b, 0xd0, 0xc1, 0x39, 0x5c,
-	0xcd, 0x60, 0xdb, 0xcd, 0x08, 0xb9, 0x0b, 0x1c, 0x93, 0xd4, 0x76, 0x7b, 0x6f, 0xde, 0x6e, 0x78,
-	0xbf, 0xbd, 0xdd, 0xf0, 0xfe, 0x78, 0xbb, 0xe1, 0xfd, 0xf8, 0xd7, 0x46, 0x0d, 0xa2, 0x54, 0x6e,
-	0x73, 0x4c, 0x7c, 0xb7, 0x6d, 0x05, 0x78, 0x62, 0x7e, 0x42, 0x27, 0x2d, 0xfc, 0x17, 0xbd, 0xf7,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xeb, 0x39, 0x96, 0x98, 0x0a, 0x00, 0x00,
+	// 1912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0x1b, 0xb9,
+	0x15, 0xd7, 0xe8, 0xdf, 0xcc, 0x3c, 0xc9, 0x89, 0x96, 0xbb, 0x9b, 0x55, 0x9c, 0x7f, 0xce, 0x04,
+	0xd8, 0xa8, 0x5b, 0xac, 0x11, 0x64, 0x81, 0xee, 0xa2, 0xc0, 0x1e, 0x6c, 0x27, 0xdb, 0xa8, 0xc8,
+	0xda, 0x09, 0xa3, 0xa6, 0x47, 0x81, 0xd2, 0xd0, 0xf2, 0x24, 0x63, 0x8e, 0x42, 0x52, 0x4e, 0x94,
+	0x8f, 0xd1, 0x43, 0x51, 0xa0, 0xfd, 0x02, 0xfd, 0x12, 0xed, 0xb5, 0xb7, 0xf6, 0xd4, 0x73, 0x9b,
+	0x9e, 0xfa, 0x21, 0x0a, 0x14, 0xef, 0x91, 0x94, 0x65, 0xaf, 0x9b, 0xa0, 0x7b, 0xe3, 0xfb, 0xfd,
+	0xf8, 0x48, 0xbe, 0xc7, 0xf7, 0x87, 0x33, 0x90, 0x8a, 0x79, 0xb1, 0x3d, 0xd7, 0x95, 0xad, 0x58,
+	0x43, 0xcc, 0x8b, 0xec, 0x4f, 0x75, 0x88, 0xb9, 0x7c, 0xb5, 0x90, 0xc6, 0xb2, 0x4f, 0xa0, 0xf5,
+	0x6a, 0x21, 0xf5, 0xb2, 0x1f, 0x6d, 0x45, 0x83, 0x94, 0x3b, 0x81, 0x7d, 0x01, 0xcd, 0x13, 0xa1,
+	0x4d, 0xbf, 0xbe, 0xd5, 0x18, 0x74, 0xee, 0x5f, 0xd9, 0xc6, 0x05, 0xbc, 0xc6, 0xf6, 0x73, 0xa1,
+	0xcd, 0x43, 0x65, 0xf5, 0x92, 0xd3, 0x1c, 0x76, 0x15, 0x12, 0x63, 0x85, 0xb6, 0x63, 0x6b, 0xfa,
+	0x1b, 0x5b, 0xd1, 0xa0, 0xc9, 0x63, 0x92, 0x47, 0x86, 0xdd, 0x85, 0xa4, 0x2c, 0xd4, 0x58, 0x4b,
+	0x91, 0xf7, 0x2f, 0x6d, 0x45, 0x83, 0xce, 0xfd, 0x2e, 0x2d, 0xf5, 0xb8, 0x50, 0x5c, 0x8a, 0x9c,
+	0xc7, 0xa5, 0x1b, 0xb0, 0x3e, 0x24, 0xc2, 0x8c, 0xab, 0x43, 0x5c, 0xe3, 0x32, 0xad, 0xd1, 0x16,
+	0xe6, 0xe0, 0x70, 0x64, 0xd8, 0x75, 0x00, 0xcf, 0x14, 0xc7, 0xb2, 0xdf, 0xdb, 0x8a, 0x06, 0x0d,
+	0x9e, 0x10, 0x57, 0x1c, 0x4b, 0x76, 0x0d, 0x52, 0x5c, 0x7c, 0x5c, 0xa9, 0x72, 0xd9, 0xff, 0x68,
+	0x2b, 0x1a, 0x24, 0x3c, 0x41, 0xe0, 0x40, 0x95, 0x4b, 0x76, 0x0b, 0x3a, 0x13, 0x69, 0xec, 0x58,
+	0x1e, 0x1e, 0x56, 0xda, 0xf6, 0x19, 0xd1, 0x80, 0xd0, 0x43, 0x42, 0x36, 0xbf, 0x86, 0x74, 0x65,
+	0x0c, 0xeb, 0x41, 0xe3, 0xa5, 0x0c, 0x6e, 0xc0, 0x21, 0xba, 0xe6, 0x44, 0x94, 0x0b, 0xd9, 0xaf,
+	0x3b, 0xd7, 0x90, 0xf0, 0xf3, 0xfa, 0x37, 0x51, 0xf6, 0x9b, 0x08, 0x12, 0x2e, 0xcd, 0xbc, 0x52,
+	0x46, 0x32, 0x06, 0xcd, 0x17, 0xa6, 0x52, 0xa4, 0xd9, 0xe5, 0x34, 0x66, 0x77, 0xa1, 0x6d, 0xa6,
+	0x47, 0xf2, 0x58, 0x78, 0x0f, 0x5e, 0x26, 0xb3, 0x9f, 0x11, 0xb4, 0x5f, 0xe5, 0x92, 0x7b, 0x9a,
+	0xdd, 0x86, 0x86, 0x7d, 0xa3, 0xfa, 0x8d, 0xad, 0x68, 0x35, 0x6b, 0xf4, 0x46, 0xed, 0x55, 0xca,
+	0xca, 0x37, 0x96, 0x23, 0xc7, 0x3e, 0x87, 0xb8, 0x14, 0x56, 0xaa, 0xe9, 0xb2, 0xdf, 0x5d, 0xf7,
+	0xa1, 0xc3, 0x78, 0x20, 0xb3, 0xdf, 0x47, 0x90, 0xec, 0x18, 0x53, 0xcc, 0x94, 0xcc, 0xd9, 0x4f,
+	0xa1, 0xb9, 0x28, 0x72, 0xd3, 0x8f, 0x68, 0xfb, 0xcf, 0x48, 0x23, 0x90, 0xdb, 0xbf, 0x2a, 0xf2,
+	0x70, 0x83, 0x38, 0x89, 0xfd, 0x04, 0xe2, 0xa9, 0xdb, 0xb1, 0x5f, 0xbf, 0xf8, 0x20, 0x81, 0x47,
+	0x97, 0xad, 0xb4, 0xff, 0x2f, 0x97, 0xfd, 0xbb, 0x0e, 0xc9, 0xf7, 0x0b, 0x2b, 0x6c, 0x51, 0x29,
+	0x0a, 0x19, 0x69, 0xc7, 0x6b, 0x6e, 0x8b, 0x8d, 0xb4, 0xbf, 0x44, 0xcf, 0xdd, 0x82, 0x4e, 0x2e,
+	0x4b, 0x69, 0xa5, 0x63, 0xeb, 0xc4, 0x82, 0x83, 0x68, 0xc2, 0x0d, 0x00, 0xd4, 0x55, 0xaf, 0x16,
+	0x22, 0x37, 0xe4, 0xb8, 0x2e, 0x4f, 0x8d, 0xb4, 0xfb, 0x04, 0x20, 0x9d, 0xcb, 0x32, 0xd0, 0x4d,
+	0x47, 0xe7, 0xb2, 0xf4, 0xf4, 0x75, 0x68, 0x18, 0x69, 0xfb, 0x40, 0x6e, 0x01, 0x32, 0x73, 0xff,
+	0xe9, 0x42, 0xe4, 0x1c, 0x61, 0x64, 0x73, 0x59, 0xf6, 0x3b, 0x3f, 0x64, 0x73, 0x59, 0xbe, 0x2f,
+	0xd0, 0x6f, 0x00, 0x4c, 0xab, 0xe3, 0xe3, 0xc2, 0x8e, 0x55, 0xf5, 0x9a, 0x42, 0x3d, 0xe1, 0xa9,
+	0x43, 0xf6, 0xab, 0xd7, 0xec, 0x3e, 0x7c, 0x5a, 0xcc, 0x54, 0xa5, 0xe5, 0xb8, 0x50, 0xb9, 0x7c,
+	0x33, 0x9e, 0x56, 0xea, 0xb0, 0x2c, 0xa6, 0x96, 0x62, 0x3d, 0xe1, 0x1f, 0x3b, 0x72, 0x88, 0xdc,
+	0x9e, 0xa7, 0xd0, 0xb9, 0xd6, 0x96, 0x14, 0xf1, 0x4d, 0x8e, 0x43, 0x76, 0x17, 0x2e, 0x7b, 0xd7,
+	0x14, 0x6a, 0x5a, 0x1d, 0x17, 0x6a, 0xe6, 0x43, 0xfe, 0x92, 0x83, 0x87, 0x1e, 0xcd, 0xfe, 0x58,
+	0x87, 0x4f, 0x1e, 0x10, 0xb4, 0xbb, 0x7c, 0x8a, 0xf9, 0xfc, 0xfe, 0x64, 0xff, 0xfa, 0x4c, 0xb2,
+	0xdf, 0x21, 0xb3, 0x2f, 0x52, 0xff, 0x41, 0xe6, 0x0f, 0x20, 0x76, 0x3b, 0xe3, 0x3d, 0xa0, 0xee,
+	0x25, 0xd2, 0x7d, 0x2e, 0xb4, 0x53, 0xe7, 0x81, 0x66, 0x9f, 0x41, 0x9c, 0xeb, 0xe5, 0x58, 0x2f,
+	0x14, 0x5d, 0x49, 0xc2, 0xdb, 0xb9, 0x5e, 0xf2, 0x05, 0xdd, 0xe6, 0x44, 0xd8, 0xe9, 0xd1, 0xd8,
+	0x14, 0x6f, 0x65, 0xbf, 0xb5, 0x15, 0x0d, 0x36, 0x78, 0x4a, 0xc8, 0xb3, 0xe2, 0xad, 0xbc, 0xc8,
+	0xe4, 0xf6, 0x45, 0x26, 0xff, 0xf8, 0x54, 0xfe, 0x16, 0xd2, 0xd5, 0x79, 0x51, 0xf1, 0x44, 0xe8,
+	0xa0, 0x78, 0x22, 0x34, 0xbb, 0x09, 0x30, 0xd7, 0x32, 0x2f, 0xa6, 0xc2, 0x4a, 0xe7, 0xa1, 0x94,
+	0xaf, 0x21, 0x99, 0x86, 0x4b, 0x4e, 0xf7, 0x89, 0xae, 0x66, 0x5a, 0x1a, 0xc3, 0xfa, 0x10, 0x1f,
+	0xe3, 0xf9, 0x65, 0x4e, 0xeb, 0x34, 0x79, 0x10, 0x91, 0x71, 0xa7, 0xce, 0xe9, 0x18, 0xcd, 0xe0,
+	0x1e, 0x62, 0xc8, 0x66, 0xe9, 0x02, 0xba, 0xc9, 0x83, 0x88, 0xc5, 0x25, 0xaf, 0x94, 0xf4, 0x5e,
+	0xa3, 0x71, 0xf6, 0x2d, 0x74, 0x42, 0x2a, 0x0f, 0x73, 0xda, 0x90, 0xc2, 0x70, 0xb8, 0xda, 0xd0,
+	0x8b, 0x68, 0xb5, 0x54, 0xf9, 0x30, 0x6c, 0xe7, 0x84, 0xec, 0xef, 0x11, 0xa4, 0x07, 0x73, 0xa9,
+	0x5d, 0x2a, 0x5e, 0x59, 0x55, 0x2a, 0x67, 0xb5, 0x97, 0xb0, 0xb2, 0xe6, 0xba, 0x9a, 0x8f, 0x85,
+	0xb5, 0xda, 0x7b, 0x2d, 0x41, 0x60, 0xc7, 0x5a, 0x8d, 0x99, 0xe0, 0xc8, 0xb2, 0xa4, 0x03, 0x27,
+	0x3c, 0x26, 0xae, 0x2c, 0x57, 0xa7, 0x19, 0xb9, 0xe4, 0x5b, 0xcb, 0x91, 0xdb, 0xd0, 0x25, 0xa5,
+	0x42, 0x9d, 0x88, 0xb2, 0xc8, 0xe9, 0xb2, 0x13, 0xde, 0x41, 0x6c, 0xe8, 0x20, 0x4c, 0x7e, 0x2d,
+	0x95, 0x38, 0x96, 0x6e, 0xdb, 0x36, 0x6d, 0x0b, 0x0e, 0xa2, 0x8d, 0xa9, 0xde, 0xd3, 0x04, 0x5b,
+	0xf5, 0x63, 0x77, 0x2a, 0x07, 0x8c, 0xaa, 0xec, 0x06, 0xc4, 0x4f, 0xc4, 0xb2, 0xac, 0x44, 0x8e,
+	0x6e, 0x7b, 0x20, 0xac, 0x08, 0x35, 0x19, 0xc7, 0x58, 0x1f, 0xe1, 0xb4, 0xa4, 0x9d, 0xc9, 0xe6,
+	0xe8, 0xec, 0x49, 0xaf, 0x81, 0xcf, 0x5d, 0xe4, 0x9c, 0xef, 0x12, 0x07, 0x8c, 0xc8, 0xdd, 0x62,
+	0x52, 0x69, 0xbc, 0x45, 0x6f, 0xba, 0x17, 0x71, 0xd3, 0x97, 0x72, 0x89, 0x76, 0x63, 0x94, 0xd0,
+	0xf8, 0x4c, 0x07, 0xdc, 0x78, 0x4f, 0x07, 0xcc, 0x62, 0x68, 0xed, 0x1d, 0xc9, 0xe9, 0xcb, 0xec,
+	0x08, 0x7a, 0xcf, 0x16, 0x13, 0x33, 0xd5, 0xc5, 0x44, 0x86, 0xbc, 0xbd, 0x0a, 0x89, 0x38, 0xb4,
+	0x52, 0xaf, 0x9d, 0x95, 0xe4, 0x91, 0xf9, 0x50, 0x80, 0x92, 0x99, 0x85, 0x9a, 0x4a, 0x54, 0xf5,
+	0xb1, 0x45, 0xf2, 0xc8, 0x64, 0x7f, 0x88, 0xa0, 0xb3, 0x77, 0x24, 0xd4, 0x4c, 0x3e, 0x3c, 0x91,
+	0xca, 0x9e, 0x35, 0x3b, 0x3a, 0x67, 0xf6, 0xba, 0xbb, 0xea, 0x67, 0xdd, 0x75, 0x15, 0x92, 0x99,
+	0xae, 0x16, 0xf3, 0x71, 0xe1, 0x5c, 0xb2, 0xc1, 0x63, 0x92, 0x87, 0x79, 0x28, 0xb7, 0xcd, 0xf7,
+	0x96, 0xdb, 0xd6, 0x85, 0xe5, 0x36, 0xbb, 0x06, 0xf1, 0x73, 0xa9, 0x0d, 0x06, 0x29, 0xd6, 0x42,
+	0x31, 0x0b, 0x79, 0x69, 0xc5, 0x2c, 0x7b, 0x01, 0xb1, 0x77, 0x21, 0xbb, 0x0b, 0x8d, 0xd3, 0x4e,
+	0xf7, 0xe9, 0xba, 0x77, 0xb7, 0x87, 0xa1, 0xcf, 0xe1, 0x8c, 0xcd, 0x9f, 0x41, 0x32, 0xbc, 0xa0,
+	0x75, 0x6d, 0x5c, 0x50, 0x22, 0x9a, 0xeb, 0x25, 0x42, 0x41, 0xec, 0x9b, 0x2d, 0x96, 0xab, 0xb9,
+	0xd0, 0xa6, 0x50, 0xb3, 0xb1, 0x0a, 0x3e, 0x4a, 0x3d, 0xb2, 0x6f, 0xd8, 0x1d, 0xd8, 0x98, 0xeb,
+	0x6a, 0x2a, 0x4d, 0x98, 0xe1, 0xd6, 0xea, 0x9e, 0x82, 0xfb, 0x06, 0x83, 0x5c, 0xaa, 0x69, 0x95,
+	0xfb, 0x29, 0xee, 0x52, 0x20, 0x40, 0xfb, 0x26, 0xfb, 0x4f, 0x04, 0x2d, 0xf2, 0x03, 0x25, 0xd3,
+	0x62, 0xf2, 0x42, 0x4e, 0xad, 0xb7, 0x3d, 0x88, 0xec, 0x3a, 0xa4, 0xab, 0x4b, 0xf6, 0xe9, 0x79,
+	0x0a, 0xe0, 0x4d, 0x56, 0x34, 0x2f, 0x5c, 0x49, 0xca, 0x13, 0x07, 0x0c, 0x73, 0xf6, 0x25, 0x74,
+	0x3d, 0xe9, 0xec, 0x6d, 0x6e, 0x45, 0x2b, 0xf7, 0x3f, 0x47, 0x84, 0x77, 0x1c, 0x4f, 0x02, 0xfa,
+	0xa5, 0x14, 0x13, 0xba, 0x26, 0x2a, 0x9d, 0x24, 0x60, 0xac, 0x97, 0xc2, 0x57, 0xe3, 0x94, 0xd3,
+	0x98, 0x65, 0xd0, 0x3e, 0x14, 0x53, 0x69, 0x4d, 0x3f, 0x5e, 0xbb, 0xd1, 0xef, 0x10, 0xe2, 0x9e,
+	0x09, 0x5d, 0x2d, 0x39, 0xed, 0x6a, 0x3d, 0x68, 0x14, 0x6a, 0xda, 0x4f, 0x29, 0x97, 0x70, 0x98,
+	0xfd, 0xb3, 0x0e, 0x2d, 0xb7, 0xf7, 0x6d, 0x7c, 0x0c, 0x1c, 0x8a, 0x45, 0x49, 0x67, 0x75, 0x3e,
+	0x78, 0x54, 0xe3, 0xe0, 0xc1, 0xe7, 0xa2, 0x64, 0x37, 0x20, 0x9d, 0x2c, 0xad, 0x34, 0x34, 0x81,
+	0x5e, 0x0b, 0x8f, 0x6a, 0x3c, 0x21, 0x08, 0xe9, 0xab, 0x10, 0x17, 0xca, 0x69, 0xa3, 0x1f, 0x1a,
+	0x8f, 0x6a, 0xbc, 0x5d, 0x28, 0xd2, 0xbc, 0x06, 0xc9, 0xa4, 0xaa, 0x4a, 0xe2, 0xa8, 0xbc, 0x3e,
+	0xaa, 0xf1, 0x18, 0x11, 0xaf, 0x67, 0xac, 0x26, 0xae, 0xe5, 0x77, 0x6d, 0x1b, 0xab, 0x91, 0xba,
+	0x05, 0x90, 0x57, 0x8b, 0x49, 0x29, 0x89, 0x45, 0x07, 0x44, 0x8f, 0x6a, 0x3c, 0x75, 0x98, 0xd7,
+	0x9d, 0xc9, 0x8a, 0xd8, 0xd8, 0x1f, 0xa8, 0x3d, 0x93, 0x95, 0xdf, 0x33, 0x17, 0xd6, 0x69, 0x26,
+	0x9e, 0x8b, 0x11, 0x41, 0xf2, 0x0e, 0x74, 0x71, 0x88, 0x0f, 0x5d, 0x9a, 0x90, 0xfa, 0x09, 0x9d,
+	0x80, 0xfa, 0x49, 0x73, 0x61, 0xcc, 0xeb, 0x4a, 0xe7, 0x34, 0x09, 0xfc, 0xe9, 0x3a, 0x01, 0xf5,
+	0x27, 0x58, 0x14, 0x8e, 0xef, 0xa0, 0xa7, 0xf1, 0x04, 0x8b, 0x02, 0xa9, 0xdd, 0x16, 0xb6, 0xb8,
+	0x32, 0xfb, 0x6b, 0x04, 0x2d, 0xba, 0x99, 0x0f, 0x35, 0xcb, 0xae, 0xcf, 0x04, 0xf6, 0x25, 0x24,
+	0x27, 0xa2, 0x1c, 0xdb, 0xe5, 0x5c, 0x92, 0x2b, 0x2f, 0xdd, 0x67, 0xa7, 0xf7, 0x8b, 0x81, 0x33,
+	0x5a, 0xce, 0x25, 0x8f, 0x4f, 0xdc, 0x00, 0xfb, 0x8a, 0xad, 0x5e, 0x4a, 0x15, 0xca, 0xa1, 0x97,
+	0x70, 0x71, 0x51, 0x16, 0xc2, 0x84, 0x70, 0x22, 0x21, 0xdb, 0x81, 0xd8, 0xaf, 0xc0, 0x00, 0xda,
+	0xcf, 0x46, 0x7c, 0xb8, 0xff, 0x8b, 0x5e, 0x8d, 0xc5, 0xd0, 0x18, 0xee, 0x8f, 0x7a, 0x11, 0x4b,
+	0xa1, 0xf5, 0xdd, 0xe3, 0x83, 0x9d, 0x51, 0xaf, 0xce, 0x12, 0x68, 0xee, 0x1e, 0x1c, 0x3c, 0xee,
+	0x35, 0x58, 0x17, 0x92, 0x07, 0x3b, 0xa3, 0x87, 0xa3, 0xe1, 0xf7, 0x0f, 0x7b, 0xcd, 0xec, 0xb7,
+	0x75, 0x80, 0xd3, 0x07, 0xf6, 0xd9, 0x04, 0x89, 0xce, 0x27, 0x08, 0x83, 0x26, 0x19, 0xe2, 0x32,
+	0x87, 0xc6, 0x78, 0x32, 0x7a, 0x9d, 0xf9, 0xb2, 0xee, 0x04, 0x5c, 0x87, 0x4e, 0x5e, 0xbc, 0x95,
+	0xda, 0x9b, 0x72, 0x0a, 0x60, 0x82, 0x6a, 0x79, 0x22, 0xb5, 0x91, 0xbe, 0x9d, 0x05, 0x11, 0x57,
+	0x9b, 0x56, 0x0b, 0x65, 0xfd, 0x7b, 0xc5, 0x09, 0x94, 0x36, 0x85, 0xb1, 0x14, 0x17, 0x09, 0xa7,
+	0x31, 0x56, 0xf0, 0x69, 0xa5, 0x8c, 0xd5, 0xa2, 0x50, 0x96, 0xa2, 0x22, 0xe5, 0x6b, 0x08, 0xee,
+	0x31, 0x17, 0xd6, 0x4a, 0xad, 0x28, 0x22, 0x52, 0x1e, 0x44, 0x5c, 0x4d, 0xaa, 0xc5, 0x31, 0xbd,
+	0x66, 0x53, 0x4e, 0xe3, 0x90, 0x60, 0x1d, 0x5f, 0x2a, 0x6d, 0x99, 0xed, 0x42, 0xf7, 0x71, 0x35,
+	0x2b, 0x54, 0x68, 0x26, 0x57, 0xa0, 0xbd, 0x30, 0x52, 0x17, 0x79, 0xe8, 0xf8, 0x4e, 0x62, 0x9b,
+	0x90, 0x84, 0x18, 0x0a, 0x0d, 0x3f, 0xc8, 0xd9, 0x53, 0xd8, 0xf0, 0x6b, 0xf8, 0x8f, 0x9e, 0xdb,
+	0xd0, 0x15, 0x53, 0xac, 0x69, 0x63, 0x72, 0x86, 0x5f, 0xaa, 0xe3, 0xb0, 0x11, 0x42, 0x58, 0x2b,
+	0xe5, 0x9b, 0x79, 0xa1, 0xa5, 0x19, 0x0b, 0xf7, 0x61, 0xd1, 0xe0, 0xa9, 0x47, 0x76, 0xec, 0xfd,
+	0x3f, 0x37, 0xa0, 0xfd, 0x60, 0xa6, 0xc5, 0xfc, 0x88, 0x7d, 0x0e, 0x2d, 0x7a, 0x67, 0xb2, 0xee,
+	0xfa, 0x87, 0xe6, 0xe6, 0x86, 0x97, 0xdc, 0x96, 0x59, 0x8d, 0x0d, 0xa0, 0x4d, 0x9f, 0x10, 0x92,
+	0x39, 0x2a, 0x7c, 0x4f, 0xf8, 0x99, 0xe1, 0x51, 0x94, 0xd5, 0xd8, 0x5d, 0x68, 0xed, 0x94, 0x56,
+	0x6a, 0xe6, 0x5e, 0xa4, 0xab, 0xe7, 0xce, 0xa6, 0xdb, 0xc1, 0x3f, 0x13, 0xb2, 0x1a, 0xfb, 0x0a,
+	0x36, 0xf6, 0xa8, 0xc5, 0x1d, 0xe8, 0x1d, 0x6c, 0xe3, 0xec, 0xfc, 0xa7, 0xcf, 0xe6, 0x79, 0x20,
+	0xab, 0xb1, 0x2f, 0xa0, 0x4b, 0xbd, 0x3a, 0xb4, 0x27, 0x57, 0xe8, 0x08, 0xf2, 0x1b, 0x78, 0x26,
+	0xab, 0xb1, 0x6f, 0x20, 0x5d, 0xb5, 0x73, 0xe6, 0xba, 0xd3, 0xf9, 0xf6, 0xbe, 0xd9, 0xf3, 0xfa,
+	0xab, 0x56, 0x9c, 0xd5, 0xee, 0x45, 0x6c, 0x00, 0xad, 0x5f, 0xe3, 0x2b, 0xf0, 0x03, 0x5e, 0xb9,
+	0x17, 0xb1, 0x3d, 0xd8, 0x38, 0xf3, 0x5e, 0x67, 0x57, 0xff, 0xe7, 0x1b, 0x7e, 0xf3, 0xe3, 0x35,
+	0x2a, 0xbc, 0x59, 0x69, 0x91, 0x7b, 0xd0, 0xa2, 0x2b, 0x66, 0x1f, 0xb9, 0x16, 0xba, 0x16, 0x32,
+	0x9b, 0x6c, 0x1d, 0x0a, 0x1b, 0xef, 0x0e, 0xfe, 0xf2, 0xee, 0x66, 0xf4, 0xb7, 0x77, 0x37, 0xa3,
+	0x7f, 0xbc, 0xbb, 0x19, 0xfd, 0xee, 0x5f, 0x37, 0x6b, 0x90, 0x16, 0xd5, 0x76, 0x4e, 0x77, 0xba,
+	0xdb, 0x71, 0x77, 0xfb, 0x04, 0xff, 0x3a, 0x4c, 0xda, 0xf4, 0xf3, 0xe1, 0xab, 0xff, 0x0e, 0x00,
+	0x49, 0x97, 0xa2, 0x19, 0x89, 0x10, 0x00, 0x00,
 }
@@ -43,12 +43,24 @@
 		SchemaRequest
 		SchemaResult
 		SchemaUpdate
+		Constraint
 		MapEntry
+		RenamePredicatePayload
 		MovePredicatePayload
 		ExportPayload
 		OracleDelta
 		TxnTimestamps
 		Num
+		ExportCount
+		TabletSplit
+		SplitPredicatePayload
+		RebalanceState
+		MoveTabletRequest
+		TabletMove
+		TabletMoves
+		TabletPin
+		MoveProgress
+		TsMark
 */
 package intern
 
@@ -80,15 +92,18 @@ type DirectedEdge_Op int32
 const (
 	DirectedEdge_SET DirectedEdge_Op = 0
 	DirectedEdge_DEL DirectedEdge_Op = 1
+	DirectedEdge_INC DirectedEdge_Op = 2
 )
 
 var DirectedEdge_Op_name = map[int32]string{
 	0: "SET",
 	1: "DEL",
+	2: "INC",
 }
 var DirectedEdge_Op_value = map[string]int32{
 	"SET": 0,
 	"DEL": 1,
+	"INC": 2,
 }
 
 func (x DirectedEdge_Op) String() string {
@@ -222,7 +237,7 @@ func (x ExportPayload_Status) String() string {
 	return proto.EnumName(ExportPayload_Status_name, int32(x))
 }
 func (ExportPayload_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorInternal, []int{37, 0}
+	return fileDescriptorInternal, []int{39, 0}
 }
 
 type List struct {
@@ -312,6 +327,8 @@ type Query struct {
 	ExpandAll    bool         `protobuf:"varint,10,opt,name=expand_all,json=expandAll,proto3" json:"expand_all,omitempty"`
 	ReadTs       uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
 	LinRead      *api.LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	// has also looks at the lists only committed in memory so far.
+	IncludeCached bool `protobuf:"varint,15,opt,name=include_cached,json=includeCached,proto3" json:"include_cached,omitempty"`
 }
 
 func (m *Query) Reset()                    { *m = Query{} }
@@ -403,6 +420,13 @@ func (m *Query) GetLinRead() *api.LinRead {
 	return nil
 }
 
+func (m *Query) GetIncludeCached() bool {
+	if m != nil {
+		return m.IncludeCached
+	}
+	return false
+}
+
 type ValueList struct {
 	Values []*TaskValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
 }
@@ -735,13 +759,19 @@ func (m *Group) GetTablets() map[string]*Tablet {
 }
 
 type ZeroProposal struct {
-	Id         uint32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Member     *Member         `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
-	Tablet     *Tablet         `protobuf:"bytes,3,opt,name=tablet" json:"tablet,omitempty"`
-	MaxLeaseId uint64          `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
-	MaxTxnTs   uint64          `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
-	MaxRaftId  uint64          `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
-	Txn        *api.TxnContext `protobuf:"bytes,7,opt,name=txn" json:"txn,omitempty"`
+	Id           uint32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Member       *Member         `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	Tablet       *Tablet         `protobuf:"bytes,3,opt,name=tablet" json:"tablet,omitempty"`
+	MaxLeaseId   uint64          `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
+	MaxTxnTs     uint64          `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
+	MaxRaftId    uint64          `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
+	Txn          *api.TxnContext `protobuf:"bytes,7,opt,name=txn" json:"txn,omitempty"`
+	Tablets      []*Tablet       `protobuf:"bytes,8,rep,name=tablets" json:"tablets,omitempty"`
+	Rebalance    *RebalanceState `protobuf:"bytes,9,opt,name=rebalance" json:"rebalance,omitempty"`
+	Pin          *TabletPin      `protobuf:"bytes,10,opt,name=pin" json:"pin,omitempty"`
+	MoveProgress *MoveProgress   `protobuf:"bytes,11,opt,name=move_progress,json=moveProgress" json:"move_progress,omitempty"`
+	TabletMove   *TabletMove     `protobuf:"bytes,12,opt,name=tablet_move,json=tabletMove" json:"tablet_move,omitempty"`
+	TsMark       *TsMark         `protobuf:"bytes,13,opt,name=ts_mark,json=tsMark" json:"ts_mark,omitempty"`
 }
 
 func (m *ZeroProposal) Reset()                    { *m = ZeroProposal{} }
@@ -798,17 +828,62 @@ func (m *ZeroProposal) GetTxn() *api.TxnContext {
 	return nil
 }
 
+func (m *ZeroProposal) GetTablets() []*Tablet {
+	if m != nil {
+		return m.Tablets
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetRebalance() *RebalanceState {
+	if m != nil {
+		return m.Rebalance
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetPin() *TabletPin {
+	if m != nil {
+		return m.Pin
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetMoveProgress() *MoveProgress {
+	if m != nil {
+		return m.MoveProgress
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetTabletMove() *TabletMove {
+	if m != nil {
+		return m.TabletMove
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetTsMark() *TsMark {
+	if m != nil {
+		return m.TsMark
+	}
+	return nil
+}
+
 // MembershipState is used to pack together the current membership state of all the nodes
 // in the caller server; and the membership updates recorded by the callee server since
 // the provided lastUpdate.
 type MembershipState struct {
-	Counter    uint64             `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
-	Groups     map[uint32]*Group  `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Zeros      map[uint64]*Member `protobuf:"bytes,3,rep,name=zeros" json:"zeros,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	MaxLeaseId uint64             `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
-	MaxTxnTs   uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
-	MaxRaftId  uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
-	Removed    []*Member          `protobuf:"bytes,7,rep,name=removed" json:"removed,omitempty"`
+	Counter         uint64             `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+	Groups          map[uint32]*Group  `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	Zeros           map[uint64]*Member `protobuf:"bytes,3,rep,name=zeros" json:"zeros,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	MaxLeaseId      uint64             `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
+	MaxTxnTs        uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
+	MaxRaftId       uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
+	Removed         []*Member          `protobuf:"bytes,7,rep,name=removed" json:"removed,omitempty"`
+	RebalancePaused bool               `protobuf:"varint,8,opt,name=rebalance_paused,json=rebalancePaused,proto3" json:"rebalance_paused,omitempty"`
+	Moves           []*TabletMove      `protobuf:"bytes,9,rep,name=moves" json:"moves,omitempty"`
+	TsMarks         []*TsMark          `protobuf:"bytes,10,rep,name=ts_marks,json=tsMarks" json:"ts_marks,omitempty"`
 }
 
 func (m *MembershipState) Reset()                    { *m = MembershipState{} }
@@ -865,6 +940,27 @@ func (m *MembershipState) GetRemoved() []*Member {
 	return nil
 }
 
+func (m *MembershipState) GetRebalancePaused() bool {
+	if m != nil {
+		return m.RebalancePaused
+	}
+	return false
+}
+
+func (m *MembershipState) GetMoves() []*TabletMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+func (m *MembershipState) GetTsMarks() []*TsMark {
+	if m != nil {
+		return m.TsMarks
+	}
+	return nil
+}
+
 type ConnectionState struct {
 	Member *Member          `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
 	State  *MembershipState `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
@@ -896,6 +992,14 @@ type Tablet struct {
 	ReadOnly  bool   `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
 	Space     int64  `protobuf:"varint,7,opt,name=space,proto3" json:"space,omitempty"`
 	Remove    bool   `protobuf:"varint,8,opt,name=remove,proto3" json:"remove,omitempty"`
+	// Set when the predicate is split by uid across groups. Uids below the first start_uid
+	// belong to group_id.
+	Splits []*TabletSplit `protobuf:"bytes,9,rep,name=splits" json:"splits,omitempty"`
+	// Reads and writes per second, as reported by the leader of the group.
+	ReadQps  float64       `protobuf:"fixed64,10,opt,name=read_qps,json=readQps,proto3" json:"read_qps,omitempty"`
+	WriteQps float64       `protobuf:"fixed64,11,opt,name=write_qps,json=writeQps,proto3" json:"write_qps,omitempty"`
+	Pinned   bool          `protobuf:"varint,12,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Move     *MoveProgress `protobuf:"bytes,13,opt,name=move" json:"move,omitempty"`
 }
 
 func (m *Tablet) Reset()                    { *m = Tablet{} }
@@ -945,6 +1049,41 @@ func (m *Tablet) GetRemove() bool {
 	return false
 }
 
+func (m *Tablet) GetSplits() []*TabletSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *Tablet) GetReadQps() float64 {
+	if m != nil {
+		return m.ReadQps
+	}
+	return 0
+}
+
+func (m *Tablet) GetWriteQps() float64 {
+	if m != nil {
+		return m.WriteQps
+	}
+	return 0
+}
+
+func (m *Tablet) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *Tablet) GetMove() *MoveProgress {
+	if m != nil {
+		return m.Move
+	}
+	return nil
+}
+
 type DirectedEdge struct {
 	Entity    uint64          `protobuf:"fixed64,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	Attr      string          `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
@@ -955,6 +1094,7 @@ type DirectedEdge struct {
 	Lang      string          `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`
 	Op        DirectedEdge_Op `protobuf:"varint,8,opt,name=op,proto3,enum=intern.DirectedEdge_Op" json:"op,omitempty"`
 	Facets    []*api.Facet    `protobuf:"bytes,9,rep,name=facets" json:"facets,omitempty"`
+	ExpiresAt uint64          `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *DirectedEdge) Reset()                    { *m = DirectedEdge{} }
@@ -1025,6 +1165,13 @@ func (m *DirectedEdge) GetFacets() []*api.Facet {
 	return nil
 }
 
+func (m *DirectedEdge) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type Mutations struct {
 	GroupId             uint32          `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	StartTs             uint64          `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
@@ -1032,6 +1179,9 @@ type Mutations struct {
 	Schema              []*SchemaUpdate `protobuf:"bytes,4,rep,name=schema" json:"schema,omitempty"`
 	DropAll             bool            `protobuf:"varint,5,opt,name=drop_all,json=dropAll,proto3" json:"drop_all,omitempty"`
 	IgnoreIndexConflict bool            `protobuf:"varint,6,opt,name=ignore_index_conflict,json=ignoreIndexConflict,proto3" json:"ignore_index_conflict,omitempty"`
+	DropInvalid         bool            `protobuf:"varint,7,opt,name=drop_invalid,json=dropInvalid,proto3" json:"drop_invalid,omitempty"`
+	// Timestamp at which the values converted by a schema change are written.
+	CommitTs uint64 `protobuf:"varint,8,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
 }
 
 func (m *Mutations) Reset()                    { *m = Mutations{} }
@@ -1081,6 +1231,20 @@ func (m *Mutations) GetIgnoreIndexConflict() bool {
 	return false
 }
 
+func (m *Mutations) GetDropInvalid() bool {
+	if m != nil {
+		return m.DropInvalid
+	}
+	return false
+}
+
+func (m *Mutations) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
+	}
+	return 0
+}
+
 type KeyValues struct {
 	Kv []*KV `protobuf:"bytes,1,rep,name=kv" json:"kv,omitempty"`
 }
@@ -1098,12 +1262,14 @@ func (m *KeyValues) GetKv() []*KV {
 }
 
 type Proposal struct {
-	Id             uint32           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Mutations      *Mutations       `protobuf:"bytes,2,opt,name=mutations" json:"mutations,omitempty"`
-	TxnContext     *api.TxnContext  `protobuf:"bytes,3,opt,name=txn_context,json=txnContext" json:"txn_context,omitempty"`
-	Kv             []*KV            `protobuf:"bytes,4,rep,name=kv" json:"kv,omitempty"`
-	State          *MembershipState `protobuf:"bytes,5,opt,name=state" json:"state,omitempty"`
-	CleanPredicate string           `protobuf:"bytes,6,opt,name=clean_predicate,json=cleanPredicate,proto3" json:"clean_predicate,omitempty"`
+	Id             uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mutations      *Mutations              `protobuf:"bytes,2,opt,name=mutations" json:"mutations,omitempty"`
+	TxnContext     *api.TxnContext         `protobuf:"bytes,3,opt,name=txn_context,json=txnContext" json:"txn_context,omitempty"`
+	Kv             []*KV                   `protobuf:"bytes,4,rep,name=kv" json:"kv,omitempty"`
+	State          *MembershipState        `protobuf:"bytes,5,opt,name=state" json:"state,omitempty"`
+	CleanPredicate string                  `protobuf:"bytes,6,opt,name=clean_predicate,json=cleanPredicate,proto3" json:"clean_predicate,omitempty"`
+	Rename         *RenamePredicatePayload `protobuf:"bytes,7,opt,name=rename" json:"rename,omitempty"`
+	Split          *SplitPredicatePayload  `protobuf:"bytes,8,opt,name=split" json:"split,omitempty"`
 }
 
 func (m *Proposal) Reset()                    { *m = Proposal{} }
@@ -1153,6 +1319,20 @@ func (m *Proposal) GetCleanPredicate() string {
 	return ""
 }
 
+func (m *Proposal) GetRename() *RenamePredicatePayload {
+	if m != nil {
+		return m.Rename
+	}
+	return nil
+}
+
+func (m *Proposal) GetSplit() *SplitPredicatePayload {
+	if m != nil {
+		return m.Split
+	}
+	return nil
+}
+
 type KV struct {
 	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Val      []byte `protobuf:"bytes,2,opt,name=val,proto3" json:"val,omitempty"`
@@ -1250,6 +1430,7 @@ type Posting struct {
 	LangTag     []byte              `protobuf:"bytes,5,opt,name=lang_tag,json=langTag,proto3" json:"lang_tag,omitempty"`
 	Label       string              `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
 	Facets      []*api.Facet        `protobuf:"bytes,9,rep,name=facets" json:"facets,omitempty"`
+	ExpiresAt   uint64              `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	// TODO: op is only used temporarily. See if we can remove it from here.
 	Op       uint32 `protobuf:"varint,12,opt,name=op,proto3" json:"op,omitempty"`
 	StartTs  uint64 `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
@@ -1310,6 +1491,13 @@ func (m *Posting) GetFacets() []*api.Facet {
 	return nil
 }
 
+func (m *Posting) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 func (m *Posting) GetOp() uint32 {
 	if m != nil {
 		return m.Op
@@ -1567,12 +1755,14 @@ func (m *SchemaResult) GetSchema() []*api.SchemaNode {
 }
 
 type SchemaUpdate struct {
-	Predicate string                 `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
-	ValueType Posting_ValType        `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=intern.Posting_ValType" json:"value_type,omitempty"`
-	Directive SchemaUpdate_Directive `protobuf:"varint,3,opt,name=directive,proto3,enum=intern.SchemaUpdate_Directive" json:"directive,omitempty"`
-	Tokenizer []string               `protobuf:"bytes,4,rep,name=tokenizer" json:"tokenizer,omitempty"`
-	Count     bool                   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	List      bool                   `protobuf:"varint,6,opt,name=list,proto3" json:"list,omitempty"`
+	Predicate  string                 `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	ValueType  Posting_ValType        `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=intern.Posting_ValType" json:"value_type,omitempty"`
+	Directive  SchemaUpdate_Directive `protobuf:"varint,3,opt,name=directive,proto3,enum=intern.SchemaUpdate_Directive" json:"directive,omitempty"`
+	Tokenizer  []string               `protobuf:"bytes,4,rep,name=tokenizer" json:"tokenizer,omitempty"`
+	Count      bool                   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	List       bool                   `protobuf:"varint,6,opt,name=list,proto3" json:"list,omitempty"`
+	Constraint *Constraint            `protobuf:"bytes,8,opt,name=constraint" json:"constraint,omitempty"`
+	Ttl        uint64                 `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
 func (m *SchemaUpdate) Reset()                    { *m = SchemaUpdate{} }
@@ -1622,6 +1812,77 @@ func (m *SchemaUpdate) GetList() bool {
 	return false
 }
 
+func (m *SchemaUpdate) GetConstraint() *Constraint {
+	if m != nil {
+		return m.Constraint
+	}
+	return nil
+}
+
+func (m *SchemaUpdate) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+// Constraint restricts the values that may be stored for a scalar predicate.
+type Constraint struct {
+	HasMin  bool     `protobuf:"varint,1,opt,name=has_min,json=hasMin,proto3" json:"has_min,omitempty"`
+	Min     float64  `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	HasMax  bool     `protobuf:"varint,3,opt,name=has_max,json=hasMax,proto3" json:"has_max,omitempty"`
+	Max     float64  `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Pattern string   `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Enum    []string `protobuf:"bytes,6,rep,name=enum" json:"enum,omitempty"`
+}
+
+func (m *Constraint) Reset()                    { *m = Constraint{} }
+func (m *Constraint) String() string            { return proto.CompactTextString(m) }
+func (*Constraint) ProtoMessage()               {}
+func (*Constraint) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{35} }
+
+func (m *Constraint) GetHasMin() bool {
+	if m != nil {
+		return m.HasMin
+	}
+	return false
+}
+
+func (m *Constraint) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *Constraint) GetHasMax() bool {
+	if m != nil {
+		return m.HasMax
+	}
+	return false
+}
+
+func (m *Constraint) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *Constraint) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *Constraint) GetEnum() []string {
+	if m != nil {
+		return m.Enum
+	}
+	return nil
+}
+
 // Bulk loader proto.
 type MapEntry struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -1633,7 +1894,7 @@ type MapEntry struct {
 func (m *MapEntry) Reset()                    { *m = MapEntry{} }
 func (m *MapEntry) String() string            { return proto.CompactTextString(m) }
 func (*MapEntry) ProtoMessage()               {}
-func (*MapEntry) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{35} }
+func (*MapEntry) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{36} }
 
 func (m *MapEntry) GetKey() []byte {
 	if m != nil {
@@ -1656,17 +1917,73 @@ func (m *MapEntry) GetPosting() *Posting {
 	return nil
 }
 
+type RenamePredicatePayload struct {
+	Predicate string           `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	NewName   string           `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	GroupId   uint32           `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	State     *MembershipState `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	StartTs   uint64           `protobuf:"varint,5,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+}
+
+func (m *RenamePredicatePayload) Reset()                    { *m = RenamePredicatePayload{} }
+func (m *RenamePredicatePayload) String() string            { return proto.CompactTextString(m) }
+func (*RenamePredicatePayload) ProtoMessage()               {}
+func (*RenamePredicatePayload) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{37} }
+
+func (m *RenamePredicatePayload) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *RenamePredicatePayload) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *RenamePredicatePayload) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *RenamePredicatePayload) GetState() *MembershipState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *RenamePredicatePayload) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
 type MovePredicatePayload struct {
 	Predicate     string           `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
 	SourceGroupId uint32           `protobuf:"varint,2,opt,name=source_group_id,json=sourceGroupId,proto3" json:"source_group_id,omitempty"`
 	DestGroupId   uint32           `protobuf:"varint,3,opt,name=dest_group_id,json=destGroupId,proto3" json:"dest_group_id,omitempty"`
 	State         *MembershipState `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	// Only move the uids in [start_uid, end_uid), if start_uid is set. An end_uid of zero
+	// leaves the range unbounded.
+	StartUid uint64 `protobuf:"fixed64,5,opt,name=start_uid,json=startUid,proto3" json:"start_uid,omitempty"`
+	EndUid   uint64 `protobuf:"fixed64,6,opt,name=end_uid,json=endUid,proto3" json:"end_uid,omitempty"`
+	// Resume a move which failed, by sending the keys after resume_key only.
+	ResumeKey []byte `protobuf:"bytes,7,opt,name=resume_key,json=resumeKey,proto3" json:"resume_key,omitempty"`
+	Rate      uint64 `protobuf:"varint,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	Attempt   uint32 `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *MovePredicatePayload) Reset()                    { *m = MovePredicatePayload{} }
 func (m *MovePredicatePayload) String() string            { return proto.CompactTextString(m) }
 func (*MovePredicatePayload) ProtoMessage()               {}
-func (*MovePredicatePayload) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{36} }
+func (*MovePredicatePayload) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{38} }
 
 func (m *MovePredicatePayload) GetPredicate() string {
 	if m != nil {
@@ -1696,20 +2013,63 @@ func (m *MovePredicatePayload) GetState() *MembershipState {
 	return nil
 }
 
+func (m *MovePredicatePayload) GetStartUid() uint64 {
+	if m != nil {
+		return m.StartUid
+	}
+	return 0
+}
+
+func (m *MovePredicatePayload) GetEndUid() uint64 {
+	if m != nil {
+		return m.EndUid
+	}
+	return 0
+}
+
+func (m *MovePredicatePayload) GetResumeKey() []byte {
+	if m != nil {
+		return m.ResumeKey
+	}
+	return nil
+}
+
+func (m *MovePredicatePayload) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *MovePredicatePayload) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 // BackupPayload is used both as a request and a response.
 // When used in request, groups represents the list of groups that need to be backed up.
 // When used in response, groups represent the list of groups that were backed up.
 type ExportPayload struct {
-	ReqId   uint64               `protobuf:"varint,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
-	GroupId uint32               `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Status  ExportPayload_Status `protobuf:"varint,3,opt,name=status,proto3,enum=intern.ExportPayload_Status" json:"status,omitempty"`
-	ReadTs  uint64               `protobuf:"varint,4,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	ReqId      uint64               `protobuf:"varint,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	GroupId    uint32               `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Status     ExportPayload_Status `protobuf:"varint,3,opt,name=status,proto3,enum=intern.ExportPayload_Status" json:"status,omitempty"`
+	ReadTs     uint64               `protobuf:"varint,4,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	Backup     string               `protobuf:"bytes,5,opt,name=backup,proto3" json:"backup,omitempty"`
+	SinceTs    uint64               `protobuf:"varint,6,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	Format     string               `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	Predicates []string             `protobuf:"bytes,8,rep,name=predicates" json:"predicates,omitempty"`
+	Dir        string               `protobuf:"bytes,9,opt,name=dir,proto3" json:"dir,omitempty"`
+	// Set in the response.
+	Files  []string       `protobuf:"bytes,10,rep,name=files" json:"files,omitempty"`
+	Counts []*ExportCount `protobuf:"bytes,11,rep,name=counts" json:"counts,omitempty"`
 }
 
 func (m *ExportPayload) Reset()                    { *m = ExportPayload{} }
 func (m *ExportPayload) String() string            { return proto.CompactTextString(m) }
 func (*ExportPayload) ProtoMessage()               {}
-func (*ExportPayload) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{37} }
+func (*ExportPayload) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{39} }
 
 func (m *ExportPayload) GetReqId() uint64 {
 	if m != nil {
@@ -1739,6 +2099,55 @@ func (m *ExportPayload) GetReadTs() uint64 {
 	return 0
 }
 
+func (m *ExportPayload) GetBackup() string {
+	if m != nil {
+		return m.Backup
+	}
+	return ""
+}
+
+func (m *ExportPayload) GetSinceTs() uint64 {
+	if m != nil {
+		return m.SinceTs
+	}
+	return 0
+}
+
+func (m *ExportPayload) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportPayload) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+func (m *ExportPayload) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *ExportPayload) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportPayload) GetCounts() []*ExportCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
 type OracleDelta struct {
 	Commits    map[uint64]uint64 `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	Aborts     []uint64          `protobuf:"varint,2,rep,packed,name=aborts" json:"aborts,omitempty"`
@@ -1748,7 +2157,7 @@ type OracleDelta struct {
 func (m *OracleDelta) Reset()                    { *m = OracleDelta{} }
 func (m *OracleDelta) String() string            { return proto.CompactTextString(m) }
 func (*OracleDelta) ProtoMessage()               {}
-func (*OracleDelta) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{38} }
+func (*OracleDelta) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{40} }
 
 func (m *OracleDelta) GetCommits() map[uint64]uint64 {
 	if m != nil {
@@ -1778,7 +2187,7 @@ type TxnTimestamps struct {
 func (m *TxnTimestamps) Reset()                    { *m = TxnTimestamps{} }
 func (m *TxnTimestamps) String() string            { return proto.CompactTextString(m) }
 func (*TxnTimestamps) ProtoMessage()               {}
-func (*TxnTimestamps) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{39} }
+func (*TxnTimestamps) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{41} }
 
 func (m *TxnTimestamps) GetTs() []uint64 {
 	if m != nil {
@@ -1794,7 +2203,7 @@ type Num struct {
 func (m *Num) Reset()                    { *m = Num{} }
 func (m *Num) String() string            { return proto.CompactTextString(m) }
 func (*Num) ProtoMessage()               {}
-func (*Num) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{40} }
+func (*Num) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{42} }
 
 func (m *Num) GetVal() uint64 {
 	if m != nil {
@@ -1803,18 +2212,355 @@ func (m *Num) GetVal() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*List)(nil), "intern.List")
-	proto.RegisterType((*TaskValue)(nil), "intern.TaskValue")
-	proto.RegisterType((*SrcFunction)(nil), "intern.SrcFunction")
-	proto.RegisterType((*Query)(nil), "intern.Query")
-	proto.RegisterType((*ValueList)(nil), "intern.ValueList")
-	proto.RegisterType((*LangList)(nil), "intern.LangList")
-	proto.RegisterType((*Result)(nil), "intern.Result")
-	proto.RegisterType((*Order)(nil), "intern.Order")
-	proto.RegisterType((*SortMessage)(nil), "intern.SortMessage")
-	proto.RegisterType((*SortResult)(nil), "intern.SortResult")
-	proto.RegisterType((*RaftContext)(nil), "intern.RaftContext")
+// The uids from start_uid, up to the start_uid of the next split, are served by group_id.
+type TabletSplit struct {
+	StartUid uint64 `protobuf:"fixed64,1,opt,name=start_uid,json=startUid,proto3" json:"start_uid,omitempty"`
+	GroupId  uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *TabletSplit) Reset()                    { *m = TabletSplit{} }
+func (m *TabletSplit) String() string            { return proto.CompactTextString(m) }
+func (*TabletSplit) ProtoMessage()               {}
+func (*TabletSplit) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{44} }
+
+func (m *TabletSplit) GetStartUid() uint64 {
+	if m != nil {
+		return m.StartUid
+	}
+	return 0
+}
+
+func (m *TabletSplit) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// Sent to each group serving a predicate which is being split, once the ranges it receives
+// have been moved, so it drops the uids it no longer serves and rebuilds its indexes.
+type SplitPredicatePayload struct {
+	Predicate string  `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	GroupId   uint32  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Tablet    *Tablet `protobuf:"bytes,3,opt,name=tablet" json:"tablet,omitempty"`
+	ReadTs    uint64  `protobuf:"varint,4,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+}
+
+func (m *SplitPredicatePayload) Reset()                    { *m = SplitPredicatePayload{} }
+func (m *SplitPredicatePayload) String() string            { return proto.CompactTextString(m) }
+func (*SplitPredicatePayload) ProtoMessage()               {}
+func (*SplitPredicatePayload) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{45} }
+
+func (m *SplitPredicatePayload) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *SplitPredicatePayload) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *SplitPredicatePayload) GetTablet() *Tablet {
+	if m != nil {
+		return m.Tablet
+	}
+	return nil
+}
+
+func (m *SplitPredicatePayload) GetReadTs() uint64 {
+	if m != nil {
+		return m.ReadTs
+	}
+	return 0
+}
+
+// RebalanceState pauses or resumes the rebalancing of tablets by Zero.
+type RebalanceState struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *RebalanceState) Reset()                    { *m = RebalanceState{} }
+func (m *RebalanceState) String() string            { return proto.CompactTextString(m) }
+func (*RebalanceState) ProtoMessage()               {}
+func (*RebalanceState) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{46} }
+
+func (m *RebalanceState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type MoveTabletRequest struct {
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	GroupId   uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MoveTabletRequest) Reset()                    { *m = MoveTabletRequest{} }
+func (m *MoveTabletRequest) String() string            { return proto.CompactTextString(m) }
+func (*MoveTabletRequest) ProtoMessage()               {}
+func (*MoveTabletRequest) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{47} }
+
+func (m *MoveTabletRequest) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *MoveTabletRequest) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// A move of a tablet made by the leader of Zero, either asked for or by the rebalancer.
+type TabletMove struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Predicate  string `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	SrcGroup   uint32 `protobuf:"varint,3,opt,name=src_group,json=srcGroup,proto3" json:"src_group,omitempty"`
+	DstGroup   uint32 `protobuf:"varint,4,opt,name=dst_group,json=dstGroup,proto3" json:"dst_group,omitempty"`
+	Manual     bool   `protobuf:"varint,5,opt,name=manual,proto3" json:"manual,omitempty"`
+	Status     string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Error      string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt  int64  `protobuf:"varint,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt int64  `protobuf:"varint,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (m *TabletMove) Reset()                    { *m = TabletMove{} }
+func (m *TabletMove) String() string            { return proto.CompactTextString(m) }
+func (*TabletMove) ProtoMessage()               {}
+func (*TabletMove) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{48} }
+
+func (m *TabletMove) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TabletMove) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *TabletMove) GetSrcGroup() uint32 {
+	if m != nil {
+		return m.SrcGroup
+	}
+	return 0
+}
+
+func (m *TabletMove) GetDstGroup() uint32 {
+	if m != nil {
+		return m.DstGroup
+	}
+	return 0
+}
+
+func (m *TabletMove) GetManual() bool {
+	if m != nil {
+		return m.Manual
+	}
+	return false
+}
+
+func (m *TabletMove) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TabletMove) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TabletMove) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *TabletMove) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+type TabletMoves struct {
+	Moves []*TabletMove `protobuf:"bytes,1,rep,name=moves" json:"moves,omitempty"`
+}
+
+func (m *TabletMoves) Reset()                    { *m = TabletMoves{} }
+func (m *TabletMoves) String() string            { return proto.CompactTextString(m) }
+func (*TabletMoves) ProtoMessage()               {}
+func (*TabletMoves) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{49} }
+
+func (m *TabletMoves) GetMoves() []*TabletMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+// TabletPin pins a tablet to the group serving it, or unpins it.
+type TabletPin struct {
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	Pinned    bool   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (m *TabletPin) Reset()                    { *m = TabletPin{} }
+func (m *TabletPin) String() string            { return proto.CompactTextString(m) }
+func (*TabletPin) ProtoMessage()               {}
+func (*TabletPin) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{50} }
+
+func (m *TabletPin) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *TabletPin) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+// Progress of a tablet being moved. The destination group reports it after writing each
+// batch of keys, so that a failed move resumes after last_key.
+type MoveProgress struct {
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	DstGroup  uint32 `protobuf:"varint,2,opt,name=dst_group,json=dstGroup,proto3" json:"dst_group,omitempty"`
+	LastKey   []byte `protobuf:"bytes,3,opt,name=last_key,json=lastKey,proto3" json:"last_key,omitempty"`
+	Keys      uint64 `protobuf:"varint,4,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bytes     uint64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	StartedAt int64  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Attempts  uint32 `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Attempt   uint32 `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (m *MoveProgress) Reset()                    { *m = MoveProgress{} }
+func (m *MoveProgress) String() string            { return proto.CompactTextString(m) }
+func (*MoveProgress) ProtoMessage()               {}
+func (*MoveProgress) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{51} }
+
+func (m *MoveProgress) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *MoveProgress) GetDstGroup() uint32 {
+	if m != nil {
+		return m.DstGroup
+	}
+	return 0
+}
+
+func (m *MoveProgress) GetLastKey() []byte {
+	if m != nil {
+		return m.LastKey
+	}
+	return nil
+}
+
+func (m *MoveProgress) GetKeys() uint64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *MoveProgress) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *MoveProgress) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *MoveProgress) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *MoveProgress) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *MoveProgress) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+// The latest timestamp handed out by Zero at a Unix time.
+type TsMark struct {
+	Unix int64  `protobuf:"varint,1,opt,name=unix,proto3" json:"unix,omitempty"`
+	Ts   uint64 `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+}
+
+func (m *TsMark) Reset()                    { *m = TsMark{} }
+func (m *TsMark) String() string            { return proto.CompactTextString(m) }
+func (*TsMark) ProtoMessage()               {}
+func (*TsMark) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{52} }
+
+func (m *TsMark) GetUnix() int64 {
+	if m != nil {
+		return m.Unix
+	}
+	return 0
+}
+
+func (m *TsMark) GetTs() uint64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*List)(nil), "intern.List")
+	proto.RegisterType((*TaskValue)(nil), "intern.TaskValue")
+	proto.RegisterType((*SrcFunction)(nil), "intern.SrcFunction")
+	proto.RegisterType((*Query)(nil), "intern.Query")
+	proto.RegisterType((*ValueList)(nil), "intern.ValueList")
+	proto.RegisterType((*LangList)(nil), "intern.LangList")
+	proto.RegisterType((*Result)(nil), "intern.Result")
+	proto.RegisterType((*Order)(nil), "intern.Order")
+	proto.RegisterType((*SortMessage)(nil), "intern.SortMessage")
+	proto.RegisterType((*SortResult)(nil), "intern.SortResult")
+	proto.RegisterType((*RaftContext)(nil), "intern.RaftContext")
 	proto.RegisterType((*Member)(nil), "intern.Member")
 	proto.RegisterType((*Group)(nil), "intern.Group")
 	proto.RegisterType((*ZeroProposal)(nil), "intern.ZeroProposal")
@@ -1839,12 +2585,24 @@ func init() {
 	proto.RegisterType((*SchemaRequest)(nil), "intern.SchemaRequest")
 	proto.RegisterType((*SchemaResult)(nil), "intern.SchemaResult")
 	proto.RegisterType((*SchemaUpdate)(nil), "intern.SchemaUpdate")
+	proto.RegisterType((*Constraint)(nil), "intern.Constraint")
 	proto.RegisterType((*MapEntry)(nil), "intern.MapEntry")
+	proto.RegisterType((*RenamePredicatePayload)(nil), "intern.RenamePredicatePayload")
 	proto.RegisterType((*MovePredicatePayload)(nil), "intern.MovePredicatePayload")
 	proto.RegisterType((*ExportPayload)(nil), "intern.ExportPayload")
 	proto.RegisterType((*OracleDelta)(nil), "intern.OracleDelta")
 	proto.RegisterType((*TxnTimestamps)(nil), "intern.TxnTimestamps")
 	proto.RegisterType((*Num)(nil), "intern.Num")
+	proto.RegisterType((*ExportCount)(nil), "intern.ExportCount")
+	proto.RegisterType((*TabletSplit)(nil), "intern.TabletSplit")
+	proto.RegisterType((*SplitPredicatePayload)(nil), "intern.SplitPredicatePayload")
+	proto.RegisterType((*RebalanceState)(nil), "intern.RebalanceState")
+	proto.RegisterType((*MoveTabletRequest)(nil), "intern.MoveTabletRequest")
+	proto.RegisterType((*TabletMove)(nil), "intern.TabletMove")
+	proto.RegisterType((*TabletMoves)(nil), "intern.TabletMoves")
+	proto.RegisterType((*TabletPin)(nil), "intern.TabletPin")
+	proto.RegisterType((*MoveProgress)(nil), "intern.MoveProgress")
+	proto.RegisterType((*TsMark)(nil), "intern.TsMark")
 	proto.RegisterEnum("intern.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
 	proto.RegisterEnum("intern.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
 	proto.RegisterEnum("intern.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
@@ -1876,6 +2634,31 @@ func NewRaftClient(cc *grpc.ClientConn) RaftClient {
 	return &raftClient{cc}
 }
 
+// Number of RDF triples, or JSON objects, exported for a predicate.
+type ExportCount struct {
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	Count     uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ExportCount) Reset()                    { *m = ExportCount{} }
+func (m *ExportCount) String() string            { return proto.CompactTextString(m) }
+func (*ExportCount) ProtoMessage()               {}
+func (*ExportCount) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{43} }
+
+func (m *ExportCount) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *ExportCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func (c *raftClient) Echo(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (*api.Payload, error) {
 	out := new(api.Payload)
 	err := grpc.Invoke(ctx, "/intern.Raft/Echo", in, out, c.cc, opts...)
@@ -2001,6 +2784,15 @@ type ZeroClient interface {
 	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*api.AssignedIds, error)
 	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
 	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*TxnTimestamps, error)
+	// Val is a Unix time in seconds. Returns the latest timestamp handed out at or
+	// before it, or zero if Zero's history doesn't go back that far.
+	TimestampAt(ctx context.Context, in *Num, opts ...grpc.CallOption) (*Num, error)
+	RenamePredicate(ctx context.Context, in *RenamePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	// Starts moving a tablet to another group, the move shows up in GetTabletMoves.
+	MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*TabletMove, error)
+	PinTablet(ctx context.Context, in *TabletPin, opts ...grpc.CallOption) (*api.Payload, error)
+	GetTabletMoves(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (*TabletMoves, error)
+	ReportMoveProgress(ctx context.Context, in *MoveProgress, opts ...grpc.CallOption) (*api.Payload, error)
 }
 
 type zeroClient struct {
@@ -2128,29 +2920,92 @@ func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...gr
 	return out, nil
 }
 
-// Server API for Zero service
-
-type ZeroServer interface {
-	Connect(context.Context, *Member) (*ConnectionState, error)
-	Update(Zero_UpdateServer) error
-	Oracle(*api.Payload, Zero_OracleServer) error
-	ShouldServe(context.Context, *Tablet) (*Tablet, error)
-	AssignUids(context.Context, *Num) (*api.AssignedIds, error)
-	Timestamps(context.Context, *Num) (*api.AssignedIds, error)
-	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
-	TryAbort(context.Context, *TxnTimestamps) (*TxnTimestamps, error)
+func (c *zeroClient) TimestampAt(ctx context.Context, in *Num, opts ...grpc.CallOption) (*Num, error) {
+	out := new(Num)
+	err := grpc.Invoke(ctx, "/intern.Zero/TimestampAt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
-	s.RegisterService(&_Zero_serviceDesc, srv)
+func (c *zeroClient) RenamePredicate(ctx context.Context, in *RenamePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := grpc.Invoke(ctx, "/intern.Zero/RenamePredicate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Member)
-	if err := dec(in); err != nil {
+func (c *zeroClient) MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*TabletMove, error) {
+	out := new(TabletMove)
+	err := grpc.Invoke(ctx, "/intern.Zero/MoveTablet", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
+	return out, nil
+}
+
+func (c *zeroClient) PinTablet(ctx context.Context, in *TabletPin, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := grpc.Invoke(ctx, "/intern.Zero/PinTablet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) GetTabletMoves(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (*TabletMoves, error) {
+	out := new(TabletMoves)
+	err := grpc.Invoke(ctx, "/intern.Zero/GetTabletMoves", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) ReportMoveProgress(ctx context.Context, in *MoveProgress, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := grpc.Invoke(ctx, "/intern.Zero/ReportMoveProgress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Zero service
+
+type ZeroServer interface {
+	Connect(context.Context, *Member) (*ConnectionState, error)
+	Update(Zero_UpdateServer) error
+	Oracle(*api.Payload, Zero_OracleServer) error
+	ShouldServe(context.Context, *Tablet) (*Tablet, error)
+	AssignUids(context.Context, *Num) (*api.AssignedIds, error)
+	Timestamps(context.Context, *Num) (*api.AssignedIds, error)
+	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
+	TryAbort(context.Context, *TxnTimestamps) (*TxnTimestamps, error)
+	// Val is a Unix time in seconds. Returns the latest timestamp handed out at or
+	// before it, or zero if Zero's history doesn't go back that far.
+	TimestampAt(context.Context, *Num) (*Num, error)
+	RenamePredicate(context.Context, *RenamePredicatePayload) (*api.Payload, error)
+	// Starts moving a tablet to another group, the move shows up in GetTabletMoves.
+	MoveTablet(context.Context, *MoveTabletRequest) (*TabletMove, error)
+	PinTablet(context.Context, *TabletPin) (*api.Payload, error)
+	GetTabletMoves(context.Context, *api.Payload) (*TabletMoves, error)
+	ReportMoveProgress(context.Context, *MoveProgress) (*api.Payload, error)
+}
+
+func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
+	s.RegisterService(&_Zero_serviceDesc, srv)
+}
+
+func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
 		return srv.(ZeroServer).Connect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
@@ -2300,6 +3155,114 @@ func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zero_TimestampAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).TimestampAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/TimestampAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).TimestampAt(ctx, req.(*Num))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_RenamePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).RenamePredicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/RenamePredicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).RenamePredicate(ctx, req.(*RenamePredicatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_MoveTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).MoveTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/MoveTablet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).MoveTablet(ctx, req.(*MoveTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_PinTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TabletPin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).PinTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/PinTablet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).PinTablet(ctx, req.(*TabletPin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_GetTabletMoves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.Payload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).GetTabletMoves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/GetTabletMoves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).GetTabletMoves(ctx, req.(*api.Payload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_ReportMoveProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveProgress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).ReportMoveProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/ReportMoveProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).ReportMoveProgress(ctx, req.(*MoveProgress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Zero_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "intern.Zero",
 	HandlerType: (*ZeroServer)(nil),
@@ -2328,6 +3291,30 @@ var _Zero_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryAbort",
 			Handler:    _Zero_TryAbort_Handler,
 		},
+		{
+			MethodName: "TimestampAt",
+			Handler:    _Zero_TimestampAt_Handler,
+		},
+		{
+			MethodName: "RenamePredicate",
+			Handler:    _Zero_RenamePredicate_Handler,
+		},
+		{
+			MethodName: "MoveTablet",
+			Handler:    _Zero_MoveTablet_Handler,
+		},
+		{
+			MethodName: "PinTablet",
+			Handler:    _Zero_PinTablet_Handler,
+		},
+		{
+			MethodName: "GetTabletMoves",
+			Handler:    _Zero_GetTabletMoves_Handler,
+		},
+		{
+			MethodName: "ReportMoveProgress",
+			Handler:    _Zero_ReportMoveProgress_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2359,6 +3346,9 @@ type WorkerClient interface {
 	Export(ctx context.Context, in *ExportPayload, opts ...grpc.CallOption) (*ExportPayload, error)
 	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
 	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	RenamePredicate(ctx context.Context, in *RenamePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	SplitPredicate(ctx context.Context, in *SplitPredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	GroupChanges(ctx context.Context, in *api.SubscribeRequest, opts ...grpc.CallOption) (Worker_GroupChangesClient, error)
 }
 
 type workerClient struct {
@@ -2506,6 +3496,56 @@ func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePaylo
 	return out, nil
 }
 
+func (c *workerClient) RenamePredicate(ctx context.Context, in *RenamePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := grpc.Invoke(ctx, "/intern.Worker/RenamePredicate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) SplitPredicate(ctx context.Context, in *SplitPredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := grpc.Invoke(ctx, "/intern.Worker/SplitPredicate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) GroupChanges(ctx context.Context, in *api.SubscribeRequest, opts ...grpc.CallOption) (Worker_GroupChangesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[2], c.cc, "/intern.Worker/GroupChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerGroupChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_GroupChangesClient interface {
+	Recv() (*api.ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type workerGroupChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerGroupChangesClient) Recv() (*api.ChangeEvent, error) {
+	m := new(api.ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Worker service
 
 type WorkerServer interface {
@@ -2520,6 +3560,9 @@ type WorkerServer interface {
 	Export(context.Context, *ExportPayload) (*ExportPayload, error)
 	ReceivePredicate(Worker_ReceivePredicateServer) error
 	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
+	RenamePredicate(context.Context, *RenamePredicatePayload) (*api.Payload, error)
+	SplitPredicate(context.Context, *SplitPredicatePayload) (*api.Payload, error)
+	GroupChanges(*api.SubscribeRequest, Worker_GroupChangesServer) error
 }
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
@@ -2722,6 +3765,63 @@ func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_RenamePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RenamePredicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Worker/RenamePredicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RenamePredicate(ctx, req.(*RenamePredicatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_SplitPredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitPredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SplitPredicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Worker/SplitPredicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SplitPredicate(ctx, req.(*SplitPredicatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_GroupChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).GroupChanges(m, &workerGroupChangesServer{stream})
+}
+
+type Worker_GroupChangesServer interface {
+	Send(*api.ChangeEvent) error
+	grpc.ServerStream
+}
+
+type workerGroupChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerGroupChangesServer) Send(m *api.ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "intern.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -2758,6 +3858,14 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MovePredicate",
 			Handler:    _Worker_MovePredicate_Handler,
 		},
+		{
+			MethodName: "RenamePredicate",
+			Handler:    _Worker_RenamePredicate_Handler,
+		},
+		{
+			MethodName: "SplitPredicate",
+			Handler:    _Worker_SplitPredicate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2771,6 +3879,11 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Worker_ReceivePredicate_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GroupChanges",
+			Handler:       _Worker_GroupChanges_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal.proto",
 }
@@ -3020,6 +4133,16 @@ func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n5
 	}
+	if m.IncludeCached {
+		dAtA[i] = 0x78
+		i++
+		if m.IncludeCached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -3586,24 +4709,86 @@ func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n15
 	}
-	return i, nil
-}
-
-func (m *MembershipState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Tablets) > 0 {
+		for _, msg := range m.Tablets {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
+	if m.Rebalance != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Rebalance.Size()))
+		n16, err := m.Rebalance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Pin != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Pin.Size()))
+		n17, err := m.Pin.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.MoveProgress != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.MoveProgress.Size()))
+		n18, err := m.MoveProgress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.TabletMove != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.TabletMove.Size()))
+		n, err := m.TabletMove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n
+	}
+	if m.TsMark != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.TsMark.Size()))
+		n, err := m.TsMark.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n
+	}
+	return i, nil
+}
+
+func (m *MembershipState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
 	if m.Counter != 0 {
 		dAtA[i] = 0x8
 		i++
@@ -3628,11 +4813,11 @@ func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintInternal(dAtA, i, uint64(v.Size()))
-				n16, err := v.MarshalTo(dAtA[i:])
+				n19, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n16
+				i += n19
 			}
 		}
 	}
@@ -3655,11 +4840,11 @@ func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintInternal(dAtA, i, uint64(v.Size()))
-				n17, err := v.MarshalTo(dAtA[i:])
+				n20, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n17
+				i += n20
 			}
 		}
 	}
@@ -3690,6 +4875,40 @@ func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RebalancePaused {
+		dAtA[i] = 0x40
+		i++
+		if m.RebalancePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Moves) > 0 {
+		for _, msg := range m.Moves {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.TsMarks) > 0 {
+		for _, msg := range m.TsMarks {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -3712,21 +4931,21 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.Member.Size()))
-		n18, err := m.Member.MarshalTo(dAtA[i:])
+		n21, err := m.Member.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if m.State != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.State.Size()))
-		n19, err := m.State.MarshalTo(dAtA[i:])
+		n22, err := m.State.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n22
 	}
 	return i, nil
 }
@@ -3792,6 +5011,48 @@ func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Splits) > 0 {
+		for _, msg := range m.Splits {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ReadQps != 0 {
+		dAtA[i] = 0x51
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(math.Float64bits(float64(m.ReadQps))))
+	}
+	if m.WriteQps != 0 {
+		dAtA[i] = 0x59
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(math.Float64bits(float64(m.WriteQps))))
+	}
+	if m.Pinned {
+		dAtA[i] = 0x60
+		i++
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Move != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Move.Size()))
+		n23, err := m.Move.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
 	return i, nil
 }
 
@@ -3866,6 +5127,11 @@ func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExpiresAt))
+	}
 	return i, nil
 }
 
@@ -3938,6 +5204,21 @@ func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.DropInvalid {
+		dAtA[i] = 0x38
+		i++
+		if m.DropInvalid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CommitTs != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.CommitTs))
+	}
 	return i, nil
 }
 
@@ -3995,21 +5276,21 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.Mutations.Size()))
-		n20, err := m.Mutations.MarshalTo(dAtA[i:])
+		n24, err := m.Mutations.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n24
 	}
 	if m.TxnContext != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.TxnContext.Size()))
-		n21, err := m.TxnContext.MarshalTo(dAtA[i:])
+		n25, err := m.TxnContext.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n25
 	}
 	if len(m.Kv) > 0 {
 		for _, msg := range m.Kv {
@@ -4027,11 +5308,11 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.State.Size()))
-		n22, err := m.State.MarshalTo(dAtA[i:])
+		n26, err := m.State.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n26
 	}
 	if len(m.CleanPredicate) > 0 {
 		dAtA[i] = 0x32
@@ -4039,6 +5320,26 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintInternal(dAtA, i, uint64(len(m.CleanPredicate)))
 		i += copy(dAtA[i:], m.CleanPredicate)
 	}
+	if m.Rename != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Rename.Size()))
+		n27, err := m.Rename.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.Split != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Split.Size()))
+		n28, err := m.Split.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
 	return i, nil
 }
 
@@ -4207,6 +5508,11 @@ func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExpiresAt))
+	}
 	if m.Op != 0 {
 		dAtA[i] = 0x60
 		i++
@@ -4484,11 +5790,11 @@ func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.Func.Size()))
-		n23, err := m.Func.MarshalTo(dAtA[i:])
+		n29, err := m.Func.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n29
 	}
 	return i, nil
 }
@@ -4642,6 +5948,90 @@ func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Constraint != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Constraint.Size()))
+		n30, err := m.Constraint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Ttl))
+	}
+	return i, nil
+}
+
+func (m *Constraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Constraint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.HasMin {
+		dAtA[i] = 0x8
+		i++
+		if m.HasMin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Min != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(math.Float64bits(float64(m.Min))))
+	}
+	if m.HasMax {
+		dAtA[i] = 0x18
+		i++
+		if m.HasMax {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(math.Float64bits(float64(m.Max))))
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if len(m.Enum) > 0 {
+		for _, s := range m.Enum {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -4675,16 +6065,16 @@ func (m *MapEntry) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.Posting.Size()))
-		n24, err := m.Posting.MarshalTo(dAtA[i:])
+		n31, err := m.Posting.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n31
 	}
 	return i, nil
 }
 
-func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
+func (m *RenamePredicatePayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4694,7 +6084,7 @@ func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *RenamePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4705,30 +6095,36 @@ func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
 		i += copy(dAtA[i:], m.Predicate)
 	}
-	if m.SourceGroupId != 0 {
-		dAtA[i] = 0x10
+	if len(m.NewName) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(m.SourceGroupId))
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.NewName)))
+		i += copy(dAtA[i:], m.NewName)
 	}
-	if m.DestGroupId != 0 {
+	if m.GroupId != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(m.DestGroupId))
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
 	}
 	if m.State != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.State.Size()))
-		n25, err := m.State.MarshalTo(dAtA[i:])
+		n32, err := m.State.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n32
+	}
+	if m.StartTs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.StartTs))
 	}
 	return i, nil
 }
 
-func (m *ExportPayload) Marshal() (dAtA []byte, err error) {
+func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4738,40 +6134,175 @@ func (m *ExportPayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExportPayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ReqId != 0 {
-		dAtA[i] = 0x8
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(m.ReqId))
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	if m.GroupId != 0 {
+	if m.SourceGroupId != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
+		i = encodeVarintInternal(dAtA, i, uint64(m.SourceGroupId))
 	}
-	if m.Status != 0 {
+	if m.DestGroupId != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(m.Status))
+		i = encodeVarintInternal(dAtA, i, uint64(m.DestGroupId))
 	}
-	if m.ReadTs != 0 {
-		dAtA[i] = 0x20
+	if m.State != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(m.ReadTs))
+		i = encodeVarintInternal(dAtA, i, uint64(m.State.Size()))
+		n33, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	return i, nil
-}
-
-func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.StartUid != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(m.StartUid))
+	}
+	if m.EndUid != 0 {
+		dAtA[i] = 0x31
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(m.EndUid))
+	}
+	if len(m.ResumeKey) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ResumeKey)))
+		i += copy(dAtA[i:], m.ResumeKey)
+	}
+	if m.Rate != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Rate))
+	}
+	if m.Attempt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Attempt))
+	}
+	return i, nil
+}
+
+func (m *ExportPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportPayload) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ReqId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.ReqId))
+	}
+	if m.GroupId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Status))
+	}
+	if m.ReadTs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.ReadTs))
+	}
+	if len(m.Backup) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Backup)))
+		i += copy(dAtA[i:], m.Backup)
+	}
+	if m.SinceTs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.SinceTs))
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Dir) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Dir)))
+		i += copy(dAtA[i:], m.Dir)
+	}
+	if len(m.Files) > 0 {
+		for _, s := range m.Files {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Counts) > 0 {
+		for _, msg := range m.Counts {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -4797,21 +6328,21 @@ func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Aborts) > 0 {
-		dAtA27 := make([]byte, len(m.Aborts)*10)
-		var j26 int
+		dAtA30 := make([]byte, len(m.Aborts)*10)
+		var j29 int
 		for _, num := range m.Aborts {
 			for num >= 1<<7 {
-				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j26++
+				j29++
 			}
-			dAtA27[j26] = uint8(num)
-			j26++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(j26))
-		i += copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintInternal(dAtA, i, uint64(j29))
+		i += copy(dAtA[i:], dAtA30[:j29])
 	}
 	if m.MaxPending != 0 {
 		dAtA[i] = 0x18
@@ -4837,21 +6368,21 @@ func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Ts) > 0 {
-		dAtA29 := make([]byte, len(m.Ts)*10)
-		var j28 int
+		dAtA32 := make([]byte, len(m.Ts)*10)
+		var j31 int
 		for _, num := range m.Ts {
 			for num >= 1<<7 {
-				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j28++
+				j31++
 			}
-			dAtA29[j28] = uint8(num)
-			j28++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintInternal(dAtA, i, uint64(j28))
-		i += copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintInternal(dAtA, i, uint64(j31))
+		i += copy(dAtA[i:], dAtA32[:j31])
 	}
 	return i, nil
 }
@@ -4879,727 +6410,838 @@ func (m *Num) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Internal(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Internal(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintInternal(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExportCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *List) Size() (n int) {
+
+func (m *ExportCount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		n += 1 + sovInternal(uint64(len(m.Uids)*8)) + len(m.Uids)*8
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	return n
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
 }
 
-func (m *TaskValue) Size() (n int) {
+func (m *TabletSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TabletSplit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.StartUid != 0 {
+		dAtA[i] = 0x9
+		i++
+		i = encodeFixed64Internal(dAtA, i, uint64(m.StartUid))
 	}
-	if m.ValType != 0 {
-		n += 1 + sovInternal(uint64(m.ValType))
+	if m.GroupId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
 	}
-	return n
+	return i, nil
 }
 
-func (m *SrcFunction) Size() (n int) {
+func (m *SplitPredicatePayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPredicatePayload) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
+	if m.GroupId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
+	}
+	if m.Tablet != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Tablet.Size()))
+		n34, err := m.Tablet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n34
 	}
-	if m.IsCount {
-		n += 2
+	if m.ReadTs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.ReadTs))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Query) Size() (n int) {
+func (m *RebalanceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
+	if m.Paused {
+		dAtA[i] = 0x8
+		i++
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.AfterUid != 0 {
-		n += 9
-	}
-	if m.DoCount {
-		n += 2
-	}
-	if m.UidList != nil {
-		l = m.UidList.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.SrcFunc != nil {
-		l = m.SrcFunc.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.FacetParam != nil {
-		l = m.FacetParam.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.FacetsFilter != nil {
-		l = m.FacetsFilter.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.ExpandAll {
-		n += 2
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovInternal(uint64(m.ReadTs))
-	}
-	if m.LinRead != nil {
-		l = m.LinRead.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return i, nil
+}
+
+func (m *MoveTabletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValueList) Size() (n int) {
+func (m *MoveTabletRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	return n
+	if m.GroupId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
+	}
+	return i, nil
 }
 
-func (m *LangList) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Lang) > 0 {
-		for _, s := range m.Lang {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
-		}
+func (m *TabletMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Result) Size() (n int) {
+func (m *TabletMove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if len(m.ValueMatrix) > 0 {
-		for _, e := range m.ValueMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Id))
 	}
-	if len(m.Counts) > 0 {
-		l = 0
-		for _, e := range m.Counts {
-			l += sovInternal(uint64(e))
-		}
-		n += 1 + sovInternal(uint64(l)) + l
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	if m.IntersectDest {
-		n += 2
+	if m.SrcGroup != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.SrcGroup))
 	}
-	if len(m.FacetMatrix) > 0 {
-		for _, e := range m.FacetMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if m.DstGroup != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.DstGroup))
 	}
-	if len(m.LangMatrix) > 0 {
-		for _, e := range m.LangMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
+	if m.Manual {
+		dAtA[i] = 0x28
+		i++
+		if m.Manual {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.LinRead != nil {
-		l = m.LinRead.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
 	}
-	return n
-}
-
-func (m *Order) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
-	if m.Desc {
-		n += 2
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.StartedAt))
 	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if m.FinishedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.FinishedAt))
 	}
-	return n
+	return i, nil
 }
 
-func (m *SortMessage) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Order) > 0 {
-		for _, e := range m.Order {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if m.Count != 0 {
-		n += 1 + sovInternal(uint64(m.Count))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovInternal(uint64(m.Offset))
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovInternal(uint64(m.ReadTs))
-	}
-	if m.LinRead != nil {
-		l = m.LinRead.Size()
-		n += 1 + l + sovInternal(uint64(l))
+func (m *TabletMoves) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SortResult) Size() (n int) {
+func (m *TabletMoves) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
+	if len(m.Moves) > 0 {
+		for _, msg := range m.Moves {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.LinRead != nil {
-		l = m.LinRead.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return i, nil
+}
+
+func (m *TabletPin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RaftContext) Size() (n int) {
+func (m *TabletPin) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 9
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	if m.Group != 0 {
-		n += 1 + sovInternal(uint64(m.Group))
+	if m.Pinned {
+		dAtA[i] = 0x10
+		i++
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return i, nil
+}
+
+func (m *MoveProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Member) Size() (n int) {
+func (m *MoveProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 9
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovInternal(uint64(m.GroupId))
+	if m.DstGroup != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.DstGroup))
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.LastKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.LastKey)))
+		i += copy(dAtA[i:], m.LastKey)
 	}
-	if m.Leader {
-		n += 2
+	if m.Keys != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Keys))
 	}
-	if m.AmDead {
-		n += 2
+	if m.Bytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Bytes))
 	}
-	if m.LastUpdate != 0 {
-		n += 1 + sovInternal(uint64(m.LastUpdate))
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.StartedAt))
 	}
-	if m.ClusterInfoOnly {
-		n += 2
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.UpdatedAt))
 	}
-	return n
+	if m.Attempts != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Attempts))
+	}
+	if m.Attempt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Attempt))
+	}
+	return i, nil
 }
 
-func (m *Group) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovInternal(uint64(l))
-			}
-			mapEntrySize := 1 + sovInternal(uint64(k)) + l
-			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Tablets) > 0 {
-		for k, v := range m.Tablets {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovInternal(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovInternal(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
-		}
+func (m *TsMark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ZeroProposal) Size() (n int) {
+func (m *TsMark) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovInternal(uint64(m.Id))
-	}
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Tablet != nil {
-		l = m.Tablet.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.MaxLeaseId != 0 {
-		n += 1 + sovInternal(uint64(m.MaxLeaseId))
-	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovInternal(uint64(m.MaxTxnTs))
-	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovInternal(uint64(m.MaxRaftId))
+	if m.Unix != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Unix))
 	}
-	if m.Txn != nil {
-		l = m.Txn.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.Ts != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Ts))
 	}
-	return n
+	return i, nil
 }
 
-func (m *MembershipState) Size() (n int) {
+func encodeFixed64Internal(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Internal(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintInternal(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *List) Size() (n int) {
 	var l int
 	_ = l
-	if m.Counter != 0 {
-		n += 1 + sovInternal(uint64(m.Counter))
-	}
-	if len(m.Groups) > 0 {
-		for k, v := range m.Groups {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovInternal(uint64(l))
-			}
-			mapEntrySize := 1 + sovInternal(uint64(k)) + l
-			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Zeros) > 0 {
-		for k, v := range m.Zeros {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovInternal(uint64(l))
-			}
-			mapEntrySize := 1 + sovInternal(uint64(k)) + l
-			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
-		}
-	}
-	if m.MaxLeaseId != 0 {
-		n += 1 + sovInternal(uint64(m.MaxLeaseId))
-	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovInternal(uint64(m.MaxTxnTs))
-	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovInternal(uint64(m.MaxRaftId))
-	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if len(m.Uids) > 0 {
+		n += 1 + sovInternal(uint64(len(m.Uids)*8)) + len(m.Uids)*8
 	}
 	return n
 }
 
-func (m *ConnectionState) Size() (n int) {
+func (m *TaskValue) Size() (n int) {
 	var l int
 	_ = l
-	if m.Member != nil {
-		l = m.Member.Size()
+	l = len(m.Val)
+	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ValType != 0 {
+		n += 1 + sovInternal(uint64(m.ValType))
 	}
 	return n
 }
 
-func (m *Tablet) Size() (n int) {
+func (m *SrcFunction) Size() (n int) {
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovInternal(uint64(m.GroupId))
-	}
-	l = len(m.Predicate)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Force {
-		n += 2
-	}
-	if m.ReadOnly {
-		n += 2
-	}
-	if m.Space != 0 {
-		n += 1 + sovInternal(uint64(m.Space))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
-	if m.Remove {
+	if m.IsCount {
 		n += 2
 	}
 	return n
 }
 
-func (m *DirectedEdge) Size() (n int) {
+func (m *Query) Size() (n int) {
 	var l int
 	_ = l
-	if m.Entity != 0 {
-		n += 9
-	}
 	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	l = len(m.Value)
-	if l > 0 {
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.AfterUid != 0 {
+		n += 9
+	}
+	if m.DoCount {
+		n += 2
+	}
+	if m.UidList != nil {
+		l = m.UidList.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.ValueType != 0 {
-		n += 1 + sovInternal(uint64(m.ValueType))
+	if m.SrcFunc != nil {
+		l = m.SrcFunc.Size()
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.ValueId != 0 {
-		n += 9
+	if m.Reverse {
+		n += 2
 	}
-	l = len(m.Label)
-	if l > 0 {
+	if m.FacetParam != nil {
+		l = m.FacetParam.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	l = len(m.Lang)
-	if l > 0 {
+	if m.FacetsFilter != nil {
+		l = m.FacetsFilter.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Op != 0 {
-		n += 1 + sovInternal(uint64(m.Op))
+	if m.ExpandAll {
+		n += 2
 	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if m.ReadTs != 0 {
+		n += 1 + sovInternal(uint64(m.ReadTs))
 	}
-	return n
-}
+	if m.LinRead != nil {
+		l = m.LinRead.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.IncludeCached {
+		n += 2
+	}
+	return n
+}
 
-func (m *Mutations) Size() (n int) {
+func (m *ValueList) Size() (n int) {
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovInternal(uint64(m.GroupId))
-	}
-	if m.StartTs != 0 {
-		n += 1 + sovInternal(uint64(m.StartTs))
-	}
-	if len(m.Edges) > 0 {
-		for _, e := range m.Edges {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if m.DropAll {
-		n += 2
-	}
-	if m.IgnoreIndexConflict {
-		n += 2
-	}
 	return n
 }
 
-func (m *KeyValues) Size() (n int) {
+func (m *LangList) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Kv) > 0 {
-		for _, e := range m.Kv {
-			l = e.Size()
+	if len(m.Lang) > 0 {
+		for _, s := range m.Lang {
+			l = len(s)
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *Result) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovInternal(uint64(m.Id))
-	}
-	if m.Mutations != nil {
-		l = m.Mutations.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.TxnContext != nil {
-		l = m.TxnContext.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
-	if len(m.Kv) > 0 {
-		for _, e := range m.Kv {
+	if len(m.ValueMatrix) > 0 {
+		for _, e := range m.ValueMatrix {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
 	}
-	l = len(m.CleanPredicate)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.IntersectDest {
+		n += 2
 	}
-	return n
-}
-
-func (m *KV) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.FacetMatrix) > 0 {
+		for _, e := range m.FacetMatrix {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.LangMatrix) > 0 {
+		for _, e := range m.LangMatrix {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
-	l = len(m.UserMeta)
-	if l > 0 {
+	if m.LinRead != nil {
+		l = m.LinRead.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Version != 0 {
-		n += 1 + sovInternal(uint64(m.Version))
-	}
 	return n
 }
 
-func (m *KC) Size() (n int) {
+func (m *Order) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovInternal(uint64(m.Timestamp))
-	}
-	return n
-}
-
-func (m *GroupKeys) Size() (n int) {
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovInternal(uint64(m.GroupId))
+	if m.Desc {
+		n += 2
 	}
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *Posting) Size() (n int) {
+func (m *SortMessage) Size() (n int) {
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		n += 9
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovInternal(uint64(m.ValType))
-	}
-	if m.PostingType != 0 {
-		n += 1 + sovInternal(uint64(m.PostingType))
-	}
-	l = len(m.LangTag)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Order) > 0 {
+		for _, e := range m.Order {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if m.Op != 0 {
-		n += 1 + sovInternal(uint64(m.Op))
+	if m.Count != 0 {
+		n += 1 + sovInternal(uint64(m.Count))
 	}
-	if m.StartTs != 0 {
-		n += 1 + sovInternal(uint64(m.StartTs))
+	if m.Offset != 0 {
+		n += 1 + sovInternal(uint64(m.Offset))
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovInternal(uint64(m.CommitTs))
+	if m.ReadTs != 0 {
+		n += 1 + sovInternal(uint64(m.ReadTs))
+	}
+	if m.LinRead != nil {
+		l = m.LinRead.Size()
+		n += 1 + l + sovInternal(uint64(l))
 	}
 	return n
 }
 
-func (m *PostingList) Size() (n int) {
+func (m *SortResult) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Postings) > 0 {
-		for _, e := range m.Postings {
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	l = len(m.Checksum)
-	if l > 0 {
+	if m.LinRead != nil {
+		l = m.LinRead.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Commit != 0 {
-		n += 1 + sovInternal(uint64(m.Commit))
+	return n
+}
+
+func (m *RaftContext) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 9
 	}
-	l = len(m.Uids)
+	if m.Group != 0 {
+		n += 1 + sovInternal(uint64(m.Group))
+	}
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
 	return n
 }
 
-func (m *FacetParam) Size() (n int) {
+func (m *Member) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.Id != 0 {
+		n += 9
 	}
-	l = len(m.Alias)
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.Leader {
+		n += 2
+	}
+	if m.AmDead {
+		n += 2
+	}
+	if m.LastUpdate != 0 {
+		n += 1 + sovInternal(uint64(m.LastUpdate))
+	}
+	if m.ClusterInfoOnly {
+		n += 2
+	}
 	return n
 }
 
-func (m *FacetParams) Size() (n int) {
+func (m *Group) Size() (n int) {
 	var l int
 	_ = l
-	if m.AllKeys {
-		n += 2
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovInternal(uint64(l))
+			}
+			mapEntrySize := 1 + sovInternal(uint64(k)) + l
+			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
+		}
 	}
-	if len(m.Param) > 0 {
-		for _, e := range m.Param {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
+	if len(m.Tablets) > 0 {
+		for k, v := range m.Tablets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovInternal(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovInternal(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
 		}
 	}
 	return n
 }
 
-func (m *Facets) Size() (n int) {
+func (m *ZeroProposal) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Tablet != nil {
+		l = m.Tablet.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.MaxLeaseId != 0 {
+		n += 1 + sovInternal(uint64(m.MaxLeaseId))
+	}
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovInternal(uint64(m.MaxTxnTs))
+	}
+	if m.MaxRaftId != 0 {
+		n += 1 + sovInternal(uint64(m.MaxRaftId))
+	}
+	if m.Txn != nil {
+		l = m.Txn.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Tablets) > 0 {
+		for _, e := range m.Tablets {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	if m.Rebalance != nil {
+		l = m.Rebalance.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Pin != nil {
+		l = m.Pin.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.MoveProgress != nil {
+		l = m.MoveProgress.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.TabletMove != nil {
+		l = m.TabletMove.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.TsMark != nil {
+		l = m.TsMark.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
-func (m *FacetsList) Size() (n int) {
+func (m *MembershipState) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.FacetsList) > 0 {
-		for _, e := range m.FacetsList {
+	if m.Counter != 0 {
+		n += 1 + sovInternal(uint64(m.Counter))
+	}
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovInternal(uint64(l))
+			}
+			mapEntrySize := 1 + sovInternal(uint64(k)) + l
+			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Zeros) > 0 {
+		for k, v := range m.Zeros {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovInternal(uint64(l))
+			}
+			mapEntrySize := 1 + sovInternal(uint64(k)) + l
+			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxLeaseId != 0 {
+		n += 1 + sovInternal(uint64(m.MaxLeaseId))
+	}
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovInternal(uint64(m.MaxTxnTs))
+	}
+	if m.MaxRaftId != 0 {
+		n += 1 + sovInternal(uint64(m.MaxRaftId))
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.RebalancePaused {
+		n += 2
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.TsMarks) > 0 {
+		for _, e := range m.TsMarks {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
@@ -5607,72 +7249,148 @@ func (m *FacetsList) Size() (n int) {
 	return n
 }
 
-func (m *Function) Size() (n int) {
+func (m *ConnectionState) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
+	if m.Member != nil {
+		l = m.Member.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	l = len(m.Key)
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	return n
+}
+
+func (m *Tablet) Size() (n int) {
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	l = len(m.Predicate)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
+	if m.Force {
+		n += 2
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.Space != 0 {
+		n += 1 + sovInternal(uint64(m.Space))
+	}
+	if m.Remove {
+		n += 2
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	if m.ReadQps != 0 {
+		n += 9
+	}
+	if m.WriteQps != 0 {
+		n += 9
+	}
+	if m.Pinned {
+		n += 2
+	}
+	if m.Move != nil {
+		l = m.Move.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
-func (m *FilterTree) Size() (n int) {
+func (m *DirectedEdge) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Op)
+	if m.Entity != 0 {
+		n += 9
+	}
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if len(m.Children) > 0 {
-		for _, e := range m.Children {
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovInternal(uint64(m.ValueType))
+	}
+	if m.ValueId != 0 {
+		n += 9
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovInternal(uint64(m.Op))
+	}
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if m.Func != nil {
-		l = m.Func.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ExpiresAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExpiresAt))
 	}
 	return n
 }
 
-func (m *SchemaRequest) Size() (n int) {
+func (m *Mutations) Size() (n int) {
 	var l int
 	_ = l
 	if m.GroupId != 0 {
 		n += 1 + sovInternal(uint64(m.GroupId))
 	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
+	if m.StartTs != 0 {
+		n += 1 + sovInternal(uint64(m.StartTs))
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	if m.DropAll {
+		n += 2
+	}
+	if m.IgnoreIndexConflict {
+		n += 2
+	}
+	if m.DropInvalid {
+		n += 2
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovInternal(uint64(m.CommitTs))
+	}
 	return n
 }
 
-func (m *SchemaResult) Size() (n int) {
+func (m *KeyValues) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
@@ -5680,195 +7398,3578 @@ func (m *SchemaResult) Size() (n int) {
 	return n
 }
 
-func (m *SchemaUpdate) Size() (n int) {
+func (m *Proposal) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
 	}
-	if m.ValueType != 0 {
-		n += 1 + sovInternal(uint64(m.ValueType))
+	if m.Mutations != nil {
+		l = m.Mutations.Size()
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Directive != 0 {
-		n += 1 + sovInternal(uint64(m.Directive))
+	if m.TxnContext != nil {
+		l = m.TxnContext.Size()
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
-			l = len(s)
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
+			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if m.Count {
-		n += 2
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.List {
-		n += 2
+	l = len(m.CleanPredicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Rename != nil {
+		l = m.Rename.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovInternal(uint64(l))
 	}
 	return n
 }
 
-func (m *MapEntry) Size() (n int) {
+func (m *KV) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Uid != 0 {
-		n += 9
+	l = len(m.Val)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Posting != nil {
-		l = m.Posting.Size()
+	l = len(m.UserMeta)
+	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovInternal(uint64(m.Version))
+	}
 	return n
 }
 
-func (m *MovePredicatePayload) Size() (n int) {
+func (m *KC) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Predicate)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.SourceGroupId != 0 {
-		n += 1 + sovInternal(uint64(m.SourceGroupId))
+	if m.Timestamp != 0 {
+		n += 1 + sovInternal(uint64(m.Timestamp))
 	}
-	if m.DestGroupId != 0 {
-		n += 1 + sovInternal(uint64(m.DestGroupId))
+	return n
+}
+
+func (m *GroupKeys) Size() (n int) {
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *ExportPayload) Size() (n int) {
+func (m *Posting) Size() (n int) {
 	var l int
 	_ = l
-	if m.ReqId != 0 {
-		n += 1 + sovInternal(uint64(m.ReqId))
+	if m.Uid != 0 {
+		n += 9
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovInternal(uint64(m.GroupId))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovInternal(uint64(m.Status))
+	if m.ValType != 0 {
+		n += 1 + sovInternal(uint64(m.ValType))
 	}
-	if m.ReadTs != 0 {
-		n += 1 + sovInternal(uint64(m.ReadTs))
+	if m.PostingType != 0 {
+		n += 1 + sovInternal(uint64(m.PostingType))
+	}
+	l = len(m.LangTag)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExpiresAt))
+	}
+	if m.Op != 0 {
+		n += 1 + sovInternal(uint64(m.Op))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovInternal(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovInternal(uint64(m.CommitTs))
 	}
 	return n
 }
 
-func (m *OracleDelta) Size() (n int) {
+func (m *PostingList) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for k, v := range m.Commits {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovInternal(uint64(k)) + 1 + sovInternal(uint64(v))
-			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
+	if len(m.Postings) > 0 {
+		for _, e := range m.Postings {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
-	if len(m.Aborts) > 0 {
-		l = 0
-		for _, e := range m.Aborts {
-			l += sovInternal(uint64(e))
-		}
-		n += 1 + sovInternal(uint64(l)) + l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
 	}
-	if m.MaxPending != 0 {
-		n += 1 + sovInternal(uint64(m.MaxPending))
+	if m.Commit != 0 {
+		n += 1 + sovInternal(uint64(m.Commit))
+	}
+	l = len(m.Uids)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
 	}
 	return n
 }
 
-func (m *TxnTimestamps) Size() (n int) {
+func (m *FacetParam) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Ts) > 0 {
-		l = 0
-		for _, e := range m.Ts {
-			l += sovInternal(uint64(e))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	return n
+}
+
+func (m *FacetParams) Size() (n int) {
+	var l int
+	_ = l
+	if m.AllKeys {
+		n += 2
+	}
+	if len(m.Param) > 0 {
+		for _, e := range m.Param {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
 		}
-		n += 1 + sovInternal(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *Num) Size() (n int) {
+func (m *Facets) Size() (n int) {
 	var l int
 	_ = l
-	if m.Val != 0 {
-		n += 1 + sovInternal(uint64(m.Val))
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
 	}
 	return n
 }
 
-func sovInternal(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *FacetsList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.FacetsList) > 0 {
+		for _, e := range m.FacetsList {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
 	return n
 }
-func sozInternal(x uint64) (n int) {
-	return sovInternal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Function) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
 }
-func (m *List) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *FilterTree) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: List: wiretype end group for non-group")
+	}
+	if m.Func != nil {
+		l = m.Func.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
+	}
+	return n
+}
+
+func (m *SchemaResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchemaUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovInternal(uint64(m.ValueType))
+	}
+	if m.Directive != 0 {
+		n += 1 + sovInternal(uint64(m.Directive))
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Constraint != nil {
+		l = m.Constraint.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovInternal(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *Constraint) Size() (n int) {
+	var l int
+	_ = l
+	if m.HasMin {
+		n += 2
+	}
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.HasMax {
+		n += 2
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Enum) > 0 {
+		for _, s := range m.Enum {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MapEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Uid != 0 {
+		n += 9
+	}
+	if m.Posting != nil {
+		l = m.Posting.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	return n
+}
+
+func (m *RenamePredicatePayload) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovInternal(uint64(m.StartTs))
+	}
+	return n
+}
+
+func (m *MovePredicatePayload) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SourceGroupId != 0 {
+		n += 1 + sovInternal(uint64(m.SourceGroupId))
+	}
+	if m.DestGroupId != 0 {
+		n += 1 + sovInternal(uint64(m.DestGroupId))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.StartUid != 0 {
+		n += 9
+	}
+	if m.EndUid != 0 {
+		n += 9
+	}
+	l = len(m.ResumeKey)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovInternal(uint64(m.Rate))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovInternal(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *ExportPayload) Size() (n int) {
+	var l int
+	_ = l
+	if m.ReqId != 0 {
+		n += 1 + sovInternal(uint64(m.ReqId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovInternal(uint64(m.Status))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovInternal(uint64(m.ReadTs))
+	}
+	l = len(m.Backup)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovInternal(uint64(m.SinceTs))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, s := range m.Files {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleDelta) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for k, v := range m.Commits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovInternal(uint64(k)) + 1 + sovInternal(uint64(v))
+			n += mapEntrySize + 1 + sovInternal(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Aborts) > 0 {
+		l = 0
+		for _, e := range m.Aborts {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.MaxPending != 0 {
+		n += 1 + sovInternal(uint64(m.MaxPending))
+	}
+	return n
+}
+
+func (m *TxnTimestamps) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ts) > 0 {
+		l = 0
+		for _, e := range m.Ts {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Num) Size() (n int) {
+	var l int
+	_ = l
+	if m.Val != 0 {
+		n += 1 + sovInternal(uint64(m.Val))
+	}
+	return n
+}
+
+func (m *ExportCount) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovInternal(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *TabletSplit) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartUid != 0 {
+		n += 9
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *SplitPredicatePayload) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	if m.Tablet != nil {
+		l = m.Tablet.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovInternal(uint64(m.ReadTs))
+	}
+	return n
+}
+
+func (m *RebalanceState) Size() (n int) {
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MoveTabletRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *TabletMove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SrcGroup != 0 {
+		n += 1 + sovInternal(uint64(m.SrcGroup))
+	}
+	if m.DstGroup != 0 {
+		n += 1 + sovInternal(uint64(m.DstGroup))
+	}
+	if m.Manual {
+		n += 2
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovInternal(uint64(m.StartedAt))
+	}
+	if m.FinishedAt != 0 {
+		n += 1 + sovInternal(uint64(m.FinishedAt))
+	}
+	return n
+}
+
+func (m *TabletMoves) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TabletPin) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Pinned {
+		n += 2
+	}
+	return n
+}
+
+func (m *MoveProgress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DstGroup != 0 {
+		n += 1 + sovInternal(uint64(m.DstGroup))
+	}
+	l = len(m.LastKey)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovInternal(uint64(m.Keys))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovInternal(uint64(m.Bytes))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovInternal(uint64(m.StartedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovInternal(uint64(m.UpdatedAt))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovInternal(uint64(m.Attempts))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovInternal(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *TsMark) Size() (n int) {
+	var l int
+	_ = l
+	if m.Unix != 0 {
+		n += 1 + sovInternal(uint64(m.Unix))
+	}
+	if m.Ts != 0 {
+		n += 1 + sovInternal(uint64(m.Ts))
+	}
+	return n
+}
+
+func sovInternal(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozInternal(x uint64) (n int) {
+	return sovInternal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(dAtA[iNdEx-8])
+				v |= uint64(dAtA[iNdEx-7]) << 8
+				v |= uint64(dAtA[iNdEx-6]) << 16
+				v |= uint64(dAtA[iNdEx-5]) << 24
+				v |= uint64(dAtA[iNdEx-4]) << 32
+				v |= uint64(dAtA[iNdEx-3]) << 40
+				v |= uint64(dAtA[iNdEx-2]) << 48
+				v |= uint64(dAtA[iNdEx-1]) << 56
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(dAtA[iNdEx-8])
+					v |= uint64(dAtA[iNdEx-7]) << 8
+					v |= uint64(dAtA[iNdEx-6]) << 16
+					v |= uint64(dAtA[iNdEx-5]) << 24
+					v |= uint64(dAtA[iNdEx-4]) << 32
+					v |= uint64(dAtA[iNdEx-3]) << 40
+					v |= uint64(dAtA[iNdEx-2]) << 48
+					v |= uint64(dAtA[iNdEx-1]) << 56
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCount = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			}
+			m.AfterUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.AfterUid = uint64(dAtA[iNdEx-8])
+			m.AfterUid |= uint64(dAtA[iNdEx-7]) << 8
+			m.AfterUid |= uint64(dAtA[iNdEx-6]) << 16
+			m.AfterUid |= uint64(dAtA[iNdEx-5]) << 24
+			m.AfterUid |= uint64(dAtA[iNdEx-4]) << 32
+			m.AfterUid |= uint64(dAtA[iNdEx-3]) << 40
+			m.AfterUid |= uint64(dAtA[iNdEx-2]) << 48
+			m.AfterUid |= uint64(dAtA[iNdEx-1]) << 56
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UidList == nil {
+				m.UidList = &List{}
+			}
+			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SrcFunc == nil {
+				m.SrcFunc = &SrcFunction{}
+			}
+			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetParam == nil {
+				m.FacetParam = &FacetParams{}
+			}
+			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetsFilter == nil {
+				m.FacetsFilter = &FilterTree{}
+			}
+			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpandAll = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeCached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeCached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LangList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
+			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntersectDest = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
+			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangMatrix = append(m.LangMatrix, &LangList{})
+			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = append(m.Order, &Order{})
+			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Id = uint64(dAtA[iNdEx-8])
+			m.Id |= uint64(dAtA[iNdEx-7]) << 8
+			m.Id |= uint64(dAtA[iNdEx-6]) << 16
+			m.Id |= uint64(dAtA[iNdEx-5]) << 24
+			m.Id |= uint64(dAtA[iNdEx-4]) << 32
+			m.Id |= uint64(dAtA[iNdEx-3]) << 40
+			m.Id |= uint64(dAtA[iNdEx-2]) << 48
+			m.Id |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Id = uint64(dAtA[iNdEx-8])
+			m.Id |= uint64(dAtA[iNdEx-7]) << 8
+			m.Id |= uint64(dAtA[iNdEx-6]) << 16
+			m.Id |= uint64(dAtA[iNdEx-5]) << 24
+			m.Id |= uint64(dAtA[iNdEx-4]) << 32
+			m.Id |= uint64(dAtA[iNdEx-3]) << 40
+			m.Id |= uint64(dAtA[iNdEx-2]) << 48
+			m.Id |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AmDead = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterInfoOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Group) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Members == nil {
+				m.Members = make(map[uint64]*Member)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Member{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Members[mapkey] = mapvalue
+			} else {
+				var mapvalue *Member
+				m.Members[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Tablets == nil {
+				m.Tablets = make(map[string]*Tablet)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Tablet{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Tablets[mapkey] = mapvalue
+			} else {
+				var mapvalue *Tablet
+				m.Tablets[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
+			}
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			}
+			m.MaxLeaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			}
+			m.MaxTxnTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			}
+			m.MaxRaftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Txn == nil {
+				m.Txn = &api.TxnContext{}
+			}
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tablets = append(m.Tablets, &Tablet{})
+			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rebalance == nil {
+				m.Rebalance = &RebalanceState{}
+			}
+			if err := m.Rebalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pin == nil {
+				m.Pin = &TabletPin{}
+			}
+			if err := m.Pin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MoveProgress == nil {
+				m.MoveProgress = &MoveProgress{}
+			}
+			if err := m.MoveProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletMove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TabletMove == nil {
+				m.TabletMove = &TabletMove{}
+			}
+			if err := m.TabletMove.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsMark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TsMark == nil {
+				m.TsMark = &TsMark{}
+			}
+			if err := m.TsMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Group{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Groups[mapkey] = mapvalue
+			} else {
+				var mapvalue *Group
+				m.Groups[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Zeros == nil {
+				m.Zeros = make(map[uint64]*Member)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += 8
-				v = uint64(dAtA[iNdEx-8])
-				v |= uint64(dAtA[iNdEx-7]) << 8
-				v |= uint64(dAtA[iNdEx-6]) << 16
-				v |= uint64(dAtA[iNdEx-5]) << 24
-				v |= uint64(dAtA[iNdEx-4]) << 32
-				v |= uint64(dAtA[iNdEx-3]) << 40
-				v |= uint64(dAtA[iNdEx-2]) << 48
-				v |= uint64(dAtA[iNdEx-1]) << 56
-				m.Uids = append(m.Uids, v)
-			} else if wireType == 2 {
-				var packedLen int
+				var mapmsglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowInternal
@@ -5878,37 +10979,202 @@ func (m *List) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					mapmsglen |= (int(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
+				if mapmsglen < 0 {
 					return ErrInvalidLengthInternal
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = uint64(dAtA[iNdEx-8])
-					v |= uint64(dAtA[iNdEx-7]) << 8
-					v |= uint64(dAtA[iNdEx-6]) << 16
-					v |= uint64(dAtA[iNdEx-5]) << 24
-					v |= uint64(dAtA[iNdEx-4]) << 32
-					v |= uint64(dAtA[iNdEx-3]) << 40
-					v |= uint64(dAtA[iNdEx-2]) << 48
-					v |= uint64(dAtA[iNdEx-1]) << 56
-					m.Uids = append(m.Uids, v)
+				mapvalue := &Member{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
 				}
+				iNdEx = postmsgIndex
+				m.Zeros[mapkey] = mapvalue
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				var mapvalue *Member
+				m.Zeros[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			}
+			m.MaxLeaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			}
+			m.MaxTxnTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			}
+			m.MaxRaftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &Member{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancePaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RebalancePaused = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, &TabletMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsMarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TsMarks = append(m.TsMarks, &TsMark{})
+			if err := m.TsMarks[len(m.TsMarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -5930,7 +11196,7 @@ func (m *List) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskValue) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5953,17 +11219,17 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -5973,28 +11239,30 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.ValType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6004,11 +11272,25 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6030,7 +11312,7 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+func (m *Tablet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6053,17 +11335,17 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var stringLen uint64
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6073,24 +11355,14 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6115,11 +11387,11 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6136,62 +11408,32 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsCount = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Query) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			m.ReadOnly = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
 			}
-			var stringLen uint64
+			m.Space = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6201,26 +11443,36 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Space |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Remove = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6230,41 +11482,62 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			m.Splits = append(m.Splits, &TabletSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQps", wireType)
 			}
-			m.AfterUid = 0
+			var v uint64
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += 8
-			m.AfterUid = uint64(dAtA[iNdEx-8])
-			m.AfterUid |= uint64(dAtA[iNdEx-7]) << 8
-			m.AfterUid |= uint64(dAtA[iNdEx-6]) << 16
-			m.AfterUid |= uint64(dAtA[iNdEx-5]) << 24
-			m.AfterUid |= uint64(dAtA[iNdEx-4]) << 32
-			m.AfterUid |= uint64(dAtA[iNdEx-3]) << 40
-			m.AfterUid |= uint64(dAtA[iNdEx-2]) << 48
-			m.AfterUid |= uint64(dAtA[iNdEx-1]) << 56
-		case 4:
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ReadQps = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteQps", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.WriteQps = float64(math.Float64frombits(v))
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6281,10 +11554,10 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DoCount = bool(v != 0)
-		case 5:
+			m.Pinned = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6308,18 +11581,85 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UidList == nil {
-				m.UidList = &List{}
+			if m.Move == nil {
+				m.Move = &MoveProgress{}
 			}
-			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Move.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+			}
+			m.Entity = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Entity = uint64(dAtA[iNdEx-8])
+			m.Entity |= uint64(dAtA[iNdEx-7]) << 8
+			m.Entity |= uint64(dAtA[iNdEx-6]) << 16
+			m.Entity |= uint64(dAtA[iNdEx-5]) << 24
+			m.Entity |= uint64(dAtA[iNdEx-4]) << 32
+			m.Entity |= uint64(dAtA[iNdEx-3]) << 40
+			m.Entity |= uint64(dAtA[iNdEx-2]) << 48
+			m.Entity |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6329,30 +11669,26 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcFunc == nil {
-				m.SrcFunc = &SrcFunction{}
-			}
-			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6362,17 +11698,28 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			var msglen int
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6382,30 +11729,33 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			m.ValueId = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetParam == nil {
-				m.FacetParam = &FacetParams{}
-			}
-			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			iNdEx += 8
+			m.ValueId = uint64(dAtA[iNdEx-8])
+			m.ValueId |= uint64(dAtA[iNdEx-7]) << 8
+			m.ValueId |= uint64(dAtA[iNdEx-6]) << 16
+			m.ValueId |= uint64(dAtA[iNdEx-5]) << 24
+			m.ValueId |= uint64(dAtA[iNdEx-4]) << 32
+			m.ValueId |= uint64(dAtA[iNdEx-3]) << 40
+			m.ValueId |= uint64(dAtA[iNdEx-2]) << 48
+			m.ValueId |= uint64(dAtA[iNdEx-1]) << 56
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6415,30 +11765,26 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetsFilter == nil {
-				m.FacetsFilter = &FilterTree{}
-			}
-			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6448,17 +11794,26 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExpandAll = bool(v != 0)
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.ReadTs = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6468,14 +11823,14 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				m.Op |= (DirectedEdge_Op(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6499,68 +11854,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValueList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var msglen int
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6570,23 +11873,11 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ExpiresAt |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, &TaskValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6608,7 +11899,7 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LangList) Unmarshal(dAtA []byte) error {
+func (m *Mutations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6631,17 +11922,17 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var stringLen uint64
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6651,74 +11942,33 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Result) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6742,14 +11992,14 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, &DirectedEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6773,76 +12023,14 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
-			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schema = append(m.Schema, &SchemaUpdate{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
-			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6859,12 +12047,12 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IntersectDest = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			m.DropAll = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreIndexConflict", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6874,28 +12062,17 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
-			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+			m.IgnoreIndexConflict = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropInvalid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6905,28 +12082,17 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LangMatrix = append(m.LangMatrix, &LangList{})
-			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			m.DropInvalid = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var msglen int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6936,25 +12102,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6976,7 +12128,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *KeyValues) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6999,17 +12151,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyValues: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyValues: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7019,69 +12171,22 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Desc = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7104,7 +12209,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SortMessage) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7127,17 +12232,17 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7147,26 +12252,14 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Id |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Order = append(m.Order, &Order{})
-			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7190,16 +12283,18 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Mutations == nil {
+				m.Mutations = &Mutations{}
+			}
+			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnContext", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7209,52 +12304,28 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			if m.TxnContext == nil {
+				m.TxnContext = &api.TxnContext{}
 			}
-			m.ReadTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.TxnContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 14:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7278,66 +12349,14 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7361,16 +12380,18 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7380,97 +12401,26 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftContext) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Id = uint64(dAtA[iNdEx-8])
-			m.Id |= uint64(dAtA[iNdEx-7]) << 8
-			m.Id |= uint64(dAtA[iNdEx-6]) << 16
-			m.Id |= uint64(dAtA[iNdEx-5]) << 24
-			m.Id |= uint64(dAtA[iNdEx-4]) << 32
-			m.Id |= uint64(dAtA[iNdEx-3]) << 40
-			m.Id |= uint64(dAtA[iNdEx-2]) << 48
-			m.Id |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rename", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7480,16 +12430,30 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rename == nil {
+				m.Rename = &RenamePredicatePayload{}
+			}
+			if err := m.Rename.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7499,20 +12463,24 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.Split == nil {
+				m.Split = &SplitPredicatePayload{}
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7535,7 +12503,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *KV) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7558,34 +12526,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: KV: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KV: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Id = uint64(dAtA[iNdEx-8])
-			m.Id |= uint64(dAtA[iNdEx-7]) << 8
-			m.Id |= uint64(dAtA[iNdEx-6]) << 16
-			m.Id |= uint64(dAtA[iNdEx-5]) << 24
-			m.Id |= uint64(dAtA[iNdEx-4]) << 32
-			m.Id |= uint64(dAtA[iNdEx-3]) << 40
-			m.Id |= uint64(dAtA[iNdEx-2]) << 48
-			m.Id |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.GroupId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7595,16 +12546,28 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7614,26 +12577,28 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserMeta", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7643,56 +12608,28 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			m.AmDead = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastUpdate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdate |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.UserMeta = append(m.UserMeta[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserMeta == nil {
+				m.UserMeta = []byte{}
 			}
-		case 13:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7702,12 +12639,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterInfoOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -7729,7 +12665,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *KC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7749,131 +12685,51 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapkey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if m.Members == nil {
-				m.Members = make(map[uint64]*Member)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				if postmsgIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := &Member{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postmsgIndex
-				m.Members[mapkey] = mapvalue
-			} else {
-				var mapvalue *Member
-				m.Members[mapkey] = mapvalue
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7883,19 +12739,66 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Timestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7905,12 +12808,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7920,74 +12827,21 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Tablets == nil {
-				m.Tablets = make(map[string]*Tablet)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Tablet{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Tablets[mapkey] = mapvalue
-			} else {
-				var mapvalue *Tablet
-				m.Tablets[mapkey] = mapvalue
+			m.Keys = append(m.Keys, &KC{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -8011,7 +12865,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+func (m *Posting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8034,17 +12888,84 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Uid = uint64(dAtA[iNdEx-8])
+			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
+			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
+			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
+			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
+			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
+			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
+			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
 			}
-			m.Id = 0
+			m.PostingType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8054,16 +12975,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.PostingType |= (Posting_PostingType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8073,28 +12994,55 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
+			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.LangTag == nil {
+				m.LangTag = []byte{}
 			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8118,18 +13066,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablet == nil {
-				m.Tablet = &Tablet{}
-			}
-			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.MaxLeaseId = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8139,16 +13085,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				m.ExpiresAt |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.MaxTxnTs = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8158,16 +13104,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
+				m.Op |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.MaxRaftId = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8177,16 +13123,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var msglen int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8196,25 +13142,11 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Txn == nil {
-				m.Txn = &api.TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -8236,7 +13168,7 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
+func (m *PostingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8259,17 +13191,17 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
-			m.Counter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8279,16 +13211,28 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Counter |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8298,19 +13242,28 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8320,12 +13273,16 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.Commit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint32
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8335,71 +13292,78 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Group{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Groups[mapkey] = mapvalue
-			} else {
-				var mapvalue *Group
-				m.Groups[mapkey] = mapvalue
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uids = append(m.Uids[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uids == nil {
+				m.Uids = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8409,34 +13373,26 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			var mapkey uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8446,109 +13402,76 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Zeros == nil {
-				m.Zeros = make(map[uint64]*Member)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Member{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Zeros[mapkey] = mapvalue
-			} else {
-				var mapvalue *Member
-				m.Zeros[mapkey] = mapvalue
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxLeaseId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxTxnTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
 			}
-			m.MaxRaftId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8558,14 +13481,15 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.AllKeys = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8589,8 +13513,8 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &Member{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Param = append(m.Param, &FacetParam{})
+			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8615,7 +13539,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *Facets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8638,15 +13562,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8670,16 +13594,64 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8703,10 +13675,8 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FacetsList = append(m.FacetsList, &Facets{})
+			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8731,7 +13701,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tablet) Unmarshal(dAtA []byte) error {
+func (m *Function) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8754,34 +13724,15 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8806,13 +13757,13 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8822,37 +13773,26 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ReadOnly = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			m.Space = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8862,31 +13802,21 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Space |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Remove = bool(v != 0)
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -8908,7 +13838,7 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
+func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8931,32 +13861,15 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
-			}
-			m.Entity = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Entity = uint64(dAtA[iNdEx-8])
-			m.Entity |= uint64(dAtA[iNdEx-7]) << 8
-			m.Entity |= uint64(dAtA[iNdEx-6]) << 16
-			m.Entity |= uint64(dAtA[iNdEx-5]) << 24
-			m.Entity |= uint64(dAtA[iNdEx-4]) << 32
-			m.Entity |= uint64(dAtA[iNdEx-3]) << 40
-			m.Entity |= uint64(dAtA[iNdEx-2]) << 48
-			m.Entity |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8981,13 +13894,44 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &FilterTree{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8997,28 +13941,80 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Func == nil {
+				m.Func = &Function{}
+			}
+			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.ValueType = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9028,31 +14024,14 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
-			}
-			m.ValueId = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.ValueId = uint64(dAtA[iNdEx-8])
-			m.ValueId |= uint64(dAtA[iNdEx-7]) << 8
-			m.ValueId |= uint64(dAtA[iNdEx-6]) << 16
-			m.ValueId |= uint64(dAtA[iNdEx-5]) << 24
-			m.ValueId |= uint64(dAtA[iNdEx-4]) << 32
-			m.ValueId |= uint64(dAtA[iNdEx-3]) << 40
-			m.ValueId |= uint64(dAtA[iNdEx-2]) << 48
-			m.ValueId |= uint64(dAtA[iNdEx-1]) << 56
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9077,11 +14056,11 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9106,30 +14085,61 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= (DirectedEdge_Op(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
 			}
-		case 9:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9153,8 +14163,8 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schema = append(m.Schema, &api.SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9179,7 +14189,7 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mutations) Unmarshal(dAtA []byte) error {
+func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9202,17 +14212,17 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9222,16 +14232,26 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			m.StartTs = 0
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9241,16 +14261,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
 			}
-			var msglen int
+			m.Directive = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9260,28 +14280,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Directive |= (SchemaUpdate_Directive(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Edges = append(m.Edges, &DirectedEdge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9291,26 +14299,24 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaUpdate{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9327,10 +14333,10 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DropAll = bool(v != 0)
+			m.Count = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreIndexConflict", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9347,60 +14353,10 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IgnoreIndexConflict = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyValues) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValues: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValues: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.List = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9424,11 +14380,32 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Constraint == nil {
+				m.Constraint = &Constraint{}
+			}
+			if err := m.Constraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9450,7 +14427,7 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *Constraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9473,17 +14450,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Constraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Constraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMin", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9493,49 +14470,35 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasMin = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutations == nil {
-				m.Mutations = &Mutations{}
-			}
-			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Min = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnContext", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMax", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9545,61 +14508,35 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TxnContext == nil {
-				m.TxnContext = &api.TxnContext{}
-			}
-			if err := m.TxnContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.HasMax = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Max = float64(math.Float64frombits(v))
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9609,28 +14546,24 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9655,7 +14588,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			m.Enum = append(m.Enum, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9678,7 +14611,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KV) Unmarshal(dAtA []byte) error {
+func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9701,10 +14634,10 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KV: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KV: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9739,41 +14672,27 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthInternal
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			m.Uid = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
-			}
-			iNdEx = postIndex
+			iNdEx += 8
+			m.Uid = uint64(dAtA[iNdEx-8])
+			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
+			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
+			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
+			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
+			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
+			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
+			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9783,42 +14702,25 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserMeta = append(m.UserMeta[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserMeta == nil {
-				m.UserMeta = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if m.Posting == nil {
+				m.Posting = &Posting{}
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9840,7 +14742,7 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KC) Unmarshal(dAtA []byte) error {
+func (m *RenamePredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9863,17 +14765,17 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KC: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenamePredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenamePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9883,28 +14785,26 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9914,62 +14814,22 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupKeys) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupKeys: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupKeys: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
@@ -9988,9 +14848,9 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10014,11 +14874,32 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &KC{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10040,7 +14921,7 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Posting) Unmarshal(dAtA []byte) error {
+func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10063,34 +14944,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
+			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Uid = uint64(dAtA[iNdEx-8])
-			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
-			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
-			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
-			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
-			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
-			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
-			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10100,28 +14964,26 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupId", wireType)
 			}
-			m.ValType = 0
+			m.SourceGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10131,16 +14993,16 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				m.SourceGroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGroupId", wireType)
 			}
-			m.PostingType = 0
+			m.DestGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10150,16 +15012,16 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PostingType |= (Posting_PostingType(b) & 0x7F) << shift
+				m.DestGroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10169,57 +15031,64 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
-			if m.LangTag == nil {
-				m.LangTag = []byte{}
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.StartUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
+			iNdEx += 8
+			m.StartUid = uint64(dAtA[iNdEx-8])
+			m.StartUid |= uint64(dAtA[iNdEx-7]) << 8
+			m.StartUid |= uint64(dAtA[iNdEx-6]) << 16
+			m.StartUid |= uint64(dAtA[iNdEx-5]) << 24
+			m.StartUid |= uint64(dAtA[iNdEx-4]) << 32
+			m.StartUid |= uint64(dAtA[iNdEx-3]) << 40
+			m.StartUid |= uint64(dAtA[iNdEx-2]) << 48
+			m.StartUid |= uint64(dAtA[iNdEx-1]) << 56
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndUid", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			m.EndUid = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			iNdEx += 8
+			m.EndUid = uint64(dAtA[iNdEx-8])
+			m.EndUid |= uint64(dAtA[iNdEx-7]) << 8
+			m.EndUid |= uint64(dAtA[iNdEx-6]) << 16
+			m.EndUid |= uint64(dAtA[iNdEx-5]) << 24
+			m.EndUid |= uint64(dAtA[iNdEx-4]) << 32
+			m.EndUid |= uint64(dAtA[iNdEx-3]) << 40
+			m.EndUid |= uint64(dAtA[iNdEx-2]) << 48
+			m.EndUid |= uint64(dAtA[iNdEx-1]) << 56
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10229,47 +15098,28 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ResumeKey = append(m.ResumeKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeKey == nil {
+				m.ResumeKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			m.StartTs = 0
+			m.Rate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10279,16 +15129,16 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				m.Rate |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.CommitTs = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10298,7 +15148,7 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				m.Attempt |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10324,7 +15174,7 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostingList) Unmarshal(dAtA []byte) error {
+func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10347,17 +15197,55 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
-			var msglen int
+			m.ReqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReqId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10367,28 +15255,35 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Status |= (ExportPayload_Status(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10398,28 +15293,26 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
-			if m.Checksum == nil {
-				m.Checksum = []byte{}
-			}
+			m.Backup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			m.Commit = 0
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10429,16 +15322,16 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Commit |= (uint64(b) & 0x7F) << shift
+				m.SinceTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10448,76 +15341,24 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uids = append(m.Uids[:0], dAtA[iNdEx:postIndex]...)
-			if m.Uids == nil {
-				m.Uids = []byte{}
-			}
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10542,11 +15383,11 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10571,83 +15412,13 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllKeys = bool(v != 0)
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10657,76 +15428,24 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Param = append(m.Param, &FacetParam{})
-			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Facets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10750,8 +15469,8 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Counts = append(m.Counts, &ExportCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10776,7 +15495,8 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetsList) Unmarshal(dAtA []byte) error {
+
+func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10799,15 +15519,15 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10831,11 +15551,157 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetsList = append(m.FacetsList, &Facets{})
-			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Commits == nil {
+				m.Commits = make(map[uint64]uint64)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Commits[mapkey] = mapvalue
+			} else {
+				var mapvalue uint64
+				m.Commits[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Aborts = append(m.Aborts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Aborts = append(m.Aborts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborts", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
+			}
+			m.MaxPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPending |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10857,7 +15723,7 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10880,99 +15746,74 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				m.Ts = append(m.Ts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ts = append(m.Ts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10994,7 +15835,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterTree) Unmarshal(dAtA []byte) error {
+func (m *Num) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11017,77 +15858,17 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: Num: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Op = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, &FilterTree{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var msglen int
+			m.Val = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11097,25 +15878,11 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Val |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Func == nil {
-				m.Func = &Function{}
-			}
-			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11137,7 +15904,7 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11160,34 +15927,15 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11212,13 +15960,13 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11228,21 +15976,11 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Count |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11264,7 +16002,8 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+
+func (m *TabletSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11287,17 +16026,34 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabletSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabletSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
 			}
-			var msglen int
+			m.StartUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.StartUid = uint64(dAtA[iNdEx-8])
+			m.StartUid |= uint64(dAtA[iNdEx-7]) << 8
+			m.StartUid |= uint64(dAtA[iNdEx-6]) << 16
+			m.StartUid |= uint64(dAtA[iNdEx-5]) << 24
+			m.StartUid |= uint64(dAtA[iNdEx-4]) << 32
+			m.StartUid |= uint64(dAtA[iNdEx-3]) << 40
+			m.StartUid |= uint64(dAtA[iNdEx-2]) << 48
+			m.StartUid |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11307,23 +16063,11 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = append(m.Schema, &api.SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11345,7 +16089,7 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
+func (m *SplitPredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11368,10 +16112,10 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitPredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitPredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11397,36 +16141,17 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
-			}
-			m.ValueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Directive = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11436,16 +16161,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Directive |= (SchemaUpdate_Directive(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11455,26 +16180,30 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
+			}
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			var v int
+			m.ReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11484,15 +16213,64 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Count = bool(v != 0)
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebalanceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebalanceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebalanceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11509,7 +16287,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.List = bool(v != 0)
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11531,7 +16309,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapEntry) Unmarshal(dAtA []byte) error {
+func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11554,17 +16332,17 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveTabletRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveTabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11574,45 +16352,26 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Uid = uint64(dAtA[iNdEx-8])
-			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
-			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
-			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
-			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
-			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
-			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
-			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11622,25 +16381,11 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Posting == nil {
-				m.Posting = &Posting{}
-			}
-			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11662,7 +16407,7 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+func (m *TabletMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11685,13 +16430,32 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabletMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabletMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
@@ -11720,11 +16484,98 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 			}
 			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcGroup", wireType)
 			}
-			m.SourceGroupId = 0
+			m.SrcGroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcGroup |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstGroup", wireType)
+			}
+			m.DstGroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstGroup |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Manual = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11734,16 +16585,26 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceGroupId |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.DestGroupId = 0
+			m.StartedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11753,16 +16614,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestGroupId |= (uint32(b) & 0x7F) << shift
+				m.StartedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var msglen int
+			m.FinishedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11772,25 +16633,11 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.FinishedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11812,7 +16659,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportPayload) Unmarshal(dAtA []byte) error {
+func (m *TabletMoves) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11835,36 +16682,17 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabletMoves: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabletMoves: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
-			}
-			m.ReqId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReqId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11874,49 +16702,23 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= (ExportPayload_Status(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ReadTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Moves = append(m.Moves, &TabletMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11938,7 +16740,7 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDelta) Unmarshal(dAtA []byte) error {
+func (m *TabletPin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11961,54 +16763,17 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabletPin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabletPin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var mapkey uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12018,118 +16783,26 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Commits == nil {
-				m.Commits = make(map[uint64]uint64)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Commits[mapkey] = mapvalue
-			} else {
-				var mapvalue uint64
-				m.Commits[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Aborts = append(m.Aborts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Aborts = append(m.Aborts, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aborts", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-		case 3:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
 			}
-			m.MaxPending = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12139,11 +16812,12 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPending |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Pinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -12165,7 +16839,7 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
+func (m *MoveProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12188,73 +16862,204 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				m.Ts = append(m.Ts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstGroup", wireType)
+			}
+			m.DstGroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ts = append(m.Ts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstGroup |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastKey = append(m.LastKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastKey == nil {
+				m.LastKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -12277,7 +17082,7 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Num) Unmarshal(dAtA []byte) error {
+func (m *TsMark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12300,17 +17105,17 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Num: wiretype end group for non-group")
+			return fmt.Errorf("proto: TsMark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TsMark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unix", wireType)
 			}
-			m.Val = 0
+			m.Unix = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12320,7 +17125,26 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Val |= (uint64(b) & 0x7F) << shift
+				m.Unix |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			m.Ts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ts |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12454,188 +17278,242 @@ var (
 func init() { proto.RegisterFile("internal.proto", fileDescriptorInternal) }
 
 var fileDescriptorInternal = []byte{
-	// 2919 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0x23, 0xc7,
-	0xb5, 0x56, 0x37, 0x9b, 0xcd, 0xe6, 0x21, 0xa9, 0xa1, 0xcb, 0x63, 0x0f, 0x4d, 0xcf, 0x95, 0x75,
-	0xdb, 0xbe, 0x1e, 0xf9, 0x25, 0xdb, 0xf2, 0x5c, 0xdb, 0x77, 0x6e, 0x1c, 0x40, 0x16, 0xa9, 0x31,
-	0x3d, 0x7a, 0xb9, 0x48, 0x8d, 0xe3, 0x2c, 0x42, 0x94, 0xd8, 0x25, 0x4d, 0x43, 0xcd, 0x6e, 0xba,
-	0xab, 0x29, 0x50, 0x5e, 0x66, 0x1b, 0x64, 0x9d, 0xac, 0x03, 0x64, 0x17, 0x20, 0x40, 0x80, 0x6c,
-	0x02, 0x24, 0xbb, 0x00, 0x59, 0x04, 0x48, 0x7e, 0x42, 0xe0, 0xec, 0x92, 0x95, 0xff, 0x41, 0x50,
-	0xa7, 0xaa, 0x1f, 0xe4, 0x50, 0xb2, 0x90, 0xc7, 0x8a, 0x7d, 0xaa, 0xce, 0xa9, 0xc7, 0x39, 0x5f,
-	0x9d, 0x17, 0x61, 0xd5, 0x0f, 0x13, 0x1e, 0x87, 0x2c, 0xd8, 0x9c, 0xc4, 0x51, 0x12, 0x11, 0x5b,
-	0xd1, 0xed, 0x2a, 0x9b, 0xf8, 0x6a, 0xc8, 0x6d, 0x83, 0xb5, 0xe7, 0x8b, 0x84, 0x10, 0xb0, 0xa6,
-	0xbe, 0x27, 0x5a, 0xc6, 0x7a, 0x69, 0xc3, 0xa6, 0xf8, 0xed, 0x7e, 0x06, 0xd5, 0x01, 0x13, 0xe7,
-	0x8f, 0x59, 0x30, 0xe5, 0xa4, 0x09, 0xa5, 0x0b, 0x16, 0xb4, 0x8c, 0x75, 0x63, 0xa3, 0x4e, 0xe5,
-	0x27, 0xd9, 0x02, 0xe7, 0x82, 0x05, 0xc3, 0xe4, 0x72, 0xc2, 0x5b, 0xe6, 0xba, 0xb1, 0xb1, 0xba,
-	0x75, 0x67, 0x53, 0x6d, 0xb0, 0x79, 0x14, 0x89, 0xc4, 0x0f, 0xcf, 0x36, 0x1f, 0xb3, 0x60, 0x70,
-	0x39, 0xe1, 0xb4, 0x72, 0xa1, 0x3e, 0xdc, 0x43, 0xa8, 0xf5, 0xe3, 0xd1, 0xee, 0x34, 0x1c, 0x25,
-	0x7e, 0x14, 0xca, 0x5d, 0x43, 0x36, 0xe6, 0xb8, 0x6a, 0x95, 0xe2, 0xb7, 0x1c, 0x63, 0xf1, 0x99,
-	0x68, 0x95, 0xd6, 0x4b, 0x72, 0x4c, 0x7e, 0x93, 0x16, 0x54, 0x7c, 0xb1, 0x13, 0x4d, 0xc3, 0xa4,
-	0x65, 0xad, 0x1b, 0x1b, 0x0e, 0x4d, 0x49, 0xf7, 0x17, 0x25, 0x28, 0x7f, 0x36, 0xe5, 0xf1, 0x25,
-	0xca, 0x25, 0x49, 0x9c, 0xae, 0x25, 0xbf, 0xc9, 0x6d, 0x28, 0x07, 0x2c, 0x3c, 0x13, 0x2d, 0x13,
-	0x17, 0x53, 0x04, 0x79, 0x11, 0xaa, 0xec, 0x34, 0xe1, 0xf1, 0x70, 0xea, 0x7b, 0xad, 0xd2, 0xba,
-	0xb1, 0x61, 0x53, 0x07, 0x07, 0x8e, 0x7d, 0x8f, 0xbc, 0x00, 0x8e, 0x17, 0x0d, 0x47, 0xc5, 0xbd,
-	0xbc, 0x08, 0xf7, 0x22, 0xf7, 0xc0, 0x99, 0xfa, 0xde, 0x30, 0xf0, 0x45, 0xd2, 0x2a, 0xaf, 0x1b,
-	0x1b, 0xb5, 0xad, 0x7a, 0x7a, 0x61, 0xa9, 0x43, 0x5a, 0x99, 0xfa, 0x1e, 0x2a, 0x73, 0x13, 0x1c,
-	0x11, 0x8f, 0x86, 0xa7, 0xd3, 0x70, 0xd4, 0xb2, 0x91, 0xf1, 0xd9, 0x94, 0xb1, 0x70, 0x7b, 0x5a,
-	0x11, 0x8a, 0x90, 0xd7, 0x8b, 0xf9, 0x05, 0x8f, 0x05, 0x6f, 0x55, 0xd4, 0x96, 0x9a, 0x24, 0xf7,
-	0xa1, 0x76, 0xca, 0x46, 0x3c, 0x19, 0x4e, 0x58, 0xcc, 0xc6, 0x2d, 0x67, 0x7e, 0xb1, 0x5d, 0x39,
-	0x75, 0x24, 0x67, 0x04, 0x85, 0xd3, 0x8c, 0x20, 0x1f, 0x40, 0x03, 0x29, 0x31, 0x3c, 0xf5, 0x83,
-	0x84, 0xc7, 0xad, 0x2a, 0xca, 0x91, 0x4c, 0x0e, 0x47, 0x07, 0x31, 0xe7, 0xb4, 0xae, 0x18, 0xd5,
-	0x08, 0xf9, 0x2f, 0x00, 0x3e, 0x9b, 0xb0, 0xd0, 0x1b, 0xb2, 0x20, 0x68, 0x01, 0x9e, 0xa5, 0xaa,
-	0x46, 0xb6, 0x83, 0x80, 0xdc, 0x91, 0xe7, 0x64, 0xde, 0x30, 0x11, 0xad, 0xc6, 0xba, 0xb1, 0x61,
-	0x51, 0x5b, 0x92, 0x03, 0x21, 0x35, 0x13, 0xf8, 0xe1, 0x50, 0x52, 0xad, 0x55, 0xad, 0x19, 0x89,
-	0xb1, 0x3d, 0x3f, 0xa4, 0x9c, 0x79, 0xb4, 0x12, 0xa8, 0x0f, 0xf7, 0x7d, 0xa8, 0x22, 0x9c, 0x50,
-	0x4d, 0xaf, 0x81, 0x7d, 0x21, 0x09, 0x85, 0xba, 0xda, 0xd6, 0x33, 0xe9, 0xf9, 0x32, 0xd4, 0x51,
-	0xcd, 0xe0, 0xae, 0x81, 0xb3, 0xc7, 0xc2, 0xb3, 0x14, 0xaa, 0xd2, 0x8e, 0x28, 0x54, 0xa5, 0xf8,
-	0xed, 0xfe, 0xd6, 0x04, 0x9b, 0x72, 0x31, 0x0d, 0x12, 0xf2, 0x06, 0x80, 0xb4, 0xd2, 0x98, 0x25,
-	0xb1, 0x3f, 0xd3, 0x2b, 0xcf, 0xdb, 0xa9, 0x3a, 0xf5, 0xbd, 0x7d, 0x9c, 0x26, 0xf7, 0xa1, 0x8e,
-	0x3b, 0xa4, 0xec, 0xe6, 0xfc, 0x41, 0xb2, 0xb3, 0xd2, 0x1a, 0xb2, 0x69, 0xa9, 0xe7, 0xc1, 0x46,
-	0x80, 0x28, 0x90, 0x36, 0xa8, 0xa6, 0xc8, 0xff, 0xe8, 0x17, 0x27, 0xf8, 0x28, 0x19, 0x7a, 0x5c,
-	0xa4, 0x08, 0x6a, 0x64, 0xa3, 0x1d, 0x2e, 0x12, 0xf2, 0xbf, 0xa0, 0xb4, 0x9e, 0x6e, 0x5a, 0xc6,
-	0x4d, 0xc9, 0x9c, 0x55, 0x85, 0xda, 0x15, 0xf9, 0xf4, 0xae, 0xef, 0x42, 0x4d, 0xde, 0x35, 0x95,
-	0xb2, 0x51, 0xaa, 0x99, 0xdd, 0x4c, 0xab, 0x87, 0x82, 0x64, 0xd2, 0x22, 0x37, 0xb6, 0x4b, 0x17,
-	0xca, 0x87, 0xb1, 0xc7, 0xe3, 0xa5, 0xaf, 0x88, 0x80, 0xe5, 0x71, 0x31, 0xc2, 0x47, 0xee, 0x50,
-	0xfc, 0xce, 0x5f, 0x56, 0xa9, 0xf0, 0xb2, 0xdc, 0x3f, 0x19, 0x50, 0xeb, 0x47, 0x71, 0xb2, 0xcf,
-	0x85, 0x60, 0x67, 0x9c, 0xbc, 0x0c, 0xe5, 0x48, 0x2e, 0xab, 0xcd, 0xd0, 0x48, 0x0f, 0x8b, 0x7b,
-	0x51, 0x35, 0xb7, 0x60, 0x30, 0xf3, 0x7a, 0x83, 0xdd, 0x86, 0xb2, 0x7a, 0x9b, 0xf2, 0xdd, 0x96,
-	0xa9, 0x22, 0xa4, 0x41, 0xa2, 0xd3, 0x53, 0xc1, 0x95, 0xc2, 0xcb, 0x54, 0x53, 0xff, 0x06, 0xc0,
-	0x9e, 0x00, 0xc8, 0x0b, 0xfd, 0x33, 0xd8, 0xba, 0xf1, 0x1e, 0x0f, 0xa1, 0x46, 0xd9, 0x69, 0xb2,
-	0x13, 0x85, 0x09, 0x9f, 0x25, 0x64, 0x15, 0x4c, 0xdf, 0x43, 0x03, 0xd8, 0xd4, 0xf4, 0x3d, 0x79,
-	0xe5, 0xb3, 0x38, 0x9a, 0x4e, 0x50, 0xff, 0x0d, 0xaa, 0x08, 0x34, 0x94, 0xe7, 0xc5, 0xa8, 0x07,
-	0x69, 0x28, 0xcf, 0x8b, 0xdd, 0xdf, 0x1b, 0x60, 0xef, 0xf3, 0xf1, 0x09, 0x8f, 0x9f, 0x5a, 0xe4,
-	0x05, 0x70, 0x50, 0x6e, 0xe8, 0x7b, 0x7a, 0x9d, 0x0a, 0xd2, 0x3d, 0x6f, 0xd9, 0x4a, 0x52, 0xa1,
-	0x01, 0x67, 0xd2, 0x72, 0x0a, 0xc1, 0x9a, 0x92, 0x0a, 0x65, 0xe3, 0xa1, 0x27, 0xaf, 0x54, 0x56,
-	0x13, 0x6c, 0xdc, 0xe1, 0xcc, 0x23, 0x2f, 0x49, 0x70, 0x8a, 0x64, 0x38, 0x9d, 0x78, 0x2c, 0xe1,
-	0xe8, 0xf5, 0x2c, 0x09, 0x45, 0x91, 0x1c, 0xe3, 0x08, 0x79, 0x1d, 0x9e, 0x19, 0x05, 0x53, 0x21,
-	0xdd, 0xae, 0x1f, 0x9e, 0x46, 0xc3, 0x28, 0x0c, 0x2e, 0xd1, 0x28, 0x0e, 0xbd, 0xa5, 0x27, 0x7a,
-	0xe1, 0x69, 0x74, 0x18, 0x06, 0x97, 0xee, 0x8f, 0x4c, 0x28, 0x3f, 0xc4, 0x5b, 0xde, 0x87, 0xca,
-	0x18, 0x2f, 0x94, 0xfa, 0x88, 0x76, 0xaa, 0x6d, 0x9c, 0xdf, 0x54, 0xb7, 0x15, 0xdd, 0x30, 0x89,
-	0x2f, 0x69, 0xca, 0x2a, 0xa5, 0x12, 0x76, 0x12, 0xf0, 0x44, 0x68, 0x38, 0x2d, 0x48, 0x0d, 0xd4,
-	0xa4, 0x96, 0xd2, 0xac, 0xed, 0x4f, 0xa1, 0x5e, 0x5c, 0x4e, 0x46, 0xbc, 0x73, 0x7e, 0x89, 0x3a,
-	0xb4, 0xa8, 0xfc, 0x24, 0xaf, 0x40, 0x19, 0xdd, 0x00, 0x6a, 0xb0, 0xb6, 0xb5, 0x9a, 0xae, 0xaa,
-	0xc4, 0xa8, 0x9a, 0x7c, 0x60, 0x7e, 0x68, 0xc8, 0xb5, 0x8a, 0x9b, 0x14, 0xd7, 0xaa, 0x5e, 0xbf,
-	0x96, 0x12, 0x2b, 0xac, 0xe5, 0xfe, 0xdd, 0x80, 0xfa, 0xf7, 0x79, 0x1c, 0x1d, 0xc5, 0xd1, 0x24,
-	0x12, 0x2c, 0x28, 0xd8, 0xb6, 0x81, 0xb6, 0x7d, 0x15, 0x6c, 0x75, 0xf3, 0x2b, 0xce, 0xa5, 0x67,
-	0x25, 0x9f, 0xba, 0x2b, 0x9a, 0xfa, 0xe9, 0x3d, 0xf5, 0x2c, 0x59, 0x03, 0x18, 0xb3, 0xd9, 0x1e,
-	0x67, 0x82, 0xf7, 0x3c, 0x04, 0x80, 0x45, 0x0b, 0x23, 0xa4, 0x0d, 0xce, 0x98, 0xcd, 0x06, 0xb3,
-	0x70, 0x20, 0x10, 0x05, 0x16, 0xcd, 0x68, 0x72, 0x17, 0xaa, 0x63, 0x36, 0x93, 0x70, 0xee, 0x79,
-	0x1a, 0x05, 0xf9, 0x00, 0xf9, 0x6f, 0x28, 0x25, 0xb3, 0x10, 0x83, 0x5c, 0x6d, 0xeb, 0x16, 0xbe,
-	0x86, 0xc1, 0x2c, 0xd4, 0xc0, 0xa7, 0x72, 0xce, 0xfd, 0x4d, 0x09, 0x6e, 0x69, 0x33, 0x3c, 0xf1,
-	0x27, 0xfd, 0x44, 0x62, 0xa7, 0x05, 0x15, 0x7c, 0xe7, 0x3c, 0xd6, 0xd6, 0x48, 0x49, 0xf2, 0xff,
-	0x60, 0x23, 0x8c, 0x53, 0x43, 0xbf, 0x3c, 0x7f, 0xf5, 0x6c, 0x09, 0x65, 0x78, 0x6d, 0x71, 0x2d,
-	0x42, 0x3e, 0x84, 0xf2, 0x57, 0x3c, 0x8e, 0x94, 0x0f, 0xab, 0x6d, 0xb9, 0x57, 0xc9, 0x4a, 0xe5,
-	0x6b, 0x51, 0x25, 0xf0, 0x1f, 0xd4, 0xd0, 0x86, 0xf4, 0x58, 0xe3, 0xe8, 0x82, 0x7b, 0xad, 0x0a,
-	0x9e, 0x6a, 0xd1, 0x98, 0xe9, 0x74, 0xfb, 0x13, 0xa8, 0x15, 0x2e, 0x55, 0x44, 0x58, 0x43, 0x21,
-	0xec, 0xe5, 0x79, 0x84, 0x35, 0xe6, 0xde, 0x40, 0x11, 0xac, 0x9f, 0x00, 0xe4, 0x57, 0xfc, 0x57,
-	0x60, 0xef, 0x3e, 0x81, 0x5b, 0x3b, 0x51, 0x18, 0x72, 0xcc, 0x6f, 0x94, 0xed, 0x72, 0x70, 0x1a,
-	0xd7, 0x82, 0xf3, 0x2d, 0x28, 0x0b, 0x29, 0xa0, 0x37, 0xb9, 0x73, 0x85, 0x31, 0xa8, 0xe2, 0x72,
-	0x7f, 0x66, 0x80, 0xad, 0x60, 0x3b, 0xe7, 0xda, 0x8c, 0x79, 0xd7, 0x76, 0x17, 0xaa, 0x93, 0x98,
-	0x7b, 0xfe, 0x28, 0x5d, 0xb8, 0x4a, 0xf3, 0x01, 0xe9, 0x58, 0x4f, 0xa3, 0x78, 0xc4, 0xf1, 0x39,
-	0x38, 0x54, 0x11, 0x32, 0x3b, 0xc4, 0x98, 0x81, 0x0e, 0x4a, 0x79, 0x3f, 0x47, 0x0e, 0x48, 0xcf,
-	0x24, 0x45, 0xc4, 0x84, 0x8d, 0x54, 0x9e, 0x56, 0xa2, 0x8a, 0x90, 0xde, 0x52, 0x59, 0x05, 0x13,
-	0x34, 0x87, 0x6a, 0xca, 0xfd, 0x95, 0x09, 0xf5, 0x8e, 0x1f, 0xf3, 0x51, 0xc2, 0xbd, 0xae, 0x77,
-	0x86, 0x8c, 0x3c, 0x4c, 0xfc, 0xe4, 0x52, 0x7b, 0x66, 0x4d, 0x65, 0x51, 0xd7, 0x9c, 0xcf, 0x5d,
-	0x95, 0xd6, 0x4b, 0x98, 0x72, 0x2b, 0x82, 0xbc, 0x0f, 0xa0, 0x12, 0x16, 0x4c, 0xbb, 0xad, 0xeb,
-	0xd3, 0xee, 0x2a, 0xb2, 0xca, 0x4f, 0xa9, 0x24, 0x25, 0xe7, 0x2b, 0xcf, 0x6d, 0x63, 0x4e, 0x3e,
-	0x95, 0x60, 0xc5, 0x50, 0x7e, 0xc2, 0x03, 0x04, 0x23, 0x86, 0xf2, 0x13, 0x1e, 0x64, 0x59, 0x56,
-	0x45, 0x1d, 0x49, 0x7e, 0x93, 0x7b, 0x60, 0x46, 0x13, 0xbc, 0x63, 0x61, 0xd3, 0xe2, 0x05, 0x37,
-	0x0f, 0x27, 0xd4, 0x8c, 0x26, 0xc4, 0x05, 0x5b, 0xe5, 0x95, 0xad, 0x2a, 0x82, 0x18, 0xf0, 0xa9,
-	0x63, 0x62, 0x43, 0xf5, 0x8c, 0xfb, 0x3c, 0x98, 0x87, 0x13, 0x52, 0x81, 0x52, 0xbf, 0x3b, 0x68,
-	0xae, 0xc8, 0x8f, 0x4e, 0x77, 0xaf, 0x69, 0xb8, 0x7f, 0x33, 0xa0, 0xba, 0x3f, 0x4d, 0x98, 0x84,
-	0x90, 0xb8, 0xce, 0xb8, 0x2f, 0x80, 0x23, 0x12, 0x16, 0x27, 0x43, 0x74, 0xf3, 0xe8, 0x16, 0x90,
-	0x1e, 0x08, 0xf2, 0x3a, 0x94, 0xb9, 0x77, 0xc6, 0xd3, 0x97, 0x7d, 0x7b, 0xd9, 0x59, 0xa9, 0x62,
-	0x21, 0x6f, 0x82, 0x2d, 0x46, 0x4f, 0xf8, 0x98, 0xb5, 0xac, 0x79, 0xe6, 0x3e, 0x8e, 0xaa, 0xf0,
-	0x45, 0x35, 0x0f, 0x96, 0x07, 0x71, 0x34, 0xc1, 0xfc, 0xb8, 0xac, 0xcb, 0x83, 0x38, 0x9a, 0xc8,
-	0xec, 0x78, 0x0b, 0x9e, 0xf3, 0xcf, 0xc2, 0x28, 0xe6, 0x43, 0x3f, 0xf4, 0xf8, 0x6c, 0x38, 0x8a,
-	0xc2, 0xd3, 0xc0, 0x1f, 0x25, 0xa8, 0x57, 0x87, 0x3e, 0xab, 0x26, 0x7b, 0x72, 0x6e, 0x47, 0x4f,
-	0xb9, 0xf7, 0xa0, 0xfa, 0x88, 0x5f, 0x62, 0x9a, 0x29, 0x48, 0x1b, 0xcc, 0xf3, 0x0b, 0x1d, 0xe7,
-	0x20, 0x3d, 0xc5, 0xa3, 0xc7, 0xd4, 0x3c, 0xbf, 0x70, 0xbf, 0x31, 0xc0, 0xb9, 0x32, 0x00, 0xbc,
-	0x0d, 0xd5, 0x71, 0xaa, 0x31, 0xfd, 0x7e, 0xb2, 0x14, 0x36, 0x53, 0x25, 0xcd, 0x79, 0xc8, 0x3b,
-	0x50, 0x4b, 0x66, 0xa1, 0x3c, 0xa1, 0x74, 0xbc, 0x3a, 0x1c, 0x3c, 0xe5, 0x8f, 0x21, 0xc9, 0xbe,
-	0xf5, 0xd9, 0xac, 0x65, 0x67, 0xcb, 0x9f, 0x6e, 0xf9, 0x26, 0x4f, 0x97, 0xdc, 0x83, 0x5b, 0xa3,
-	0x80, 0xb3, 0x70, 0x98, 0x3f, 0x4d, 0x85, 0xbc, 0x55, 0x1c, 0x3e, 0x4a, 0x47, 0xdd, 0x1f, 0x80,
-	0xf9, 0xe8, 0x71, 0xd1, 0x1f, 0xd5, 0x95, 0x3f, 0xd2, 0xa5, 0xa8, 0x99, 0x97, 0xa2, 0x6d, 0x70,
-	0xa6, 0x82, 0xc7, 0xfb, 0x3c, 0x61, 0xfa, 0xb9, 0x64, 0xb4, 0x0c, 0x1e, 0xb2, 0x96, 0xf2, 0xa3,
-	0x50, 0x3b, 0xea, 0x94, 0x74, 0xef, 0x83, 0xf9, 0x68, 0x67, 0xc9, 0xfa, 0x77, 0xa1, 0x9a, 0xf8,
-	0x63, 0x2e, 0x12, 0x36, 0x9e, 0x68, 0x64, 0xe5, 0x03, 0xee, 0x2e, 0x54, 0xd1, 0x83, 0x3e, 0xe2,
-	0x97, 0xd7, 0xc2, 0x73, 0x0d, 0xac, 0x73, 0x7e, 0x99, 0x06, 0xa6, 0x5c, 0x67, 0x3b, 0x14, 0xc7,
-	0xdd, 0x6f, 0x4a, 0x50, 0xd1, 0x0f, 0x56, 0x9e, 0x61, 0x9a, 0xa5, 0x6b, 0xf2, 0x33, 0x7f, 0xfd,
-	0x66, 0xf1, 0xf5, 0x17, 0x4b, 0xee, 0xd2, 0xcd, 0x4a, 0x6e, 0xf2, 0x5d, 0xa8, 0x4f, 0xd4, 0x5c,
-	0xd1, 0x67, 0xbc, 0xb8, 0x28, 0xa7, 0x7f, 0x51, 0xb6, 0x36, 0xc9, 0x09, 0x79, 0x45, 0x2c, 0x3b,
-	0x12, 0x76, 0x86, 0x06, 0xae, 0xd3, 0x8a, 0xa4, 0x07, 0xec, 0xec, 0x0a, 0xcf, 0x71, 0x83, 0xc7,
-	0x2f, 0x11, 0x1c, 0x4d, 0x5a, 0x75, 0x85, 0xe0, 0x68, 0x32, 0xf7, 0x96, 0x1b, 0xf3, 0x6f, 0xf9,
-	0x45, 0xa8, 0x8e, 0xa2, 0xf1, 0xd8, 0xc7, 0xb9, 0x55, 0x15, 0x4c, 0xd5, 0xc0, 0x40, 0xb8, 0x5f,
-	0x41, 0x45, 0x5f, 0x98, 0xd4, 0xa0, 0xd2, 0xe9, 0xee, 0x6e, 0x1f, 0xef, 0x49, 0x6f, 0x02, 0x60,
-	0x7f, 0xdc, 0x3b, 0xd8, 0xa6, 0x5f, 0x34, 0x0d, 0xe9, 0x59, 0x7a, 0x07, 0x83, 0xa6, 0x49, 0xaa,
-	0x50, 0xde, 0xdd, 0x3b, 0xdc, 0x1e, 0x34, 0x4b, 0xc4, 0x01, 0xeb, 0xe3, 0xc3, 0xc3, 0xbd, 0xa6,
-	0x45, 0xea, 0xe0, 0x74, 0xb6, 0x07, 0xdd, 0x41, 0x6f, 0xbf, 0xdb, 0x2c, 0x4b, 0xde, 0x87, 0xdd,
-	0xc3, 0xa6, 0x2d, 0x3f, 0x8e, 0x7b, 0x9d, 0x66, 0x45, 0xce, 0x1f, 0x6d, 0xf7, 0xfb, 0x9f, 0x1f,
-	0xd2, 0x4e, 0xd3, 0x91, 0xeb, 0xf6, 0x07, 0xb4, 0x77, 0xf0, 0xb0, 0x59, 0x75, 0xdf, 0x85, 0x5a,
-	0x41, 0x69, 0x52, 0x82, 0x76, 0x77, 0x9b, 0x2b, 0x72, 0x9b, 0xc7, 0xdb, 0x7b, 0xc7, 0xdd, 0xa6,
-	0x41, 0x56, 0x01, 0xf0, 0x73, 0xb8, 0xb7, 0x7d, 0xf0, 0xb0, 0x69, 0xba, 0x3f, 0x34, 0x32, 0x19,
-	0x2c, 0x65, 0xdf, 0x00, 0x47, 0xab, 0x3a, 0xcd, 0x6f, 0x6f, 0x2d, 0xd8, 0x85, 0x66, 0x0c, 0x12,
-	0xe4, 0xa3, 0x27, 0x7c, 0x74, 0x2e, 0xa6, 0x63, 0x8d, 0x8a, 0x8c, 0x56, 0x15, 0xa9, 0xd4, 0x09,
-	0xc2, 0xc2, 0xa2, 0x9a, 0xca, 0xda, 0x3a, 0x16, 0xf2, 0xab, 0xb6, 0xce, 0x7d, 0x80, 0xbc, 0x71,
-	0xb0, 0x24, 0x33, 0xbd, 0x0d, 0x65, 0x16, 0xf8, 0x4c, 0xe8, 0x68, 0xa4, 0x08, 0x97, 0x42, 0xad,
-	0xd0, 0x6e, 0x90, 0x06, 0x63, 0x41, 0x30, 0x44, 0x84, 0x1b, 0xca, 0x0f, 0xb2, 0x20, 0xc0, 0x37,
-	0xb1, 0x01, 0x65, 0xd5, 0xad, 0x30, 0x97, 0xd4, 0xb5, 0x28, 0x4e, 0x15, 0x83, 0xfb, 0x26, 0xd8,
-	0xaa, 0xd8, 0x2d, 0x60, 0xc6, 0xb8, 0x32, 0x60, 0x7c, 0xa4, 0xcf, 0x8d, 0xa5, 0x31, 0x79, 0x5b,
-	0x77, 0x46, 0x84, 0xea, 0xc7, 0x18, 0xf3, 0xc9, 0x92, 0x62, 0xd4, 0x4d, 0x11, 0x14, 0x70, 0x3b,
-	0xe0, 0x5c, 0xdb, 0x77, 0xd2, 0x8a, 0x30, 0x73, 0x45, 0x2c, 0xe9, 0x44, 0xb9, 0x31, 0x40, 0xde,
-	0x3d, 0xd1, 0x30, 0x56, 0xab, 0x48, 0x18, 0x6f, 0x4a, 0x13, 0xf9, 0x81, 0x17, 0xf3, 0xf0, 0xa9,
-	0xdb, 0xe7, 0x3d, 0x97, 0x8c, 0x87, 0xbc, 0x02, 0x16, 0x36, 0x89, 0x94, 0x03, 0xce, 0x6a, 0xf9,
-	0xac, 0x43, 0x84, 0xb3, 0xee, 0x09, 0x34, 0x54, 0x2c, 0xa2, 0xfc, 0xcb, 0x29, 0x17, 0xc9, 0xf5,
-	0x5e, 0x07, 0x32, 0xb7, 0x9a, 0xb6, 0xbd, 0x0a, 0x23, 0x12, 0x28, 0xa7, 0x3e, 0x0f, 0xbc, 0xf4,
-	0x56, 0x9a, 0x72, 0x3f, 0x80, 0x7a, 0xba, 0x07, 0x56, 0xba, 0xf7, 0xb2, 0xa8, 0x98, 0xe2, 0x52,
-	0x1a, 0x44, 0xb1, 0x1c, 0x44, 0x5e, 0x16, 0x10, 0xdd, 0x5f, 0x9b, 0xa9, 0xa4, 0x2e, 0xf4, 0xe6,
-	0x72, 0x2e, 0x63, 0x31, 0xe7, 0x9a, 0xcf, 0x5f, 0xcc, 0x1b, 0xe7, 0x2f, 0xdf, 0x81, 0xaa, 0x87,
-	0xc1, 0xdb, 0xbf, 0x48, 0x5d, 0xdf, 0xda, 0xb2, 0x40, 0xad, 0x43, 0xbc, 0x7f, 0xc1, 0x69, 0x2e,
-	0x80, 0x1e, 0x3d, 0x3a, 0xe7, 0xa1, 0xff, 0x15, 0x56, 0xb4, 0xf2, 0xe2, 0xf9, 0x40, 0xde, 0x53,
-	0x50, 0x01, 0x5d, 0xf7, 0x14, 0x64, 0x02, 0x24, 0x91, 0xa5, 0xa2, 0x37, 0x7e, 0xbb, 0xff, 0x07,
-	0xd5, 0x6c, 0x7d, 0xe9, 0x43, 0x0e, 0x0e, 0x0f, 0xba, 0xea, 0xc5, 0xf7, 0x0e, 0x3a, 0xdd, 0xef,
-	0x35, 0x0d, 0xe9, 0x85, 0x68, 0xf7, 0x71, 0x97, 0xf6, 0xbb, 0x4d, 0x53, 0x7a, 0x8b, 0x4e, 0x77,
-	0xaf, 0x3b, 0xe8, 0x36, 0x4b, 0x9f, 0x5a, 0x4e, 0xa5, 0xe9, 0x50, 0x87, 0xcf, 0x26, 0x81, 0x3f,
-	0xf2, 0x13, 0xf7, 0x0b, 0x70, 0xf6, 0xd9, 0xe4, 0xa9, 0x94, 0x3b, 0x0f, 0x71, 0x53, 0x5d, 0xa9,
-	0xeb, 0x80, 0xf0, 0x1a, 0x54, 0xb4, 0x27, 0xc8, 0xc2, 0xf5, 0x82, 0xa7, 0x48, 0xe7, 0xdd, 0x5f,
-	0x1a, 0x70, 0x7b, 0x3f, 0xba, 0xe0, 0x59, 0x24, 0x3d, 0x62, 0x97, 0x41, 0xc4, 0xbc, 0x6f, 0x31,
-	0xcd, 0xab, 0x70, 0x4b, 0x44, 0xd3, 0x78, 0xc4, 0x87, 0x0b, 0x9d, 0x82, 0x86, 0x1a, 0x7e, 0xa8,
-	0x21, 0xe6, 0x42, 0xc3, 0xe3, 0x22, 0xc9, 0xb9, 0x4a, 0xc8, 0x55, 0x93, 0x83, 0x29, 0x4f, 0x96,
-	0x12, 0x58, 0x37, 0xca, 0xe6, 0xff, 0x68, 0x40, 0xa3, 0x3b, 0x9b, 0x44, 0x71, 0x92, 0x1e, 0xf5,
-	0x39, 0x99, 0x52, 0x7f, 0x99, 0x02, 0xdc, 0xa2, 0xe5, 0x98, 0x7f, 0xd9, 0xbb, 0xb6, 0x8d, 0x71,
-	0x1f, 0x6c, 0xb9, 0xd8, 0x54, 0x68, 0x78, 0xdc, 0x4d, 0xf7, 0x9c, 0x5b, 0x78, 0xb3, 0x8f, 0x3c,
-	0x54, 0xf3, 0x16, 0x3b, 0x44, 0x56, 0xb1, 0x43, 0xe4, 0x3e, 0x00, 0x5b, 0xb1, 0x16, 0xec, 0x5c,
-	0x83, 0x4a, 0xff, 0x78, 0x67, 0xa7, 0xdb, 0xef, 0x37, 0x0d, 0xd2, 0x80, 0x6a, 0xe7, 0xf8, 0x68,
-	0xaf, 0xb7, 0xb3, 0x3d, 0xd0, 0xb6, 0xde, 0xdd, 0xee, 0xed, 0x75, 0x3b, 0xcd, 0x92, 0xfb, 0x3b,
-	0x03, 0x6a, 0x87, 0x31, 0x1b, 0x05, 0xbc, 0xc3, 0x83, 0x84, 0x91, 0x07, 0xb2, 0x7e, 0x95, 0xfe,
-	0x38, 0x75, 0x6f, 0xeb, 0x79, 0x23, 0x2c, 0xe3, 0xda, 0xdc, 0x51, 0x2c, 0xba, 0x2b, 0xa1, 0x05,
-	0xe4, 0x83, 0x65, 0x27, 0x51, 0xac, 0x5b, 0x19, 0x16, 0xd5, 0x14, 0x79, 0x09, 0x6a, 0x63, 0x36,
-	0x1b, 0x4e, 0x78, 0xe8, 0xa5, 0x98, 0x50, 0x35, 0xe8, 0x91, 0x1a, 0x69, 0x3f, 0x80, 0x7a, 0x71,
-	0xc5, 0x25, 0x75, 0xdd, 0x5c, 0x8e, 0x61, 0x15, 0xeb, 0xb8, 0x97, 0xa0, 0x21, 0x8b, 0xd5, 0x34,
-	0xe7, 0xc1, 0x78, 0xad, 0x0f, 0x6f, 0x51, 0x33, 0x11, 0xee, 0x1d, 0x28, 0x1d, 0x4c, 0xc7, 0xc5,
-	0x3f, 0x05, 0x2c, 0xcc, 0xc4, 0xb6, 0x7e, 0x6c, 0x80, 0x25, 0x4b, 0x59, 0xe9, 0xda, 0xba, 0xa3,
-	0x27, 0x11, 0x51, 0x3d, 0x2f, 0xad, 0xfd, 0xf6, 0x1c, 0xe5, 0xae, 0x90, 0x37, 0x54, 0xeb, 0x2b,
-	0xed, 0x17, 0x5e, 0xcf, 0xbc, 0x05, 0xb5, 0x4f, 0x23, 0x3f, 0xdc, 0x51, 0xdd, 0x22, 0x92, 0xb5,
-	0xc1, 0x0b, 0xcd, 0xb3, 0x45, 0x99, 0xad, 0x9f, 0x97, 0xc0, 0x92, 0xc5, 0x2d, 0xb9, 0x0f, 0x15,
-	0x5d, 0x9a, 0x92, 0x85, 0x12, 0xb4, 0x9d, 0xa1, 0x73, 0xa1, 0x76, 0x75, 0x57, 0xc8, 0xfb, 0x60,
-	0x6b, 0xb7, 0x36, 0x5f, 0x3e, 0xb7, 0xaf, 0x42, 0xb4, 0xbb, 0xb2, 0x61, 0xbc, 0x63, 0x90, 0xb7,
-	0xc1, 0x56, 0xa6, 0x5d, 0xb8, 0xd2, 0xb3, 0x4b, 0x0c, 0xef, 0xae, 0xa0, 0x40, 0xad, 0xff, 0x24,
-	0x9a, 0x06, 0x5e, 0x9f, 0xc7, 0x17, 0x9c, 0x2c, 0xb4, 0x66, 0xda, 0x0b, 0xb4, 0xbb, 0x42, 0xde,
-	0x02, 0xd8, 0x16, 0xc2, 0x3f, 0x0b, 0x8f, 0x7d, 0x4f, 0x90, 0x5a, 0x3a, 0x7f, 0x30, 0x1d, 0xb7,
-	0x9b, 0xb8, 0xa5, 0x9a, 0xe5, 0x5e, 0xcf, 0x13, 0x8a, 0xbd, 0x60, 0xce, 0x6f, 0x65, 0x7f, 0x0f,
-	0x1a, 0x0a, 0x3c, 0x87, 0xf1, 0xb6, 0xc4, 0x1b, 0x59, 0x2c, 0x0e, 0xda, 0x8b, 0x03, 0xee, 0x0a,
-	0x79, 0x00, 0xce, 0x20, 0xbe, 0x54, 0xfc, 0xcf, 0x65, 0x07, 0x2e, 0xe2, 0xa8, 0xbd, 0x7c, 0xd8,
-	0x5d, 0xd9, 0xfa, 0x89, 0x05, 0xf6, 0xe7, 0x51, 0x7c, 0xce, 0x63, 0xb2, 0x09, 0x36, 0x16, 0x2d,
-	0x9c, 0x3c, 0x5d, 0xc4, 0x2c, 0xdb, 0xf6, 0x9d, 0x6f, 0x3d, 0xeb, 0x22, 0x90, 0xde, 0x84, 0x2a,
-	0xaa, 0x79, 0xc0, 0xc4, 0x79, 0x6e, 0x58, 0xfc, 0x1b, 0x29, 0xd7, 0xb4, 0x0a, 0x84, 0xee, 0x0a,
-	0xf9, 0x08, 0x9e, 0xcf, 0x3c, 0xe9, 0x76, 0xe8, 0xa9, 0x68, 0xd3, 0x61, 0x09, 0xcb, 0xcf, 0x97,
-	0x15, 0x04, 0xed, 0x42, 0x6d, 0xa4, 0xa1, 0xf0, 0x2e, 0x58, 0x7d, 0x79, 0xaa, 0xfc, 0x2f, 0xa0,
-	0xbc, 0x41, 0xde, 0x26, 0xc5, 0xc1, 0x6c, 0xc7, 0x0f, 0xc0, 0x56, 0xbb, 0xe4, 0x6a, 0x9c, 0x4b,
-	0x00, 0xda, 0xb7, 0x17, 0x87, 0xb5, 0xe0, 0x3d, 0x70, 0xf6, 0xfd, 0x50, 0xf5, 0x99, 0xe6, 0x81,
-	0x57, 0xb4, 0xb8, 0xbb, 0x42, 0x3e, 0x04, 0x5b, 0xb9, 0xc5, 0x7c, 0x87, 0x39, 0x37, 0xd9, 0x5e,
-	0x3e, 0x8c, 0xda, 0x6e, 0x52, 0x3e, 0xe2, 0x7e, 0x21, 0xbc, 0x90, 0xc2, 0xa5, 0x17, 0x75, 0xbd,
-	0x61, 0x90, 0x8f, 0xa0, 0x31, 0x17, 0x8d, 0x48, 0xe6, 0x99, 0x97, 0x05, 0xa9, 0xc5, 0x05, 0x3e,
-	0x6e, 0xfe, 0xe1, 0xeb, 0x35, 0xe3, 0xcf, 0x5f, 0xaf, 0x19, 0x7f, 0xf9, 0x7a, 0xcd, 0xf8, 0xe9,
-	0x5f, 0xd7, 0x56, 0x4e, 0x6c, 0xfc, 0xeb, 0xf2, 0xbd, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe7,
-	0x44, 0x65, 0xab, 0xdf, 0x1c, 0x00, 0x00,
+	// 3791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7a, 0xcd, 0x73, 0x1b, 0x47,
+	0x76, 0x38, 0x67, 0xf0, 0x35, 0xf3, 0x00, 0x50, 0x70, 0x5b, 0x96, 0x20, 0x58, 0x96, 0xb9, 0xe3,
+	0xfd, 0xad, 0xe8, 0xb5, 0x97, 0xb6, 0x69, 0xad, 0xed, 0xd5, 0x2f, 0x4e, 0x0a, 0x26, 0x21, 0x2d,
+	0x2d, 0x8a, 0xa4, 0x9b, 0x90, 0x36, 0x9b, 0x43, 0x50, 0x4d, 0x4c, 0x93, 0x9a, 0xd2, 0x60, 0x66,
+	0x3c, 0x33, 0xa0, 0x49, 0x1f, 0x73, 0x4d, 0xb6, 0x6a, 0x73, 0xdb, 0xaa, 0xfd, 0x13, 0x92, 0x7f,
+	0x21, 0xb9, 0xa5, 0x2a, 0x39, 0x25, 0xe7, 0x1c, 0x52, 0x29, 0xa7, 0x52, 0x95, 0x7f, 0x20, 0x97,
+	0xe4, 0x92, 0x7a, 0xaf, 0x7b, 0xbe, 0x40, 0x90, 0x54, 0xbc, 0x9b, 0x13, 0xfa, 0xbd, 0x7e, 0xfd,
+	0xf5, 0xbe, 0xdf, 0x1b, 0xc0, 0xaa, 0x17, 0xa4, 0x32, 0x0e, 0x84, 0xbf, 0x11, 0xc5, 0x61, 0x1a,
+	0xb2, 0xa6, 0x82, 0x07, 0xb6, 0x88, 0x3c, 0x85, 0x72, 0x06, 0x50, 0xdf, 0xf5, 0x92, 0x94, 0x31,
+	0xa8, 0xcf, 0x3d, 0x37, 0xe9, 0x1b, 0x6b, 0xb5, 0xf5, 0x26, 0xa7, 0xb1, 0xf3, 0x15, 0xd8, 0x63,
+	0x91, 0xbc, 0x7c, 0x2e, 0xfc, 0xb9, 0x64, 0x3d, 0xa8, 0x9d, 0x0a, 0xbf, 0x6f, 0xac, 0x19, 0xeb,
+	0x1d, 0x8e, 0x43, 0xb6, 0x09, 0xd6, 0xa9, 0xf0, 0x27, 0xe9, 0x79, 0x24, 0xfb, 0xe6, 0x9a, 0xb1,
+	0xbe, 0xba, 0x79, 0x7b, 0x43, 0x1d, 0xb0, 0x71, 0x10, 0x26, 0xa9, 0x17, 0x9c, 0x6c, 0x3c, 0x17,
+	0xfe, 0xf8, 0x3c, 0x92, 0xbc, 0x75, 0xaa, 0x06, 0xce, 0x3e, 0xb4, 0x0f, 0xe3, 0xe9, 0xa3, 0x79,
+	0x30, 0x4d, 0xbd, 0x30, 0xc0, 0x53, 0x03, 0x31, 0x93, 0xb4, 0xab, 0xcd, 0x69, 0x8c, 0x38, 0x11,
+	0x9f, 0x24, 0xfd, 0xda, 0x5a, 0x0d, 0x71, 0x38, 0x66, 0x7d, 0x68, 0x79, 0xc9, 0x56, 0x38, 0x0f,
+	0xd2, 0x7e, 0x7d, 0xcd, 0x58, 0xb7, 0x78, 0x06, 0x3a, 0x7f, 0x5d, 0x83, 0xc6, 0x57, 0x73, 0x19,
+	0x9f, 0xd3, 0xba, 0x34, 0x8d, 0xb3, 0xbd, 0x70, 0xcc, 0x6e, 0x42, 0xc3, 0x17, 0xc1, 0x49, 0xd2,
+	0x37, 0x69, 0x33, 0x05, 0xb0, 0x37, 0xc1, 0x16, 0xc7, 0xa9, 0x8c, 0x27, 0x73, 0xcf, 0xed, 0xd7,
+	0xd6, 0x8c, 0xf5, 0x26, 0xb7, 0x08, 0xf1, 0xcc, 0x73, 0xd9, 0x1d, 0xb0, 0xdc, 0x70, 0x32, 0x2d,
+	0x9f, 0xe5, 0x86, 0x74, 0x16, 0xbb, 0x0f, 0xd6, 0xdc, 0x73, 0x27, 0xbe, 0x97, 0xa4, 0xfd, 0xc6,
+	0x9a, 0xb1, 0xde, 0xde, 0xec, 0x64, 0x0f, 0x46, 0x1e, 0xf2, 0xd6, 0xdc, 0x73, 0x71, 0xc0, 0x36,
+	0xc0, 0x4a, 0xe2, 0xe9, 0xe4, 0x78, 0x1e, 0x4c, 0xfb, 0x4d, 0x22, 0x7c, 0x3d, 0x23, 0x2c, 0xbd,
+	0x9e, 0xb7, 0x12, 0x05, 0xe0, 0xf3, 0x62, 0x79, 0x2a, 0xe3, 0x44, 0xf6, 0x5b, 0xea, 0x48, 0x0d,
+	0xb2, 0x07, 0xd0, 0x3e, 0x16, 0x53, 0x99, 0x4e, 0x22, 0x11, 0x8b, 0x59, 0xdf, 0xaa, 0x6e, 0xf6,
+	0x08, 0xa7, 0x0e, 0x70, 0x26, 0xe1, 0x70, 0x9c, 0x03, 0xec, 0x53, 0xe8, 0x12, 0x94, 0x4c, 0x8e,
+	0x3d, 0x3f, 0x95, 0x71, 0xdf, 0xa6, 0x75, 0x2c, 0x5f, 0x47, 0xd8, 0x71, 0x2c, 0x25, 0xef, 0x28,
+	0x42, 0x85, 0x61, 0x6f, 0x01, 0xc8, 0xb3, 0x48, 0x04, 0xee, 0x44, 0xf8, 0x7e, 0x1f, 0xe8, 0x2e,
+	0xb6, 0xc2, 0x0c, 0x7d, 0x9f, 0xdd, 0xc6, 0x7b, 0x0a, 0x77, 0x92, 0x26, 0xfd, 0xee, 0x9a, 0xb1,
+	0x5e, 0xe7, 0x4d, 0x04, 0xc7, 0x09, 0x72, 0xc6, 0xf7, 0x82, 0x09, 0x42, 0xfd, 0x55, 0xcd, 0x19,
+	0xd4, 0xb1, 0x5d, 0x2f, 0xe0, 0x52, 0xb8, 0xbc, 0xe5, 0xab, 0x81, 0xf3, 0x09, 0xd8, 0xa4, 0x4e,
+	0xc4, 0xa6, 0x77, 0xa1, 0x79, 0x8a, 0x80, 0xd2, 0xba, 0xf6, 0xe6, 0x6b, 0xd9, 0xfd, 0x72, 0xad,
+	0xe3, 0x9a, 0xc0, 0xb9, 0x07, 0xd6, 0xae, 0x08, 0x4e, 0x32, 0x55, 0x45, 0x39, 0xd2, 0x22, 0x9b,
+	0xd3, 0xd8, 0xf9, 0x1b, 0x13, 0x9a, 0x5c, 0x26, 0x73, 0x3f, 0x65, 0xef, 0x01, 0xa0, 0x94, 0x66,
+	0x22, 0x8d, 0xbd, 0x33, 0xbd, 0x73, 0x55, 0x4e, 0xf6, 0xdc, 0x73, 0x9f, 0xd2, 0x34, 0x7b, 0x00,
+	0x1d, 0x3a, 0x21, 0x23, 0x37, 0xab, 0x17, 0xc9, 0xef, 0xca, 0xdb, 0x44, 0xa6, 0x57, 0xdd, 0x82,
+	0x26, 0x29, 0x88, 0x52, 0xd2, 0x2e, 0xd7, 0x10, 0xfb, 0x7f, 0xda, 0xe2, 0x12, 0x39, 0x4d, 0x27,
+	0xae, 0x4c, 0x32, 0x0d, 0xea, 0xe6, 0xd8, 0x6d, 0x99, 0xa4, 0xec, 0xa7, 0xa0, 0xb8, 0x9e, 0x1d,
+	0xda, 0x58, 0xab, 0x55, 0xa4, 0x43, 0x12, 0x51, 0xa7, 0x12, 0x9d, 0x3e, 0xf5, 0x23, 0x68, 0xe3,
+	0x5b, 0xb3, 0x55, 0x4d, 0x5a, 0xd5, 0xcb, 0x5f, 0xa6, 0xd9, 0xc3, 0x01, 0x89, 0xf4, 0x92, 0x57,
+	0x96, 0xcb, 0x08, 0x1a, 0xfb, 0xb1, 0x2b, 0xe3, 0xa5, 0x56, 0xc4, 0xa0, 0xee, 0xca, 0x64, 0x4a,
+	0x46, 0x6e, 0x71, 0x1a, 0x17, 0x96, 0x55, 0x2b, 0x59, 0x96, 0xf3, 0x8f, 0x06, 0xb4, 0x0f, 0xc3,
+	0x38, 0x7d, 0x2a, 0x93, 0x44, 0x9c, 0x48, 0xf6, 0x0e, 0x34, 0x42, 0xdc, 0x56, 0x8b, 0xa1, 0x9b,
+	0x5d, 0x96, 0xce, 0xe2, 0x6a, 0x6e, 0x41, 0x60, 0xe6, 0xd5, 0x02, 0xbb, 0x09, 0x0d, 0x65, 0x9b,
+	0x68, 0xb7, 0x0d, 0xae, 0x00, 0x14, 0x48, 0x78, 0x7c, 0x9c, 0x48, 0xc5, 0xf0, 0x06, 0xd7, 0xd0,
+	0xef, 0x41, 0x61, 0x8f, 0x00, 0xf0, 0x41, 0xdf, 0x47, 0xb7, 0x5e, 0xf9, 0x8c, 0xc7, 0xd0, 0xe6,
+	0xe2, 0x38, 0xdd, 0x0a, 0x83, 0x54, 0x9e, 0xa5, 0x6c, 0x15, 0x4c, 0xcf, 0x25, 0x01, 0x34, 0xb9,
+	0xe9, 0xb9, 0xf8, 0xe4, 0x93, 0x38, 0x9c, 0x47, 0xc4, 0xff, 0x2e, 0x57, 0x00, 0x09, 0xca, 0x75,
+	0xe3, 0x7e, 0x4d, 0x0b, 0xca, 0x75, 0x63, 0xe7, 0xef, 0x0c, 0x68, 0x3e, 0x95, 0xb3, 0x23, 0x19,
+	0x5f, 0xd8, 0xe4, 0x0e, 0x58, 0xb4, 0x6e, 0xe2, 0xb9, 0x7a, 0x9f, 0x16, 0xc1, 0x3b, 0xee, 0xb2,
+	0x9d, 0x90, 0xa1, 0xbe, 0x14, 0x28, 0x39, 0xa5, 0xc1, 0x1a, 0x42, 0x86, 0x8a, 0xd9, 0xc4, 0xc5,
+	0x27, 0x35, 0xd4, 0x84, 0x98, 0x6d, 0x4b, 0xe1, 0xb2, 0xb7, 0x51, 0x39, 0x93, 0x74, 0x32, 0x8f,
+	0x5c, 0x91, 0x4a, 0xf2, 0x7a, 0x75, 0x54, 0xc5, 0x24, 0x7d, 0x46, 0x18, 0xf6, 0x63, 0x78, 0x6d,
+	0xea, 0xcf, 0x13, 0x74, 0xbb, 0x5e, 0x70, 0x1c, 0x4e, 0xc2, 0xc0, 0x3f, 0x27, 0xa1, 0x58, 0xfc,
+	0x86, 0x9e, 0xd8, 0x09, 0x8e, 0xc3, 0xfd, 0xc0, 0x3f, 0x77, 0xfe, 0xdc, 0x84, 0xc6, 0x63, 0x7a,
+	0xe5, 0x03, 0x68, 0xcd, 0xe8, 0x41, 0x99, 0x8f, 0x18, 0x64, 0xdc, 0xa6, 0xf9, 0x0d, 0xf5, 0xda,
+	0x64, 0x14, 0xa4, 0xf1, 0x39, 0xcf, 0x48, 0x71, 0x55, 0x2a, 0x8e, 0x7c, 0x99, 0x26, 0x7d, 0x73,
+	0xd9, 0xaa, 0xb1, 0x9a, 0xd4, 0xab, 0x34, 0xe9, 0xe0, 0x4b, 0xe8, 0x94, 0xb7, 0xc3, 0x88, 0xf7,
+	0x52, 0x9e, 0x13, 0x0f, 0xeb, 0x1c, 0x87, 0xec, 0x87, 0xd0, 0x20, 0x37, 0x40, 0x1c, 0x6c, 0x6f,
+	0xae, 0x66, 0xbb, 0xaa, 0x65, 0x5c, 0x4d, 0x3e, 0x34, 0x3f, 0x33, 0x70, 0xaf, 0xf2, 0x21, 0xe5,
+	0xbd, 0xec, 0xab, 0xf7, 0x52, 0xcb, 0x4a, 0x7b, 0x39, 0x7f, 0x55, 0x83, 0xce, 0x9f, 0xc8, 0x38,
+	0x3c, 0x88, 0xc3, 0x28, 0x4c, 0x84, 0x5f, 0x92, 0x6d, 0x97, 0x64, 0xfb, 0x23, 0x68, 0xaa, 0x97,
+	0x5f, 0x72, 0x2f, 0x3d, 0x8b, 0x74, 0xea, 0xad, 0xfd, 0x5a, 0x95, 0x4e, 0x9f, 0xa9, 0x67, 0xd9,
+	0x3d, 0x80, 0x99, 0x38, 0xdb, 0x95, 0x22, 0x91, 0x3b, 0x2e, 0x29, 0x40, 0x9d, 0x97, 0x30, 0x6c,
+	0x00, 0xd6, 0x4c, 0x9c, 0x8d, 0xcf, 0x82, 0x71, 0x42, 0x5a, 0x50, 0xe7, 0x39, 0xcc, 0xee, 0x82,
+	0x3d, 0x13, 0x67, 0xa8, 0xce, 0x3b, 0xae, 0xd6, 0x82, 0x02, 0xc1, 0x7e, 0x00, 0xb5, 0xf4, 0x2c,
+	0xa0, 0x20, 0xd7, 0xde, 0xbc, 0x41, 0xd6, 0x30, 0x3e, 0x0b, 0xb4, 0xe2, 0x73, 0x9c, 0x63, 0xeb,
+	0x85, 0xec, 0xac, 0xb5, 0xda, 0x92, 0x5b, 0x66, 0xd3, 0xec, 0x01, 0xd8, 0xb1, 0x3c, 0x12, 0xbe,
+	0x08, 0xa6, 0x52, 0x47, 0xb8, 0x5b, 0x19, 0x2d, 0xcf, 0x26, 0x0e, 0x53, 0x91, 0x4a, 0x5e, 0x10,
+	0xb2, 0x77, 0xa0, 0x16, 0x79, 0x01, 0xc5, 0xb6, 0x4a, 0xc4, 0xc1, 0x3d, 0x0f, 0xbc, 0x80, 0xe3,
+	0x2c, 0xfb, 0x19, 0x74, 0x67, 0xe1, 0xa9, 0x9c, 0x44, 0x71, 0x78, 0x12, 0xcb, 0x24, 0xe9, 0xb7,
+	0x89, 0xfc, 0x66, 0xce, 0xd8, 0xf0, 0x54, 0x1e, 0xe8, 0x39, 0xde, 0x99, 0x95, 0x20, 0xe7, 0xdf,
+	0x6b, 0x70, 0x43, 0xab, 0xd1, 0x0b, 0x2f, 0xa2, 0xe3, 0x31, 0xbe, 0x93, 0x9f, 0x92, 0xb1, 0xd6,
+	0xa6, 0x0c, 0x64, 0xff, 0x1f, 0x9a, 0x64, 0x86, 0x99, 0xa2, 0xbe, 0x53, 0x15, 0x5d, 0xbe, 0x85,
+	0x52, 0x5c, 0xad, 0xb1, 0x7a, 0x09, 0xfb, 0x0c, 0x1a, 0xdf, 0xca, 0x38, 0x54, 0x3e, 0xb8, 0xbd,
+	0xe9, 0x5c, 0xb6, 0x16, 0x95, 0x47, 0x2f, 0x55, 0x0b, 0xfe, 0x0f, 0x25, 0xbc, 0x8e, 0x1e, 0x17,
+	0x19, 0xe2, 0xf6, 0x5b, 0x55, 0xf1, 0x69, 0x65, 0xcc, 0xa6, 0xd9, 0xbb, 0xd0, 0xcb, 0xa5, 0x32,
+	0x89, 0xc4, 0x3c, 0x91, 0x2e, 0xe5, 0x37, 0x16, 0xbf, 0x91, 0xe3, 0x0f, 0x08, 0x3d, 0xf8, 0x39,
+	0xb4, 0x4b, 0xef, 0x2f, 0x1b, 0x53, 0x57, 0x19, 0xd3, 0x3b, 0x55, 0x63, 0xea, 0x56, 0xcc, 0xbd,
+	0x6c, 0x97, 0x3f, 0x07, 0x28, 0xb8, 0xf1, 0xbb, 0x58, 0xb8, 0xf3, 0x02, 0x6e, 0x6c, 0x85, 0x41,
+	0x20, 0x29, 0x95, 0x53, 0x62, 0x2e, 0xec, 0xd0, 0xb8, 0xd2, 0x0e, 0x7f, 0x02, 0x8d, 0x04, 0x17,
+	0xe8, 0x43, 0x6e, 0x5f, 0x22, 0x37, 0xae, 0xa8, 0x9c, 0x7f, 0x30, 0xa1, 0xa9, 0xf4, 0xb3, 0xe2,
+	0xc5, 0x8d, 0xaa, 0x17, 0xbf, 0x0b, 0x76, 0x14, 0x4b, 0xd7, 0x9b, 0x66, 0x1b, 0xdb, 0xbc, 0x40,
+	0x60, 0x0c, 0x39, 0x0e, 0xe3, 0xa9, 0x24, 0xcb, 0xb7, 0xb8, 0x02, 0x30, 0x11, 0xa6, 0xf0, 0x48,
+	0xbe, 0x58, 0x39, 0x7a, 0x0b, 0x11, 0xe8, 0x84, 0x71, 0x49, 0x12, 0x89, 0xa9, 0x4a, 0x49, 0x6b,
+	0x5c, 0x01, 0x18, 0x18, 0x94, 0x00, 0xb5, 0xac, 0x34, 0xc4, 0xde, 0x83, 0x66, 0x12, 0xf9, 0x5e,
+	0x9a, 0xf4, 0xed, 0xb5, 0x5a, 0x39, 0x47, 0x55, 0x37, 0x3f, 0xc4, 0x39, 0xae, 0x49, 0xf0, 0x19,
+	0x74, 0xee, 0xd7, 0x51, 0x42, 0x86, 0x68, 0x70, 0x0a, 0xd3, 0x5f, 0x45, 0x94, 0x9b, 0x7f, 0x13,
+	0x7b, 0xa9, 0xa4, 0xb9, 0x36, 0xcd, 0x59, 0x84, 0xc0, 0xc9, 0x5b, 0xd0, 0x8c, 0xbc, 0x20, 0x90,
+	0x6e, 0xbf, 0xa3, 0x0e, 0x57, 0x10, 0x5b, 0x87, 0x3a, 0x5d, 0xa9, 0x7b, 0x85, 0x95, 0x12, 0x85,
+	0xf3, 0xcf, 0x26, 0x74, 0xb6, 0xbd, 0x58, 0x4e, 0x53, 0xe9, 0x8e, 0xdc, 0x13, 0x7a, 0x8f, 0x0c,
+	0x52, 0x2f, 0x3d, 0xd7, 0xb1, 0x52, 0x43, 0x79, 0x1e, 0x64, 0x56, 0xab, 0x09, 0xa5, 0x1c, 0x35,
+	0x2a, 0x82, 0x14, 0xc0, 0x3e, 0x01, 0xa0, 0x81, 0x2a, 0x84, 0xea, 0x57, 0x17, 0x42, 0x36, 0x91,
+	0xe2, 0x10, 0x99, 0xa0, 0xd6, 0x79, 0x2a, 0x96, 0x36, 0xa9, 0x4a, 0x9a, 0xa3, 0xf9, 0x51, 0x72,
+	0x75, 0x24, 0x7d, 0x32, 0x2f, 0x4a, 0xae, 0x8e, 0xa4, 0x9f, 0xe7, 0xbd, 0x2d, 0x75, 0x25, 0x1c,
+	0xb3, 0xfb, 0x60, 0x86, 0x51, 0xdf, 0xaa, 0x1e, 0x5a, 0x7e, 0xe0, 0xc6, 0x7e, 0xc4, 0xcd, 0x30,
+	0x62, 0x0e, 0x34, 0x55, 0xa6, 0xaf, 0xe5, 0x03, 0xe4, 0x7c, 0x29, 0xd5, 0xe4, 0x7a, 0x46, 0x67,
+	0xff, 0x5e, 0x2c, 0x93, 0x89, 0x48, 0x49, 0x30, 0x75, 0x6e, 0x6b, 0xcc, 0x30, 0x75, 0xde, 0x06,
+	0x73, 0x3f, 0x62, 0x2d, 0xa8, 0x1d, 0x8e, 0xc6, 0xbd, 0x15, 0x1c, 0x6c, 0x8f, 0x76, 0x7b, 0x06,
+	0x0e, 0x76, 0xf6, 0xb6, 0x7a, 0xa6, 0xf3, 0x2b, 0x13, 0xec, 0xa7, 0xf3, 0x54, 0xa0, 0x45, 0x24,
+	0x57, 0xe9, 0xea, 0x1d, 0xb0, 0x92, 0x54, 0xc4, 0xe9, 0x84, 0x02, 0x34, 0x39, 0x44, 0x82, 0xc7,
+	0x09, 0xfb, 0x31, 0x34, 0xa4, 0x7b, 0x22, 0x33, 0x9f, 0x76, 0x73, 0xd9, 0x9b, 0xb8, 0x22, 0x61,
+	0xef, 0x43, 0x33, 0x99, 0xbe, 0x90, 0x33, 0xd1, 0xaf, 0x57, 0x89, 0x0f, 0x09, 0xab, 0x12, 0x0f,
+	0xae, 0x69, 0xf0, 0x50, 0x37, 0x0e, 0x23, 0xaa, 0x6c, 0x1a, 0xba, 0xb0, 0x8b, 0xc3, 0x08, 0xeb,
+	0x9a, 0x4d, 0x78, 0xc3, 0x3b, 0x09, 0xc2, 0x58, 0x4e, 0xbc, 0xc0, 0x95, 0x67, 0x93, 0x69, 0x18,
+	0x1c, 0xfb, 0xde, 0x34, 0x25, 0xfe, 0x5b, 0xfc, 0x75, 0x35, 0xb9, 0x83, 0x73, 0x5b, 0x7a, 0x8a,
+	0xfd, 0x00, 0x3a, 0xb4, 0x9d, 0x17, 0x9c, 0x0a, 0xdf, 0x73, 0x75, 0xe1, 0xd6, 0x46, 0xdc, 0x8e,
+	0x42, 0x39, 0xf7, 0xc1, 0x7e, 0x22, 0xcf, 0xa9, 0x86, 0x48, 0xd8, 0x00, 0xcc, 0x97, 0xa7, 0x3a,
+	0x89, 0x81, 0xec, 0xa2, 0x4f, 0x9e, 0x73, 0xf3, 0xe5, 0xa9, 0xf3, 0x2f, 0x26, 0x58, 0x97, 0x46,
+	0xf7, 0x0f, 0xc0, 0x9e, 0x65, 0x4c, 0xed, 0x9b, 0xd5, 0xb0, 0x95, 0x73, 0x9b, 0x17, 0x34, 0xec,
+	0x43, 0x68, 0xa7, 0x67, 0xc1, 0x64, 0xaa, 0xa2, 0x6a, 0xbf, 0xb6, 0x3c, 0xd8, 0x42, 0x9a, 0x8f,
+	0xf5, 0xdd, 0xea, 0xcb, 0xee, 0x56, 0x38, 0xab, 0xc6, 0xab, 0x38, 0x2b, 0x76, 0x1f, 0x6e, 0x4c,
+	0x7d, 0x29, 0x82, 0x49, 0xe1, 0x8c, 0x94, 0x12, 0xaf, 0x12, 0xfa, 0x20, 0xc3, 0xb2, 0x4f, 0xd0,
+	0x91, 0x50, 0xf1, 0xaf, 0xb2, 0x81, 0x7b, 0x45, 0xe8, 0x46, 0x6c, 0x4e, 0x78, 0x20, 0xce, 0xfd,
+	0x50, 0xb8, 0x5c, 0x53, 0xb3, 0x8f, 0xd1, 0x2d, 0xf9, 0x5e, 0xaa, 0x6b, 0xe1, 0xb7, 0x72, 0x99,
+	0x23, 0xf2, 0xc2, 0x2a, 0x45, 0xeb, 0xfc, 0x29, 0x98, 0x4f, 0x9e, 0x97, 0xdd, 0x7d, 0x47, 0xb9,
+	0x7b, 0xdd, 0xd4, 0x30, 0x8b, 0xa6, 0xc6, 0x00, 0xac, 0x79, 0x22, 0xe3, 0xa7, 0x32, 0x15, 0xda,
+	0xcc, 0x73, 0x18, 0xc3, 0x38, 0x56, 0xe5, 0x5e, 0x18, 0xe8, 0x90, 0x99, 0x81, 0xce, 0x03, 0x30,
+	0x9f, 0x6c, 0x2d, 0xd9, 0xff, 0x2e, 0xd8, 0xa9, 0x37, 0x93, 0x49, 0x2a, 0x66, 0x91, 0xd6, 0xf4,
+	0x02, 0xe1, 0x3c, 0x02, 0x9b, 0x02, 0xd4, 0x13, 0x79, 0x7e, 0xa5, 0xb9, 0xdc, 0x83, 0xfa, 0x4b,
+	0x79, 0x9e, 0xa5, 0x08, 0x85, 0x80, 0xb6, 0x38, 0xe1, 0x9d, 0x5f, 0xd7, 0xa1, 0xa5, 0x1d, 0x0d,
+	0xde, 0x61, 0x9e, 0x27, 0xfe, 0x38, 0x2c, 0xbc, 0x96, 0x59, 0xf6, 0x5a, 0xe5, 0xe6, 0x4d, 0xed,
+	0xd5, 0x9a, 0x37, 0xec, 0x0f, 0xa1, 0x13, 0xa9, 0xb9, 0xb2, 0xaf, 0x7b, 0x73, 0x71, 0x9d, 0xfe,
+	0xa5, 0xb5, 0xed, 0xa8, 0x00, 0xf0, 0x89, 0x54, 0xc0, 0xa6, 0xe2, 0x84, 0xb4, 0xa9, 0xc3, 0x5b,
+	0x08, 0x8f, 0xc5, 0xc9, 0x25, 0x1e, 0xef, 0x77, 0x77, 0x5a, 0x68, 0x4d, 0x61, 0x44, 0xe1, 0xa2,
+	0x4b, 0x7e, 0xb0, 0xec, 0x7a, 0xba, 0x55, 0xd7, 0xf3, 0x26, 0xd8, 0xd3, 0x70, 0x36, 0xf3, 0x68,
+	0x6e, 0x95, 0xe6, 0x2c, 0x85, 0x18, 0x27, 0xce, 0xb7, 0xd0, 0xd2, 0xfc, 0x60, 0x6d, 0x68, 0x6d,
+	0x8f, 0x1e, 0x0d, 0x9f, 0xed, 0xa2, 0x17, 0x04, 0x68, 0x7e, 0xb1, 0xb3, 0x37, 0xe4, 0xbf, 0xcc,
+	0x1c, 0xe1, 0xb8, 0x67, 0x32, 0x1b, 0x1a, 0x8f, 0x76, 0xf7, 0x87, 0xe3, 0x5e, 0x8d, 0x59, 0x50,
+	0xff, 0x62, 0x7f, 0x7f, 0xb7, 0x57, 0x67, 0x1d, 0xb0, 0xb6, 0x87, 0xe3, 0xd1, 0x78, 0xe7, 0xe9,
+	0xa8, 0xd7, 0x40, 0xda, 0xc7, 0xa3, 0xfd, 0x5e, 0x13, 0x07, 0xcf, 0x76, 0xb6, 0x7b, 0x2d, 0x9c,
+	0x3f, 0x18, 0x1e, 0x1e, 0xfe, 0x62, 0x9f, 0x6f, 0xf7, 0x2c, 0xdc, 0xf7, 0x70, 0xcc, 0x77, 0xf6,
+	0x1e, 0xf7, 0x6c, 0xe7, 0x23, 0x68, 0x97, 0x78, 0x8a, 0x2b, 0xf8, 0xe8, 0x51, 0x6f, 0x05, 0x8f,
+	0x79, 0x3e, 0xdc, 0x7d, 0x36, 0xea, 0x19, 0x6c, 0x15, 0x80, 0x86, 0x93, 0xdd, 0xe1, 0xde, 0xe3,
+	0x9e, 0xe9, 0xfc, 0x99, 0x91, 0xaf, 0xa1, 0x9e, 0xc9, 0x7b, 0x60, 0x69, 0x49, 0x64, 0x85, 0xd4,
+	0x8d, 0x05, 0xb1, 0xf1, 0x9c, 0x00, 0x6d, 0x60, 0xfa, 0x42, 0x4e, 0x5f, 0x26, 0xf3, 0x99, 0x56,
+	0x9a, 0x1c, 0x56, 0xad, 0x0f, 0xe4, 0x09, 0x69, 0x4d, 0x9d, 0x6b, 0x28, 0xef, 0x1f, 0xd6, 0x89,
+	0x9e, 0xc6, 0xce, 0x03, 0x80, 0xa2, 0x43, 0xb5, 0xa4, 0x04, 0xba, 0x09, 0x0d, 0xe1, 0x7b, 0x22,
+	0xd1, 0x41, 0x56, 0x01, 0x0e, 0x87, 0x76, 0xb1, 0x8a, 0xec, 0x42, 0xf8, 0xfe, 0x84, 0x0c, 0xc0,
+	0x50, 0x6e, 0x5b, 0xf8, 0x3e, 0x99, 0xcc, 0x3a, 0x34, 0x54, 0x5b, 0xcc, 0x5c, 0xd2, 0x40, 0xa1,
+	0xe5, 0x5c, 0x11, 0x38, 0xef, 0x43, 0xf3, 0x91, 0x52, 0x97, 0x42, 0xa5, 0x8c, 0xcb, 0x54, 0xca,
+	0xf9, 0x1c, 0xa0, 0xe8, 0xc1, 0xb0, 0x0f, 0x74, 0x0b, 0x2e, 0x51, 0x8d, 0x3f, 0xa3, 0x9a, 0xd5,
+	0x2a, 0x42, 0xdd, 0x7d, 0xa3, 0x05, 0xce, 0x36, 0x58, 0x57, 0x36, 0x38, 0x35, 0x23, 0xcc, 0x82,
+	0x11, 0x4b, 0x5a, 0x9e, 0x4e, 0x0c, 0x50, 0xb4, 0xe9, 0xb4, 0x1a, 0xab, 0x5d, 0x50, 0x8d, 0x37,
+	0x50, 0x44, 0x9e, 0xef, 0xc6, 0x32, 0xb8, 0xf0, 0xfa, 0x7c, 0x15, 0xcf, 0x69, 0xd8, 0x0f, 0xa1,
+	0x4e, 0xdd, 0x48, 0x15, 0x0c, 0xf2, 0xa6, 0x51, 0x76, 0x4f, 0x4e, 0xb3, 0xce, 0x11, 0x74, 0x55,
+	0xe8, 0xe4, 0xf2, 0xeb, 0xb9, 0x4c, 0xd2, 0xab, 0x9d, 0x12, 0xe4, 0x2e, 0x3e, 0xeb, 0xaf, 0x96,
+	0x30, 0xa8, 0x28, 0xc7, 0x9e, 0xf4, 0xdd, 0xec, 0x55, 0x1a, 0x72, 0x3e, 0x85, 0x4e, 0x76, 0x06,
+	0xb5, 0x54, 0xee, 0xe7, 0x41, 0x3c, 0xd3, 0x4b, 0x14, 0x88, 0x22, 0xd9, 0x0b, 0xdd, 0x3c, 0x7e,
+	0x3b, 0xff, 0x6d, 0x42, 0xa7, 0x1c, 0xd8, 0xab, 0x19, 0xaf, 0xb1, 0x98, 0xf1, 0x56, 0xd3, 0x32,
+	0xf3, 0x95, 0xd3, 0xb2, 0x3f, 0x00, 0xdb, 0xa5, 0x5c, 0xc3, 0x3b, 0xcd, 0x3c, 0xe3, 0xbd, 0x65,
+	0x79, 0x85, 0xce, 0x48, 0xbc, 0x53, 0xc9, 0x8b, 0x05, 0xe4, 0xf0, 0xc3, 0x97, 0x32, 0xf0, 0xbe,
+	0xa5, 0xd6, 0x09, 0x3e, 0xbc, 0x40, 0x14, 0xcd, 0x2b, 0x95, 0x7f, 0x28, 0x80, 0xf2, 0x3a, 0xd4,
+	0x2c, 0x95, 0x6c, 0xd0, 0x98, 0x6d, 0x02, 0x4c, 0xc3, 0x20, 0x49, 0x63, 0xe1, 0x05, 0x59, 0xa8,
+	0xcb, 0x25, 0xbc, 0x95, 0xcf, 0xf0, 0x12, 0x15, 0xea, 0x55, 0x9a, 0xfa, 0x54, 0x09, 0xd7, 0x39,
+	0x0e, 0x9d, 0x9f, 0x81, 0x9d, 0xdf, 0x12, 0x3d, 0xd1, 0xde, 0xfe, 0xde, 0x48, 0xf9, 0x8d, 0x9d,
+	0xbd, 0xed, 0xd1, 0x1f, 0xf7, 0x0c, 0xf4, 0x65, 0x7c, 0xf4, 0x7c, 0xc4, 0x0f, 0x47, 0x3d, 0x13,
+	0x7d, 0xce, 0xf6, 0x68, 0x77, 0x34, 0x1e, 0xf5, 0x6a, 0x5f, 0xd6, 0xad, 0x56, 0xcf, 0xe2, 0x96,
+	0x3c, 0x8b, 0x7c, 0x6f, 0xea, 0xa5, 0xce, 0xaf, 0x0d, 0x80, 0xe2, 0x5c, 0xec, 0x03, 0xbd, 0x10,
+	0xc9, 0x64, 0xe6, 0x05, 0xda, 0x28, 0x9b, 0x2f, 0x44, 0xf2, 0xd4, 0x0b, 0xf0, 0x12, 0x88, 0x34,
+	0x29, 0x73, 0xc7, 0x61, 0x4e, 0x2a, 0xce, 0xfa, 0xb5, 0x82, 0x54, 0x9c, 0x11, 0xa9, 0x38, 0xeb,
+	0xd7, 0x35, 0xa9, 0x38, 0xc3, 0x00, 0x1b, 0x89, 0x14, 0xdf, 0x48, 0x1c, 0xb2, 0x79, 0x06, 0x22,
+	0x8f, 0x64, 0x30, 0x9f, 0x51, 0xd3, 0xd3, 0xe6, 0x34, 0x76, 0x7e, 0x09, 0xd6, 0x53, 0x11, 0x5d,
+	0xa8, 0xe4, 0x8a, 0xd0, 0x3e, 0xd7, 0xbd, 0x2e, 0x1d, 0x08, 0xdf, 0x85, 0x96, 0x76, 0x71, 0x79,
+	0x4e, 0xb4, 0xe0, 0x02, 0xb3, 0x79, 0xe7, 0xb7, 0x06, 0xdc, 0x5a, 0x9e, 0x87, 0x5c, 0xa3, 0x75,
+	0x77, 0xc0, 0x0a, 0xe4, 0x37, 0x13, 0xb2, 0x79, 0x65, 0xe0, 0xad, 0x40, 0x7e, 0xb3, 0x87, 0x66,
+	0x5f, 0xb6, 0xa5, 0x5a, 0xd5, 0x96, 0xf2, 0x1c, 0xab, 0xfe, 0x4a, 0x05, 0xe1, 0x6f, 0x4c, 0xb8,
+	0xa9, 0x6a, 0x9b, 0xff, 0xd5, 0xdd, 0x7e, 0x04, 0x37, 0x92, 0x70, 0x1e, 0x4f, 0xe5, 0x64, 0xa1,
+	0x13, 0xd8, 0x55, 0xe8, 0xc7, 0xfa, 0x36, 0x0e, 0x74, 0x5d, 0x99, 0xa4, 0x93, 0x85, 0xdb, 0xb6,
+	0x11, 0xf9, 0xf8, 0x7b, 0xdd, 0x18, 0x43, 0xab, 0x8a, 0xba, 0xf3, 0xbc, 0xd8, 0x51, 0x61, 0x18,
+	0xbf, 0xb8, 0xdc, 0x86, 0x96, 0x0c, 0x5c, 0x9a, 0x6a, 0x66, 0x35, 0x98, 0x8b, 0x13, 0x6f, 0x01,
+	0xc4, 0x32, 0x99, 0xcf, 0x24, 0x7a, 0x7f, 0x4a, 0x13, 0x3b, 0xdc, 0x56, 0x98, 0x27, 0xca, 0x6b,
+	0xc6, 0x78, 0x05, 0x8b, 0x14, 0x9e, 0xc6, 0xce, 0x5f, 0xd4, 0xa0, 0x3b, 0x3a, 0x8b, 0xc2, 0x38,
+	0xcd, 0x78, 0xf2, 0x06, 0xe6, 0x99, 0x5f, 0x67, 0x0e, 0xac, 0xce, 0x1b, 0xb1, 0xfc, 0x7a, 0xe7,
+	0xca, 0x7e, 0xe8, 0x03, 0x68, 0xe2, 0xad, 0xe7, 0x89, 0x36, 0xff, 0xbb, 0xd9, 0xe3, 0x2a, 0x1b,
+	0x6f, 0x1c, 0x12, 0x0d, 0xd7, 0xb4, 0xe5, 0x56, 0x73, 0xbd, 0xd2, 0x6a, 0xbe, 0x05, 0xcd, 0x23,
+	0x31, 0x7d, 0x39, 0x8f, 0xb4, 0x4e, 0x6b, 0x88, 0x32, 0x11, 0x0f, 0x7b, 0x1f, 0x69, 0xa2, 0xdb,
+	0x28, 0x2d, 0x82, 0xd5, 0x92, 0xe3, 0x30, 0x9e, 0x89, 0x54, 0xd7, 0x7a, 0x1a, 0x5a, 0xf0, 0xb9,
+	0xd6, 0x05, 0x9f, 0xdb, 0x83, 0x9a, 0xeb, 0xa9, 0xaf, 0x3d, 0x36, 0xc7, 0x21, 0xd5, 0xfd, 0x9e,
+	0x2f, 0xb1, 0xcc, 0x46, 0x62, 0x05, 0x60, 0xb1, 0xae, 0xbf, 0x5f, 0xb4, 0xab, 0xc5, 0xba, 0x7a,
+	0x21, 0x7d, 0xed, 0xca, 0x3e, 0x6a, 0x38, 0x0f, 0xa1, 0xa9, 0x9e, 0x5a, 0xf2, 0x20, 0x6d, 0x68,
+	0x1d, 0x3e, 0xdb, 0xda, 0x1a, 0x1d, 0x1e, 0xf6, 0x0c, 0xd6, 0x05, 0x7b, 0xfb, 0xd9, 0xc1, 0xee,
+	0xce, 0xd6, 0x70, 0xac, 0xbd, 0xc8, 0xa3, 0xe1, 0xce, 0xee, 0x68, 0xbb, 0x57, 0x73, 0xfe, 0xd6,
+	0x80, 0xf6, 0x7e, 0x2c, 0xa6, 0xbe, 0xdc, 0x96, 0x7e, 0x2a, 0xd8, 0x43, 0x68, 0xa9, 0x7c, 0x21,
+	0x0b, 0xbf, 0x6b, 0xc5, 0x17, 0x81, 0x9c, 0x6a, 0x63, 0x4b, 0x91, 0xe8, 0xf6, 0xac, 0x5e, 0x80,
+	0x4c, 0x11, 0x47, 0x61, 0xac, 0x7b, 0xba, 0x75, 0xae, 0x21, 0xec, 0x3c, 0xcf, 0xc4, 0xd9, 0x24,
+	0x92, 0x81, 0x9b, 0x99, 0xb6, 0x6a, 0x66, 0x1d, 0x28, 0xcc, 0xe0, 0x21, 0x74, 0xca, 0x3b, 0x2e,
+	0xe9, 0xfa, 0x54, 0x52, 0xe4, 0x7a, 0xb9, 0xcb, 0xf3, 0x36, 0x74, 0xb1, 0xeb, 0x95, 0xa5, 0xec,
+	0x09, 0x06, 0x62, 0x7d, 0xf9, 0x3a, 0x37, 0xd3, 0xc4, 0xb9, 0x0d, 0xb5, 0xbd, 0xf9, 0xac, 0xfc,
+	0x75, 0xb4, 0x4e, 0x85, 0x84, 0x33, 0x84, 0x76, 0x89, 0x9b, 0xd7, 0x98, 0x66, 0x1e, 0x18, 0xf4,
+	0x05, 0x08, 0x70, 0x46, 0xd0, 0x2e, 0x75, 0x4f, 0xaa, 0x46, 0x64, 0x2c, 0x18, 0xd1, 0xe5, 0xfa,
+	0xec, 0xfc, 0xa5, 0x01, 0x6f, 0x2c, 0xad, 0x8e, 0xae, 0xf7, 0x65, 0x97, 0x99, 0xc8, 0xab, 0x76,
+	0x92, 0x2f, 0x33, 0x0a, 0x67, 0x1d, 0x56, 0xab, 0x2d, 0x5a, 0xea, 0xed, 0xa8, 0x26, 0xa0, 0x0e,
+	0x28, 0x0a, 0x72, 0x76, 0xe1, 0x35, 0xf4, 0x75, 0x7a, 0x63, 0x9d, 0x97, 0x7c, 0xdf, 0x8b, 0x3b,
+	0xff, 0x65, 0x00, 0xa8, 0xad, 0x70, 0xd3, 0x52, 0xad, 0x5d, 0xa7, 0x5a, 0xfb, 0xea, 0x26, 0x1a,
+	0x0a, 0x20, 0x9e, 0x2a, 0xbf, 0xa8, 0x9d, 0x22, 0x7e, 0xe7, 0x55, 0x5f, 0x2a, 0xde, 0x04, 0xdb,
+	0xcd, 0x9c, 0x26, 0x3d, 0xb6, 0xcb, 0x2d, 0x57, 0x3b, 0x4c, 0x7c, 0xdc, 0x4c, 0x04, 0x73, 0x91,
+	0x75, 0x1e, 0x34, 0x84, 0x78, 0xed, 0x6a, 0x54, 0xdd, 0xa3, 0x21, 0xd4, 0x07, 0x19, 0xc7, 0x61,
+	0xac, 0xed, 0x5f, 0x01, 0xe8, 0x0f, 0x49, 0xde, 0xd2, 0xc5, 0x52, 0xc7, 0xa2, 0xb6, 0x9c, 0xad,
+	0x31, 0xc3, 0x14, 0x0d, 0xe1, 0xd8, 0x0b, 0xbc, 0xe4, 0x85, 0x9a, 0xb7, 0x69, 0x1e, 0x32, 0xd4,
+	0x30, 0x75, 0x3e, 0x85, 0x76, 0xf1, 0x76, 0x4a, 0x9f, 0xb1, 0x27, 0x96, 0x99, 0x22, 0xab, 0xca,
+	0x10, 0x69, 0xb8, 0x22, 0x70, 0x86, 0x60, 0x2b, 0xe4, 0x81, 0x17, 0x5c, 0xc3, 0xfb, 0xa2, 0x45,
+	0x67, 0x96, 0x5b, 0x74, 0xce, 0x7f, 0x18, 0xd0, 0x29, 0xf7, 0xe3, 0xae, 0xd9, 0xa6, 0xc2, 0x4d,
+	0x73, 0x81, 0x9b, 0x54, 0x47, 0x26, 0x29, 0x45, 0x85, 0x5a, 0x56, 0x47, 0x26, 0xa9, 0x8e, 0x09,
+	0x54, 0x29, 0x28, 0x6d, 0xa3, 0x31, 0x32, 0xf3, 0xe8, 0x1c, 0x1d, 0xa6, 0xea, 0x64, 0x2b, 0x60,
+	0x81, 0x99, 0xcd, 0x45, 0x66, 0xbe, 0x05, 0xa0, 0x3e, 0x65, 0xd1, 0xb4, 0x6a, 0x81, 0xda, 0x1a,
+	0x33, 0xc4, 0x8e, 0x89, 0x25, 0xd2, 0x54, 0xce, 0x22, 0xfa, 0x4c, 0x41, 0xd7, 0xcb, 0xe0, 0xcd,
+	0x5f, 0x19, 0x50, 0xc7, 0x6e, 0x38, 0x26, 0xdd, 0xa3, 0xe9, 0x8b, 0x90, 0xa9, 0xcf, 0x7e, 0xda,
+	0xe8, 0x06, 0x15, 0xc8, 0x59, 0x61, 0xef, 0xa9, 0xaf, 0x7f, 0xd9, 0x27, 0xd3, 0xab, 0x89, 0x37,
+	0xa1, 0xfd, 0x65, 0xe8, 0x05, 0x5b, 0xea, 0x83, 0x19, 0xcb, 0x1d, 0x77, 0xe9, 0xfb, 0xe1, 0xe2,
+	0x9a, 0xcd, 0xff, 0x6c, 0x40, 0x1d, 0x9b, 0xde, 0xf8, 0x59, 0x4c, 0xb7, 0xac, 0xd9, 0x42, 0x6b,
+	0x7a, 0x70, 0xbb, 0x94, 0x5b, 0x96, 0x7b, 0xda, 0xce, 0x0a, 0x36, 0x6a, 0x74, 0xc2, 0x5d, 0x6d,
+	0xab, 0x0f, 0x2e, 0x0b, 0xfa, 0xce, 0xca, 0xba, 0xf1, 0xa1, 0xc1, 0x3e, 0x80, 0xa6, 0x72, 0xea,
+	0x0b, 0x4f, 0x7a, 0x7d, 0x89, 0xcb, 0x77, 0x56, 0x68, 0x41, 0xfb, 0xf0, 0x45, 0x38, 0xf7, 0xdd,
+	0x43, 0x19, 0xa3, 0x6d, 0x56, 0xf5, 0x71, 0xb0, 0x00, 0x3b, 0x2b, 0xec, 0x27, 0x00, 0xc3, 0x24,
+	0xf1, 0x4e, 0x82, 0x67, 0x9e, 0x9b, 0xb0, 0x76, 0x36, 0xbf, 0x37, 0x9f, 0x0d, 0x7a, 0x74, 0xa4,
+	0x9a, 0x95, 0xee, 0x8e, 0x9b, 0x28, 0xf2, 0x92, 0x23, 0xbf, 0x96, 0xfc, 0x63, 0xe8, 0xaa, 0xb0,
+	0xb1, 0x1f, 0x0f, 0x31, 0xd2, 0xb0, 0xc5, 0x16, 0xda, 0x60, 0x11, 0xe1, 0xac, 0xb0, 0x87, 0x60,
+	0x8d, 0xe3, 0x73, 0x45, 0xff, 0x46, 0x7e, 0xe1, 0x72, 0x04, 0x19, 0x2c, 0x47, 0x3b, 0x2b, 0xec,
+	0x5d, 0x68, 0xe7, 0xf0, 0x30, 0xad, 0x5e, 0xb0, 0x0c, 0x38, 0x2b, 0x6c, 0x08, 0x37, 0x16, 0xd2,
+	0x53, 0x76, 0x4d, 0xff, 0xec, 0x82, 0x26, 0x7d, 0x0e, 0x50, 0xf8, 0x55, 0x76, 0xa7, 0xdc, 0x33,
+	0xaf, 0xf8, 0xda, 0xc1, 0x12, 0xbf, 0xe0, 0xac, 0xb0, 0x0d, 0xb0, 0x0f, 0xbc, 0x40, 0xaf, 0xbe,
+	0xf8, 0x19, 0xed, 0xc2, 0x71, 0x3f, 0x85, 0xd5, 0xc7, 0x32, 0x2d, 0xbb, 0x9f, 0x4b, 0xb4, 0xa2,
+	0x44, 0x42, 0xfc, 0x64, 0x5c, 0x62, 0x14, 0xad, 0xf8, 0x8e, 0xa5, 0x1d, 0xfe, 0x0b, 0x7a, 0xff,
+	0xdb, 0x06, 0x34, 0x7f, 0x11, 0xc6, 0x2f, 0x65, 0xcc, 0x36, 0xa0, 0x49, 0xad, 0x52, 0xc9, 0x2e,
+	0xb6, 0x4e, 0x97, 0x89, 0xf1, 0xc3, 0x6b, 0x65, 0xbf, 0xf8, 0xbe, 0xf7, 0xc1, 0x26, 0xb5, 0xc5,
+	0xbf, 0xae, 0x14, 0x86, 0x42, 0xff, 0x4c, 0x2a, 0x34, 0x57, 0x95, 0xbc, 0xc4, 0xfc, 0x5b, 0xb9,
+	0x80, 0x86, 0x81, 0xab, 0xea, 0xca, 0x6d, 0x91, 0x0a, 0xf6, 0x5a, 0xc5, 0xc6, 0xb0, 0xcd, 0x31,
+	0x28, 0x75, 0x64, 0xb5, 0x69, 0x7d, 0x04, 0x75, 0xfc, 0x53, 0x42, 0x61, 0xfe, 0xa5, 0xff, 0x5c,
+	0x0c, 0x58, 0x19, 0x99, 0x9f, 0xf8, 0x29, 0x34, 0xd5, 0x29, 0x85, 0x5a, 0x56, 0x4a, 0xfd, 0xc1,
+	0xcd, 0x45, 0xb4, 0x5e, 0x78, 0x1f, 0xac, 0xa7, 0x5e, 0xa0, 0x3e, 0xfd, 0x55, 0x45, 0xb6, 0xa0,
+	0x93, 0x9f, 0x41, 0x53, 0x25, 0x3c, 0xc5, 0x09, 0x95, 0x84, 0x79, 0xb0, 0x1c, 0x4d, 0xdc, 0xee,
+	0x71, 0x39, 0x95, 0x5e, 0xa9, 0xa2, 0x61, 0xa5, 0x47, 0x2f, 0xf2, 0x7a, 0xdd, 0x60, 0x9f, 0x43,
+	0xb7, 0x52, 0x00, 0xb1, 0xbb, 0x55, 0x8d, 0xb8, 0x46, 0xf7, 0x7f, 0x0f, 0xe6, 0xf3, 0x47, 0xb0,
+	0x5a, 0xcd, 0xa9, 0xd8, 0xd5, 0x9d, 0xe8, 0xc5, 0x0d, 0xbe, 0xe8, 0xfd, 0xfd, 0x77, 0xf7, 0x8c,
+	0x7f, 0xfa, 0xee, 0x9e, 0xf1, 0xaf, 0xdf, 0xdd, 0x33, 0x7e, 0xf3, 0x6f, 0xf7, 0x56, 0x8e, 0x9a,
+	0xf4, 0x8f, 0xbc, 0x8f, 0xff, 0x67, 0x00, 0xdc, 0x1d, 0x7d, 0x6f, 0xb6, 0x27, 0x00, 0x00,
 }
@@ -47,7 +47,8 @@ func ApplyMutations(ctx context.Context, m *intern.Mutations) (*api.TxnContext,
 func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEdge, error) {
 	edges := make([]*intern.DirectedEdge, 0, 2*len(m.Edges))
 	for _, edge := range m.Edges {
-		x.AssertTrue(edge.Op == intern.DirectedEdge_DEL || edge.Op == intern.DirectedEdge_SET)
+		x.AssertTrue(edge.Op == intern.DirectedEdge_DEL || edge.Op == intern.DirectedEdge_SET ||
+			edge.Op == intern.DirectedEdge_INC)
 
 		var preds []string
 		if edge.Attr != x.Star {
@@ -65,7 +66,7 @@ func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEd
 					edge.GetEntity())
 			}
 			for _, tv := range valMatrix[0].Values {
-				if len(tv.Val) > 0 {
+				if len(tv.Val) > 0 && x.PredicateAllowed(ctx, string(tv.Val)) {
 					preds = append(preds, string(tv.Val))
 				}
 			}
@@ -76,8 +77,12 @@ func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEd
 			edgeCopy.Attr = pred
 			edges = append(edges, &edgeCopy)
 
+			op := edge.Op
+			if op == intern.DirectedEdge_INC {
+				op = intern.DirectedEdge_SET
+			}
 			e := &intern.DirectedEdge{
-				Op:     edge.Op,
+				Op:     op,
 				Entity: edge.GetEntity(),
 				Attr:   "_predicate_",
 				Value:  []byte(pred),
@@ -188,7 +193,11 @@ func ToInternal(gmu *gql.Mutation,
 		if err := facets.SortAndValidate(nq.Facets); err != nil {
 			return edges, err
 		}
-		if err := parse(nq, intern.DirectedEdge_SET); err != nil {
+		op := intern.DirectedEdge_SET
+		if nq.Inc {
+			op = intern.DirectedEdge_INC
+		}
+		if err := parse(nq, op); err != nil {
 			return edges, err
 		}
 	}
@@ -196,6 +205,9 @@ func ToInternal(gmu *gql.Mutation,
 		if nq.Subject == x.Star && nq.ObjectValue.GetDefaultVal() == x.Star {
 			return edges, errors.New("Predicate deletion should be called via alter.")
 		}
+		if nq.Inc {
+			return edges, errors.New("Increments can only be part of set mutations.")
+		}
 		if err := parse(nq, intern.DirectedEdge_DEL); err != nil {
 			return edges, err
 		}
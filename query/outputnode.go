@@ -487,8 +487,9 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 }
 
 type Extensions struct {
-	Latency *api.Latency    `json:"server_latency,omitempty"`
-	Txn     *api.TxnContext `json:"txn,omitempty"`
+	Latency  *api.Latency    `json:"server_latency,omitempty"`
+	Txn      *api.TxnContext `json:"txn,omitempty"`
+	Warnings []string        `json:"warnings,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
@@ -1763,6 +1763,9 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 
 		up := uniquePreds(child.ExpandPreds)
 		for pred, _ := range up {
+			if !x.PredicateAllowed(ctx, pred) {
+				continue
+			}
 			temp := &SubGraph{
 				ReadTs:  sg.ReadTs,
 				LinRead: sg.LinRead,
@@ -2552,6 +2555,14 @@ func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
 	return nil
 }
 
+// UidVar returns the uids bound to the variable by ProcessQuery.
+func (req *QueryRequest) UidVar(name string) []uint64 {
+	if v, ok := req.vars[name]; ok && v.Uids != nil {
+		return v.Uids.Uids
+	}
+	return nil
+}
+
 var MutationNotAllowedErr = x.Errorf("Mutations are forbidden on this server.")
 
 type InvalidRequestError struct {
@@ -5849,6 +5849,34 @@ func (s *zeroServer) TryAbort(ctx context.Context, txns *intern.TxnTimestamps) (
 	return &intern.TxnTimestamps{}, nil
 }
 
+func (s *zeroServer) TimestampAt(ctx context.Context, num *intern.Num) (*intern.Num, error) {
+	return &intern.Num{}, nil
+}
+
+func (s *zeroServer) RenamePredicate(ctx context.Context,
+	in *intern.RenamePredicatePayload) (*api.Payload, error) {
+	return &api.Payload{}, nil
+}
+
+func (s *zeroServer) MoveTablet(ctx context.Context,
+	in *intern.MoveTabletRequest) (*intern.TabletMove, error) {
+	return &intern.TabletMove{}, nil
+}
+
+func (s *zeroServer) PinTablet(ctx context.Context, in *intern.TabletPin) (*api.Payload, error) {
+	return &api.Payload{}, nil
+}
+
+func (s *zeroServer) GetTabletMoves(ctx context.Context,
+	in *api.Payload) (*intern.TabletMoves, error) {
+	return &intern.TabletMoves{}, nil
+}
+
+func (s *zeroServer) ReportMoveProgress(ctx context.Context,
+	in *intern.MoveProgress) (*api.Payload, error) {
+	return &api.Payload{}, nil
+}
+
 func StartDummyZero() *grpc.Server {
 	ln, err := net.Listen("tcp", "localhost:12340")
 	x.Check(err)
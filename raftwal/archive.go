@@ -0,0 +1,503 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package raftwal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	maxArchiveFileSize = 64 << 20
+	archiveFileSuffix  = ".log"
+	// Appending blocks once this many bytes are waiting to be written.
+	maxArchivePending    = 64 << 20
+	archiveFlushInterval = 100 * time.Millisecond
+	archivePruneInterval = 10 * time.Minute
+)
+
+// Archive keeps the raft entries applied by a node in files under a directory, so that they can
+// be replayed on top of a backup. Unlike the entries in the WAL, they aren't dropped once a
+// snapshot is taken, but only once they are older than the retention, if set. Each group gets a
+// g<gid> directory, holding files named after the index of their first entry. A new file is
+// started on every run, once the current one grows past maxArchiveFileSize, and after an error.
+//
+// Each record is the length of the rest as a uvarint, followed by the time it was archived in
+// nanoseconds as 8 bytes and the marshalled entry. The time and entry are encrypted together
+// when encryption is enabled, in which case the file names end in .enc.
+//
+// Entries are written and synced to disk in the background, in batches, and written again
+// after an error. Snapshots must not go past Archived, so that the entries not written yet
+// are still in the WAL after a crash.
+type Archive struct {
+	sync.Mutex
+	dir       string
+	retention time.Duration
+	groups    map[uint32]*archiveGroup
+	pending   int        // Bytes waiting to be written, across the groups.
+	room      *sync.Cond // Signalled when pending goes down.
+	kick      chan struct{}
+	closing   chan struct{}
+	done      chan struct{}
+	closed    bool
+	err       error // The last error while writing, until a write succeeds.
+}
+
+type archiveGroup struct {
+	// Only used by the loop writing to disk.
+	f    *os.File
+	size int64
+
+	buf      []byte // Records waiting to be written.
+	first    uint64 // Index of the first entry in buf.
+	applied  uint64 // Index of the last entry appended.
+	synced   uint64 // Entries up to this index are on disk.
+	flushing bool   // The records taken out of buf aren't on disk yet.
+}
+
+// OpenArchive creates the directory if needed and returns an archive writing to it. Files
+// older than retention are deleted, unless it's zero.
+func OpenArchive(dir string, retention time.Duration) (*Archive, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	a := &Archive{
+		dir:       dir,
+		retention: retention,
+		groups:    make(map[uint32]*archiveGroup),
+		kick:      make(chan struct{}, 1),
+		closing:   make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	a.room = sync.NewCond(a)
+	go a.run()
+	return a, nil
+}
+
+func archiveGroupDir(dir string, gid uint32) string {
+	return filepath.Join(dir, fmt.Sprintf("g%d", gid))
+}
+
+// Append queues the normal entries holding a proposal to be written to disk. Entries have to be
+// appended in the order they're applied. It only blocks while too much is waiting to be
+// written.
+func (a *Archive) Append(gid uint32, es []raftpb.Entry) error {
+	if len(es) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	var first uint64
+	now := time.Now().UnixNano()
+	for _, e := range es {
+		if e.Type != raftpb.EntryNormal || len(e.Data) == 0 {
+			continue
+		}
+		if first == 0 {
+			first = e.Index
+		}
+		data := make([]byte, 8+e.Size())
+		binary.BigEndian.PutUint64(data[:8], uint64(now))
+		if _, err := e.MarshalTo(data[8:]); err != nil {
+			return x.Wrapf(err, "While marshalling entry %d", e.Index)
+		}
+		data = x.EncryptValue(data)
+		var lbuf [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(lbuf[:], uint64(len(data)))
+		buf.Write(lbuf[:n])
+		buf.Write(data)
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	for a.pending >= maxArchivePending && !a.closed {
+		select {
+		case a.kick <- struct{}{}:
+		default:
+		}
+		a.room.Wait()
+	}
+	if a.closed {
+		return x.Errorf("WAL archive is closed")
+	}
+	g := a.groups[gid]
+	if g == nil {
+		g = &archiveGroup{}
+		a.groups[gid] = g
+	}
+	if buf.Len() > 0 {
+		if len(g.buf) == 0 {
+			g.first = first
+		}
+		g.buf = append(g.buf, buf.Bytes()...)
+		a.pending += buf.Len()
+	}
+	g.applied = es[len(es)-1].Index
+	return nil
+}
+
+// Archived returns the index up to which the entries applied by the group are on disk, and so
+// can be dropped from the WAL.
+func (a *Archive) Archived(gid uint32) uint64 {
+	a.Lock()
+	defer a.Unlock()
+	g := a.groups[gid]
+	if g == nil {
+		return 0
+	}
+	if len(g.buf) == 0 && !g.flushing {
+		return g.applied
+	}
+	return g.synced
+}
+
+func (a *Archive) run() {
+	flush := time.NewTicker(archiveFlushInterval)
+	defer flush.Stop()
+	prune := time.NewTicker(archivePruneInterval)
+	defer prune.Stop()
+	for {
+		select {
+		case <-flush.C:
+			a.flush()
+		case <-a.kick:
+			a.flush()
+		case <-prune.C:
+			if err := a.prune(); err != nil {
+				x.Printf("Error while deleting old WAL archive files: %v\n", err)
+			}
+		case <-a.closing:
+			a.flush()
+			a.Lock()
+			for _, g := range a.groups {
+				if g.f != nil {
+					g.f.Close()
+					g.f = nil
+				}
+			}
+			a.Unlock()
+			close(a.done)
+			return
+		}
+	}
+}
+
+// flush writes the queued records of every group and syncs them. Records that couldn't be
+// written stay queued, to be written again next time.
+func (a *Archive) flush() {
+	type batch struct {
+		g      *archiveGroup
+		gid    uint32
+		buf    []byte
+		first  uint64
+		upto   uint64
+		synced bool
+	}
+	a.Lock()
+	var batches []*batch
+	for gid, g := range a.groups {
+		if len(g.buf) == 0 {
+			continue
+		}
+		batches = append(batches, &batch{g: g, gid: gid, buf: g.buf, first: g.first,
+			upto: g.applied})
+		g.buf, g.flushing = nil, true
+	}
+	a.Unlock()
+	if len(batches) == 0 {
+		return
+	}
+
+	var err error
+	for _, b := range batches {
+		if err = a.write(b.gid, b.g, b.buf, b.first); err != nil {
+			break
+		}
+		b.synced = true
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	for _, b := range batches {
+		b.g.flushing = false
+		if b.synced {
+			b.g.synced = b.upto
+			a.pending -= len(b.buf)
+			continue
+		}
+		b.g.buf, b.g.first = append(b.buf, b.g.buf...), b.first
+	}
+	if err != nil && a.err == nil {
+		x.Printf("Error while writing to WAL archive, retrying: %v\n", err)
+	} else if err == nil && a.err != nil {
+		x.Printf("Writing to WAL archive again\n")
+	}
+	a.err = err
+	a.room.Broadcast()
+}
+
+// write appends the records to the current file of the group, starting a new one if needed.
+// After an error, the file is cut back to the records written before, and left for a new one.
+func (a *Archive) write(gid uint32, g *archiveGroup, buf []byte, first uint64) error {
+	if g.f == nil || g.size >= maxArchiveFileSize {
+		if err := a.rotate(gid, g, first); err != nil {
+			return err
+		}
+	}
+	n, err := g.f.Write(buf)
+	if err == nil {
+		err = g.f.Sync()
+	}
+	if err != nil {
+		g.f.Truncate(g.size)
+		g.f.Close()
+		g.f = nil
+		return err
+	}
+	g.size += int64(n)
+	return nil
+}
+
+func (a *Archive) rotate(gid uint32, g *archiveGroup, first uint64) error {
+	if g.f != nil {
+		if err := g.f.Close(); err != nil {
+			return err
+		}
+		g.f = nil
+	}
+	dir := archiveGroupDir(a.dir, gid)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%020d%s", first, archiveFileSuffix)
+	if x.ValueEncryption() != nil {
+		name += x.EncryptedFileSuffix
+	}
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	g.f, g.size = f, fi.Size()
+	return nil
+}
+
+// prune deletes the files of every group last written to longer than the retention ago. Files
+// are only deleted from the oldest on, and the last one of a group is kept, so the entries left
+// still follow each other.
+func (a *Archive) prune() error {
+	if a.retention <= 0 {
+		return nil
+	}
+	gids, err := ArchivedGroups(a.dir)
+	if err != nil {
+		return err
+	}
+	cutoff := time.Now().Add(-a.retention)
+	for _, gid := range gids {
+		files, err := archiveFiles(archiveGroupDir(a.dir, gid))
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			continue
+		}
+		for _, file := range files[:len(files)-1] {
+			fi, err := os.Stat(file)
+			if err != nil {
+				return err
+			}
+			if fi.ModTime().After(cutoff) {
+				break
+			}
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close writes the queued records and closes the files of all the groups. It returns the
+// error the records couldn't be written with, if any.
+func (a *Archive) Close() error {
+	a.Lock()
+	if a.closed {
+		a.Unlock()
+		return nil
+	}
+	a.closed = true
+	a.room.Broadcast()
+	a.Unlock()
+	close(a.closing)
+	<-a.done
+	a.Lock()
+	defer a.Unlock()
+	return a.err
+}
+
+// ArchivedGroups returns the groups with entries in the archive directory. Zero archives its
+// entries as group 0.
+func ArchivedGroups(dir string) ([]uint32, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var gids []uint32
+	for _, fi := range fis {
+		if !fi.IsDir() || !strings.HasPrefix(fi.Name(), "g") {
+			continue
+		}
+		gid, err := strconv.ParseUint(fi.Name()[1:], 10, 32)
+		if err != nil {
+			continue
+		}
+		gids = append(gids, uint32(gid))
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	return gids, nil
+}
+
+// ArchivedEntry is an entry read back from the archive, along with the time it was archived at.
+type ArchivedEntry struct {
+	raftpb.Entry
+	Time time.Time
+}
+
+// ArchiveReader reads the archived entries of a group in the order of their index. Entries
+// archived again after a restart are only returned once. Encrypted files are decrypted with the
+// keys set by x.SetValueEncryption.
+type ArchiveReader struct {
+	files []string
+	f     *os.File
+	br    *bufio.Reader
+	last  uint64
+}
+
+// OpenArchiveReader returns a reader over the entries of the group in the archive directory.
+func OpenArchiveReader(dir string, gid uint32) (*ArchiveReader, error) {
+	files, err := archiveFiles(archiveGroupDir(dir, gid))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, x.EncryptedFileSuffix) && x.ValueEncryption() == nil {
+			return nil, x.Errorf("Archive file %s is encrypted. Set --encryption_key_file "+
+				"to read it", filepath.Base(file))
+		}
+	}
+	return &ArchiveReader{files: files}, nil
+}
+
+// archiveFiles returns the paths of the archive files in the directory of a group, from the
+// oldest.
+func archiveFiles(gdir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(gdir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, fi := range fis {
+		name := strings.TrimSuffix(fi.Name(), x.EncryptedFileSuffix)
+		if strings.HasSuffix(name, archiveFileSuffix) {
+			files = append(files, filepath.Join(gdir, fi.Name()))
+		}
+	}
+	// The names start with the zero padded index of their first entry, so they sort by it.
+	sort.Strings(files)
+	return files, nil
+}
+
+// Next returns the next entry, or io.EOF once there are no more.
+func (r *ArchiveReader) Next() (*ArchivedEntry, error) {
+	for {
+		if r.br == nil {
+			if len(r.files) == 0 {
+				return nil, io.EOF
+			}
+			f, err := os.Open(r.files[0])
+			if err != nil {
+				return nil, err
+			}
+			r.f, r.br, r.files = f, bufio.NewReader(f), r.files[1:]
+		}
+		ae, err := r.read()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// A record cut short by a crash ends the file.
+			r.f.Close()
+			r.f, r.br = nil, nil
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ae.Index <= r.last {
+			continue
+		}
+		r.last = ae.Index
+		return ae, nil
+	}
+}
+
+func (r *ArchiveReader) read() (*ArchivedEntry, error) {
+	sz, err := binary.ReadUvarint(r.br)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, sz)
+	if _, err := io.ReadFull(r.br, data); err != nil {
+		return nil, err
+	}
+	if data, err = x.DecryptValue(data); err != nil {
+		return nil, err
+	}
+	if len(data) < 8 {
+		return nil, x.Errorf("Invalid archive record of %d bytes", len(data))
+	}
+	ae := &ArchivedEntry{Time: time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))}
+	if err := ae.Unmarshal(data[8:]); err != nil {
+		return nil, err
+	}
+	return ae, nil
+}
+
+// Close closes the file being read.
+func (r *ArchiveReader) Close() error {
+	if r.f == nil {
+		return nil
+	}
+	return r.f.Close()
+}
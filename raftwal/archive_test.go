@@ -0,0 +1,139 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package raftwal
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/stretchr/testify/require"
+)
+
+func entry(index uint64, data string) raftpb.Entry {
+	return raftpb.Entry{Term: 1, Index: index, Type: raftpb.EntryNormal, Data: []byte(data)}
+}
+
+func readAll(t *testing.T, dir string, gid uint32) []uint64 {
+	r, err := OpenArchiveReader(dir, gid)
+	require.NoError(t, err)
+	defer r.Close()
+	var idx []uint64
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		idx = append(idx, e.Index)
+	}
+	return idx
+}
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	a, err := OpenArchive(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, a.Append(1, []raftpb.Entry{
+		entry(1, ""), // Empty entries are left out.
+		entry(2, "a"),
+		{Term: 1, Index: 3, Type: raftpb.EntryConfChange, Data: []byte("cc")},
+		entry(4, "b"),
+	}))
+	require.NoError(t, a.Append(0, []raftpb.Entry{entry(7, "z")}))
+	require.NoError(t, a.Close())
+
+	// After a restart, entries since the last snapshot are applied, and archived, again.
+	a, err = OpenArchive(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, a.Append(1, []raftpb.Entry{entry(4, "b"), entry(5, "c")}))
+	require.NoError(t, a.Close())
+
+	gids, err := ArchivedGroups(dir)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{0, 1}, gids)
+	require.Equal(t, []uint64{2, 4, 5}, readAll(t, dir, 1))
+	require.Equal(t, []uint64{7}, readAll(t, dir, 0))
+
+	// A record cut short by a crash is ignored.
+	files, err := filepath.Glob(filepath.Join(dir, "g1", "*"+archiveFileSuffix))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	f, err := os.OpenFile(files[1], os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{100, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, []uint64{2, 4, 5}, readAll(t, dir, 1))
+}
+
+func TestArchiveArchived(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	a, err := OpenArchive(dir, 0)
+	require.NoError(t, err)
+	defer a.Close()
+	require.Zero(t, a.Archived(1))
+	require.NoError(t, a.Append(1, []raftpb.Entry{entry(1, "a"), entry(2, "b")}))
+	for a.Archived(1) < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, []uint64{1, 2}, readAll(t, dir, 1))
+
+	// Entries without a proposal are archived as soon as they're appended.
+	require.NoError(t, a.Append(1, []raftpb.Entry{entry(3, "")}))
+	require.EqualValues(t, 3, a.Archived(1))
+}
+
+func TestArchivePrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, idx := range []uint64{1, 5, 9} {
+		a, err := OpenArchive(dir, 0)
+		require.NoError(t, err)
+		require.NoError(t, a.Append(1, []raftpb.Entry{entry(idx, "a"), entry(idx+1, "b")}))
+		require.NoError(t, a.Close())
+	}
+	files, err := archiveFiles(archiveGroupDir(dir, 1))
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	old := time.Now().Add(-2 * time.Hour)
+	for _, file := range files {
+		require.NoError(t, os.Chtimes(file, old, old))
+	}
+	recent := time.Now()
+	require.NoError(t, os.Chtimes(files[1], recent, recent))
+
+	// Only the files older than the first recent one go, and the last one is always kept.
+	a, err := OpenArchive(dir, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, a.prune())
+	require.NoError(t, a.Close())
+	require.Equal(t, []uint64{5, 6, 9, 10}, readAll(t, dir, 1))
+}
@@ -29,8 +29,9 @@ import (
 )
 
 type Wal struct {
-	wals *badger.ManagedDB
-	id   uint64
+	wals    *badger.ManagedDB
+	id      uint64
+	archive *Archive
 }
 
 func Init(walStore *badger.ManagedDB, id uint64) *Wal {
@@ -41,6 +42,40 @@ func Init(walStore *badger.ManagedDB, id uint64) *Wal {
 
 var idKey = []byte("raftid")
 
+// SetArchive makes ArchiveApplied keep the applied entries in the archive.
+func (w *Wal) SetArchive(a *Archive) {
+	w.archive = a
+}
+
+// ArchiveApplied queues the entries to be written to the archive, if one was set. It has to be
+// called with the committed entries in the order they're applied.
+func (w *Wal) ArchiveApplied(gid uint32, es []raftpb.Entry) error {
+	if w.archive == nil {
+		return nil
+	}
+	return w.archive.Append(gid, es)
+}
+
+// Archived returns the highest index up to idx a snapshot of the group can be taken at, without
+// dropping entries from the WAL that aren't in the archive yet.
+func (w *Wal) Archived(gid uint32, idx uint64) uint64 {
+	if w.archive == nil {
+		return idx
+	}
+	if archived := w.archive.Archived(gid); archived < idx {
+		return archived
+	}
+	return idx
+}
+
+// CloseArchive writes the entries still queued, and closes the archive, if one was set.
+func (w *Wal) CloseArchive() error {
+	if w.archive == nil {
+		return nil
+	}
+	return w.archive.Close()
+}
+
 func (w *Wal) snapshotKey(gid uint32) []byte {
 	b := make([]byte, 14)
 	binary.BigEndian.PutUint64(b[0:8], w.id)
@@ -73,12 +108,21 @@ func (w *Wal) prefix(gid uint32) []byte {
 	return b
 }
 
+// itemValue returns the value of the item, decrypted if encryption is enabled.
+func itemValue(item *badger.Item) ([]byte, error) {
+	val, err := item.Value()
+	if err != nil {
+		return nil, err
+	}
+	return x.DecryptValue(val)
+}
+
 func (w *Wal) StoreRaftId(id uint64) error {
 	txn := w.wals.NewTransactionAt(1, true)
 	defer txn.Discard()
 	var b [8]byte
 	binary.BigEndian.PutUint64(b[:], id)
-	if err := txn.Set(idKey, b[:]); err != nil {
+	if err := txn.Set(idKey, x.EncryptValue(b[:])); err != nil {
 		return err
 	}
 	return txn.CommitAt(1, nil)
@@ -94,7 +138,7 @@ func RaftId(wals *badger.ManagedDB) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
-	val, err := item.Value()
+	val, err := itemValue(item)
 	if err != nil {
 		return 0, err
 	}
@@ -112,7 +156,7 @@ func (w *Wal) StoreSnapshot(gid uint32, s raftpb.Snapshot) error {
 	if err != nil {
 		return x.Wrapf(err, "wal.Store: While marshal snapshot")
 	}
-	if err := txn.Set(w.snapshotKey(gid), data); err != nil {
+	if err := txn.Set(w.snapshotKey(gid), x.EncryptValue(data)); err != nil {
 		return err
 	}
 	x.Printf("Writing snapshot to WAL, metadata: %+v, len(data): %d\n", s.Metadata, len(s.Data))
@@ -166,7 +210,7 @@ func (w *Wal) Store(gid uint32, h raftpb.HardState, es []raftpb.Entry) error {
 			return x.Wrapf(err, "wal.Store: While marshal entry")
 		}
 		k := w.entryKey(gid, e.Term, e.Index)
-		if err := txn.Set(k, data); err != nil {
+		if err := txn.Set(k, x.EncryptValue(data)); err != nil {
 			return err
 		}
 	}
@@ -176,7 +220,7 @@ func (w *Wal) Store(gid uint32, h raftpb.HardState, es []raftpb.Entry) error {
 		if err != nil {
 			return x.Wrapf(err, "wal.Store: While marshal hardstate")
 		}
-		if err := txn.Set(w.hardStateKey(gid), data); err != nil {
+		if err := txn.Set(w.hardStateKey(gid), x.EncryptValue(data)); err != nil {
 			return err
 		}
 	}
@@ -218,9 +262,9 @@ func (w *Wal) Snapshot(gid uint32) (snap raftpb.Snapshot, rerr error) {
 	if err != nil {
 		return snap, x.Wrapf(err, "while fetching snapshot from wal")
 	}
-	val, err := item.Value()
+	val, err := itemValue(item)
 	if err != nil {
-		return
+		return snap, err
 	}
 	rerr = x.Wrapf(snap.Unmarshal(val), "While unmarshal snapshot")
 	return
@@ -236,9 +280,9 @@ func (w *Wal) HardState(gid uint32) (hd raftpb.HardState, rerr error) {
 	if err != nil {
 		return hd, x.Wrapf(err, "while fetching hardstate from wal")
 	}
-	val, err := item.Value()
+	val, err := itemValue(item)
 	if err != nil {
-		return
+		return hd, err
 	}
 	rerr = x.Wrapf(hd.Unmarshal(val), "While unmarshal snapshot")
 	return
@@ -255,7 +299,7 @@ func (w *Wal) Entries(gid uint32, fromTerm, fromIndex uint64) (es []raftpb.Entry
 	for itr.Seek(start); itr.ValidForPrefix(prefix); itr.Next() {
 		item := itr.Item()
 		var e raftpb.Entry
-		val, err := item.Value()
+		val, err := itemValue(item)
 		if err != nil {
 			return es, err
 		}
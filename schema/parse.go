@@ -18,7 +18,10 @@
 package schema
 
 import (
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos/intern"
@@ -71,6 +74,22 @@ func parseDirective(it *lex.ItemIterator, schema *intern.SchemaUpdate, t types.T
 		}
 	case "count":
 		schema.Count = true
+	case "constraint":
+		if err := parseConstraintDirective(it, schema, t); err != nil {
+			return err
+		}
+	case "pattern":
+		if err := parsePatternDirective(it, schema, t); err != nil {
+			return err
+		}
+	case "enum":
+		if err := parseEnumDirective(it, schema, t); err != nil {
+			return err
+		}
+	case "ttl":
+		if err := parseTTLDirective(it, schema); err != nil {
+			return err
+		}
 	default:
 		return x.Errorf("Invalid index specification")
 	}
@@ -132,14 +151,8 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*intern.SchemaUpda
 		}
 		next = it.Item()
 	}
-	if next.Typ == itemAt {
-		if err := parseDirective(it, schema, t); err != nil {
-			return nil, err
-		}
-		next = it.Item()
-	}
-	// Check for another directive, we could have @count too.
-	if next.Typ == itemAt {
+	// Check for directives, there could be several, e.g. @index(exact) @count.
+	for next.Typ == itemAt {
 		if err := parseDirective(it, schema, t); err != nil {
 			return nil, err
 		}
@@ -160,6 +173,204 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*intern.SchemaUpda
 	return schema, nil
 }
 
+// schemaConstraint returns the constraint of the schema update, creating it if needed.
+func schemaConstraint(schema *intern.SchemaUpdate) *intern.Constraint {
+	if schema.Constraint == nil {
+		schema.Constraint = &intern.Constraint{}
+	}
+	return schema.Constraint
+}
+
+func expectLeftRound(it *lex.ItemIterator, predicate, directive string) error {
+	if !it.Next() {
+		return x.Errorf("Invalid ending.")
+	}
+	if next := it.Item(); next.Typ != itemLeftRound {
+		it.Prev() // Backup.
+		return x.Errorf("Require arguments for @%s on pred: %s", directive, predicate)
+	}
+	return nil
+}
+
+// parseConstraintDirective parses a range constraint like @constraint(min: 0, max: 150).
+func parseConstraintDirective(it *lex.ItemIterator, schema *intern.SchemaUpdate,
+	typ types.TypeID) error {
+	if typ != types.IntID && typ != types.FloatID {
+		return x.Errorf("Range constraint not allowed on predicate %s of type %s",
+			schema.Predicate, typ.Name())
+	}
+	if err := expectLeftRound(it, schema.Predicate, "constraint"); err != nil {
+		return err
+	}
+	c := schemaConstraint(schema)
+	expectArg := true
+	for {
+		if !it.Next() {
+			return x.Errorf("Invalid ending while parsing @constraint for pred: %s",
+				schema.Predicate)
+		}
+		next := it.Item()
+		switch {
+		case next.Typ == itemRightRound:
+			if !c.HasMin && !c.HasMax {
+				return x.Errorf("Expected min or max in @constraint for pred: %s",
+					schema.Predicate)
+			}
+			if c.HasMin && c.HasMax && c.Min > c.Max {
+				return x.Errorf("Min %v is greater than max %v in @constraint for pred: %s",
+					c.Min, c.Max, schema.Predicate)
+			}
+			return nil
+		case next.Typ == itemComma:
+			if expectArg {
+				return x.Errorf("Expected a constraint but got comma")
+			}
+			expectArg = true
+		case next.Typ == itemText && expectArg:
+			name := next.Val
+			it.Next()
+			if it.Item().Typ != itemColon {
+				return x.Errorf("Expected colon after %s in @constraint", name)
+			}
+			it.Next()
+			num := it.Item()
+			if num.Typ != itemNumber {
+				return x.Errorf("Expected a number for %s in @constraint but got: %v",
+					name, num.Val)
+			}
+			v, err := strconv.ParseFloat(num.Val, 64)
+			if err != nil {
+				return x.Errorf("Invalid number %s for %s in @constraint", num.Val, name)
+			}
+			switch name {
+			case "min":
+				c.HasMin, c.Min = true, v
+			case "max":
+				c.HasMax, c.Max = true, v
+			default:
+				return x.Errorf("Invalid constraint: %s. Expected min or max", name)
+			}
+			expectArg = false
+		default:
+			return x.Errorf("Expected constraint arg but got: %v", next.Val)
+		}
+	}
+}
+
+// parsePatternDirective parses a regular expression constraint like @pattern("^[a-z]+$").
+func parsePatternDirective(it *lex.ItemIterator, schema *intern.SchemaUpdate,
+	typ types.TypeID) error {
+	if typ != types.StringID && typ != types.DefaultID {
+		return x.Errorf("Pattern not allowed on predicate %s of type %s",
+			schema.Predicate, typ.Name())
+	}
+	if err := expectLeftRound(it, schema.Predicate, "pattern"); err != nil {
+		return err
+	}
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemQuotedText {
+		return x.Errorf("Expected a quoted pattern for pred: %s but got: %v",
+			schema.Predicate, next.Val)
+	}
+	pattern, err := strconv.Unquote(next.Val)
+	if err != nil {
+		return x.Errorf("Invalid pattern %s for pred: %s", next.Val, schema.Predicate)
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return x.Errorf("Invalid pattern for pred: %s: %v", schema.Predicate, err)
+	}
+	it.Next()
+	if it.Item().Typ != itemRightRound {
+		return x.Errorf("Expected ) after pattern for pred: %s", schema.Predicate)
+	}
+	schemaConstraint(schema).Pattern = pattern
+	return nil
+}
+
+// parseEnumDirective parses the list of allowed values in @enum("a", "b").
+func parseEnumDirective(it *lex.ItemIterator, schema *intern.SchemaUpdate,
+	typ types.TypeID) error {
+	if typ != types.StringID && typ != types.DefaultID {
+		return x.Errorf("Enum not allowed on predicate %s of type %s",
+			schema.Predicate, typ.Name())
+	}
+	if err := expectLeftRound(it, schema.Predicate, "enum"); err != nil {
+		return err
+	}
+	var values []string
+	seen := make(map[string]bool)
+	expectArg := true
+	for {
+		if !it.Next() {
+			return x.Errorf("Invalid ending while parsing @enum for pred: %s", schema.Predicate)
+		}
+		next := it.Item()
+		switch {
+		case next.Typ == itemRightRound:
+			if len(values) == 0 {
+				return x.Errorf("Expected at least one value in @enum for pred: %s",
+					schema.Predicate)
+			}
+			schemaConstraint(schema).Enum = values
+			return nil
+		case next.Typ == itemComma:
+			if expectArg {
+				return x.Errorf("Expected a value but got comma")
+			}
+			expectArg = true
+		case next.Typ == itemQuotedText && expectArg:
+			v, err := strconv.Unquote(next.Val)
+			if err != nil {
+				return x.Errorf("Invalid enum value %s for pred: %s", next.Val, schema.Predicate)
+			}
+			if seen[v] {
+				return x.Errorf("Duplicate enum value %q for pred: %s", v, schema.Predicate)
+			}
+			seen[v] = true
+			values = append(values, v)
+			expectArg = false
+		default:
+			return x.Errorf("Expected a quoted enum value but got: %v", next.Val)
+		}
+	}
+}
+
+// parseTTLDirective parses the lifetime of the edges of a predicate, like @ttl(720h). The
+// argument is a Go duration, and is stored in whole seconds.
+func parseTTLDirective(it *lex.ItemIterator, schema *intern.SchemaUpdate) error {
+	if err := expectLeftRound(it, schema.Predicate, "ttl"); err != nil {
+		return err
+	}
+	// The lexer splits a duration like 1h30m into a number and a word, so glue the
+	// pieces back together.
+	var arg string
+	for {
+		if !it.Next() {
+			return x.Errorf("Invalid ending while parsing @ttl for pred: %s", schema.Predicate)
+		}
+		next := it.Item()
+		if next.Typ == itemRightRound {
+			break
+		}
+		if next.Typ != itemNumber && next.Typ != itemText {
+			return x.Errorf("Expected a duration in @ttl for pred: %s but got: %v",
+				schema.Predicate, next.Val)
+		}
+		arg += next.Val
+	}
+	d, err := time.ParseDuration(arg)
+	if err != nil {
+		return x.Errorf("Invalid duration %q in @ttl for pred: %s", arg, schema.Predicate)
+	}
+	if d < time.Second {
+		return x.Errorf("Duration in @ttl for pred: %s should be at least 1s, got: %v",
+			schema.Predicate, d)
+	}
+	schema.Ttl = uint64(d / time.Second)
+	return nil
+}
+
 // parseIndexDirective works on "@index" or "@index(customtokenizer)".
 func parseIndexDirective(it *lex.ItemIterator, predicate string,
 	typ types.TypeID) ([]string, error) {
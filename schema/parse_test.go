@@ -368,3 +368,69 @@ func TestParseUnderscore(t *testing.T) {
 	_, err := Parse("_share_:string @index(term) .")
 	require.NoError(t, err)
 }
+
+func TestParseConstraints(t *testing.T) {
+	reset()
+	schemas, err := Parse(`
+		age: int @index(int) @constraint(min: 0, max: 150) .
+		score: [float] @constraint(max: -1.5e2) .
+		email: string @index(exact) @pattern("^[^@]+@[^@]+$") @count .
+		status: string @enum("active", "closed \"old\"") .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(schemas))
+	require.EqualValues(t, &intern.Constraint{HasMin: true, Min: 0, HasMax: true, Max: 150},
+		schemas[0].Constraint)
+	require.Equal(t, []string{"int"}, schemas[0].Tokenizer)
+	require.EqualValues(t, &intern.Constraint{HasMax: true, Max: -150}, schemas[1].Constraint)
+	require.True(t, schemas[1].List)
+	require.Equal(t, "^[^@]+@[^@]+$", schemas[2].Constraint.Pattern)
+	require.True(t, schemas[2].Count)
+	require.Equal(t, []string{"active", `closed "old"`}, schemas[3].Constraint.Enum)
+}
+
+func TestParseTTL(t *testing.T) {
+	reset()
+	schemas, err := Parse(`
+		session: uid @ttl(720h) .
+		event: string @index(exact) @ttl(1h30m) .
+		token: string @ttl(45s) @count .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(schemas))
+	require.EqualValues(t, 720*3600, schemas[0].Ttl)
+	require.EqualValues(t, 5400, schemas[1].Ttl)
+	require.Equal(t, []string{"exact"}, schemas[1].Tokenizer)
+	require.EqualValues(t, 45, schemas[2].Ttl)
+	require.True(t, schemas[2].Count)
+
+	for _, tc := range []struct{ in, err string }{
+		{`session: uid @ttl .`, "Require arguments for @ttl"},
+		{`session: uid @ttl() .`, "Invalid duration"},
+		{`session: uid @ttl(10) .`, "Invalid duration"},
+		{`session: uid @ttl(10ms) .`, "should be at least 1s"},
+	} {
+		reset()
+		_, err := Parse(tc.in)
+		require.Error(t, err, tc.in)
+		require.Contains(t, err.Error(), tc.err, tc.in)
+	}
+}
+
+func TestParseConstraintErrors(t *testing.T) {
+	for _, tc := range []struct{ in, err string }{
+		{`name: string @constraint(min: 1) .`, "Range constraint not allowed on predicate name"},
+		{`age: int @constraint() .`, "Expected min or max"},
+		{`age: int @constraint(min: 5, max: 1) .`, "is greater than max"},
+		{`age: int @constraint(low: 5) .`, "Invalid constraint: low"},
+		{`age: int @pattern("a") .`, "Pattern not allowed on predicate age"},
+		{`name: string @pattern("a(") .`, "Invalid pattern for pred: name"},
+		{`name: string @enum() .`, "Expected at least one value"},
+		{`name: string @enum("a", "a") .`, "Duplicate enum value"},
+	} {
+		reset()
+		_, err := Parse(tc.in)
+		require.Error(t, err, tc.in)
+		require.Contains(t, err.Error(), tc.err, tc.in)
+	}
+}
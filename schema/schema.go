@@ -213,6 +213,27 @@ func (s *state) IsList(pred string) bool {
 	return false
 }
 
+// Constraint returns the value constraint for the predicate, or nil if there is none.
+func (s *state) Constraint(pred string) *intern.Constraint {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Constraint
+	}
+	return nil
+}
+
+// TTL returns the number of seconds after which edges of the predicate expire, or 0 if
+// they never do.
+func (s *state) TTL(pred string) uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Ttl
+	}
+	return 0
+}
+
 func Init(ps *badger.ManagedDB) {
 	pstore = ps
 	reset()
@@ -236,6 +257,9 @@ func Load(predicate string) error {
 	if err != nil {
 		return err
 	}
+	if val, err = x.DecryptValue(val); err != nil {
+		return err
+	}
 	var s intern.SchemaUpdate
 	x.Check(s.Unmarshal(val))
 	State().Set(predicate, s)
@@ -268,6 +292,9 @@ func LoadFromDb() error {
 		if err != nil {
 			return err
 		}
+		if val, err = x.DecryptValue(val); err != nil {
+			return err
+		}
 		if len(val) == 0 {
 			continue
 		}
@@ -36,6 +36,8 @@ const (
 	itemUnderscore
 	itemLeftSquare
 	itemRightSquare
+	itemQuotedText // quoted string
+	itemNumber     // integer or floating point number
 )
 
 func lexText(l *lex.Lexer) lex.StateFn {
@@ -78,6 +80,13 @@ Loop:
 		case r == '_':
 			// Predicates can start with _.
 			return lexWord
+		case r == '"':
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf("Invalid schema: %v", err)
+			}
+			l.Emit(itemQuotedText)
+		case r == '-' || r == '+' || isDigit(r):
+			return lexNumber
 		default:
 			return l.Errorf("Invalid schema. Unexpected %s", l.Input[l.Start:l.Pos])
 		}
@@ -103,6 +112,19 @@ func lexWord(l *lex.Lexer) lex.StateFn {
 	return lexText
 }
 
+func lexNumber(l *lex.Lexer) lex.StateFn {
+	// The caller already absorbed the sign or the first digit.
+	l.AcceptRun(func(r rune) bool {
+		return isDigit(r) || r == '.' || r == 'e' || r == 'E' || r == '-' || r == '+'
+	})
+	l.Emit(itemNumber)
+	return lexText
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
 // isNameBegin returns true if the rune is an alphabet.
 func isNameBegin(r rune) bool {
 	switch {
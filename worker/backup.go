@@ -0,0 +1,575 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"golang.org/x/net/context"
+	"golang.org/x/net/trace"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// BackupManifestFile is written into the directory of a backup once all the groups are done.
+const BackupManifestFile = "manifest.json"
+
+// BackupManifest describes a backup of all the groups, taken at one timestamp.
+type BackupManifest struct {
+	Name    string `json:"name"`
+	ReadTs  uint64 `json:"read_ts"`
+	SinceTs uint64 `json:"since_ts,omitempty"`
+	// When the backup was started.
+	Time time.Time `json:"time"`
+	// The backup an incremental backup holds the changes since. Empty for full backups.
+	Parent    string   `json:"parent,omitempty"`
+	MaxUid    uint64   `json:"max_uid"`
+	Encrypted bool     `json:"encrypted"`
+	Groups    []uint32 `json:"groups"`
+	// The namespace whose predicates the backup holds. Backups of the default namespace hold
+	// the predicates of all of them.
+	Namespace uint64 `json:"namespace,omitempty"`
+}
+
+// GroupBackup describes the backup file written by the leader of a group.
+type GroupBackup struct {
+	GroupId uint32 `json:"group_id"`
+	ReadTs  uint64 `json:"read_ts"`
+	SinceTs uint64 `json:"since_ts,omitempty"`
+	File    string `json:"file"`
+	Keys    uint64 `json:"keys"`
+	// Bytes taken by the keys of each predicate served by the group.
+	Predicates map[string]int64 `json:"predicates"`
+}
+
+func groupBackupFile(gid uint32) string {
+	return fmt.Sprintf("g%d.json", gid)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that a crash doesn't leave a partial file behind.
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return x.Wrapf(err, "While reading %s", path)
+	}
+	return nil
+}
+
+// ReadBackupManifest reads the manifest of the backup in dir.
+func ReadBackupManifest(dir string) (*BackupManifest, error) {
+	m := new(BackupManifest)
+	if err := readJSON(filepath.Join(dir, BackupManifestFile), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReadGroupBackup reads the description of the backup file of group gid in dir.
+func ReadGroupBackup(dir string, gid uint32) (*GroupBackup, error) {
+	g := new(GroupBackup)
+	if err := readJSON(filepath.Join(dir, groupBackupFile(gid)), g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// LatestBackup returns the manifest with the highest read timestamp among the backups in root,
+// or nil if there are none. Backups without a manifest didn't complete, and are ignored.
+func LatestBackup(root string) (*BackupManifest, error) {
+	return latestBackup(root, func(*BackupManifest) bool { return true })
+}
+
+// latestBackup returns the latest of the backups in root which match.
+func latestBackup(root string, match func(*BackupManifest) bool) (*BackupManifest, error) {
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var latest *BackupManifest
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		m, err := ReadBackupManifest(filepath.Join(root, d.Name()))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if match(m) && (latest == nil || m.ReadTs > latest.ReadTs) {
+			latest = m
+		}
+	}
+	return latest, nil
+}
+
+// BackupChain returns the backups needed to restore backup name from root: the full backup it
+// starts with, followed by the incremental backups leading up to it. An empty name picks the
+// latest backup.
+func BackupChain(root, name string) ([]*BackupManifest, error) {
+	var m *BackupManifest
+	var err error
+	if name == "" {
+		if m, err = LatestBackup(root); err == nil && m == nil {
+			err = x.Errorf("No backup found in %s", root)
+		}
+	} else {
+		m, err = ReadBackupManifest(filepath.Join(root, name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	chain := []*BackupManifest{m}
+	for m.Parent != "" {
+		parent, err := ReadBackupManifest(filepath.Join(root, m.Parent))
+		if err != nil {
+			return nil, x.Wrapf(err, "While reading backup %s, the parent of %s", m.Parent, m.Name)
+		}
+		if parent.ReadTs != m.SinceTs {
+			return nil, x.Errorf("Backup %s holds the changes since %d, but its parent %s was "+
+				"taken at %d", m.Name, m.SinceTs, parent.Name, parent.ReadTs)
+		}
+		m = parent
+		chain = append([]*BackupManifest{m}, chain...)
+	}
+	return chain, nil
+}
+
+// A backup file holds KVs, each prefixed with its length as a uvarint, compressed with gzip. It
+// is encrypted as a stream when encryption at rest is enabled. A KV without a value marks a key
+// deleted since the previous backup.
+type backupWriter struct {
+	f  *os.File
+	bw *bufio.Writer
+	ew io.WriteCloser
+	gw *gzip.Writer
+}
+
+func newBackupWriter(path string) (*backupWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w := &backupWriter{f: f, bw: bufio.NewWriterSize(f, 1<<20)}
+	var out io.Writer = w.bw
+	if Config.EncryptExports {
+		if w.ew, err = x.ValueEncryption().NewWriter(out); err != nil {
+			f.Close()
+			return nil, err
+		}
+		out = w.ew
+	}
+	w.gw = gzip.NewWriter(out)
+	return w, nil
+}
+
+// write returns the number of bytes the KV took, before compression.
+func (w *backupWriter) write(kv *intern.KV) (int, error) {
+	data, err := kv.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	var lbuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lbuf[:], uint64(len(data)))
+	if _, err := w.gw.Write(lbuf[:n]); err != nil {
+		return 0, err
+	}
+	if _, err := w.gw.Write(data); err != nil {
+		return 0, err
+	}
+	return n + len(data), nil
+}
+
+func (w *backupWriter) close() error {
+	defer w.f.Close()
+	if err := w.gw.Close(); err != nil {
+		return err
+	}
+	if w.ew != nil {
+		if err := w.ew.Close(); err != nil {
+			return err
+		}
+	}
+	if err := w.bw.Flush(); err != nil {
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		return err
+	}
+	return w.f.Close()
+}
+
+// BackupReader reads the KVs of a group backup file. Encrypted files are decrypted with the
+// keys set by x.SetValueEncryption.
+type BackupReader struct {
+	f  *os.File
+	gr *gzip.Reader
+	br *bufio.Reader
+}
+
+func OpenBackup(dir string, g *GroupBackup) (*BackupReader, error) {
+	f, err := os.Open(filepath.Join(dir, g.File))
+	if err != nil {
+		return nil, err
+	}
+	var in io.Reader = bufio.NewReaderSize(f, 1<<20)
+	if strings.HasSuffix(g.File, x.EncryptedFileSuffix) {
+		k := x.ValueEncryption()
+		if k == nil {
+			f.Close()
+			return nil, x.Errorf("Backup file %s is encrypted, but no keys were given", g.File)
+		}
+		if in, err = k.NewReader(in); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	gr, err := gzip.NewReader(in)
+	if err != nil {
+		f.Close()
+		return nil, x.Wrapf(err, "While reading %s", g.File)
+	}
+	return &BackupReader{f: f, gr: gr, br: bufio.NewReader(gr)}, nil
+}
+
+// Next returns io.EOF once all the KVs were read.
+func (r *BackupReader) Next() (*intern.KV, error) {
+	sz, err := binary.ReadUvarint(r.br)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, sz)
+	if _, err := io.ReadFull(r.br, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	kv := new(intern.KV)
+	if err := kv.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+func (r *BackupReader) Close() error {
+	r.gr.Close()
+	return r.f.Close()
+}
+
+// backup writes the data of the tablets served by this group, as of readTs, into the directory
+// of backup name. If sinceTs is set, only the keys changed after it are written. If preds is set,
+// only the keys of those predicates are.
+func backup(name string, readTs, sinceTs uint64, preds []string) error {
+	dir := filepath.Join(Config.BackupPath, name)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	gid := groups().groupId()
+	g := &GroupBackup{
+		GroupId:    gid,
+		ReadTs:     readTs,
+		SinceTs:    sinceTs,
+		File:       fmt.Sprintf("g%d.backup", gid),
+		Predicates: make(map[string]int64),
+	}
+	if Config.EncryptExports {
+		g.File += x.EncryptedFileSuffix
+	}
+	x.Printf("Writing backup of group %d to: %v\n", gid, filepath.Join(dir, g.File))
+	w, err := newBackupWriter(filepath.Join(dir, g.File))
+	if err != nil {
+		return err
+	}
+	var wantPred map[string]bool
+	if len(preds) > 0 {
+		wantPred = make(map[string]bool, len(preds))
+		for _, pred := range preds {
+			wantPred[pred] = true
+		}
+	}
+	if err := writeBackup(w, g, wantPred); err != nil {
+		w.f.Close()
+		return err
+	}
+	if err := w.close(); err != nil {
+		return err
+	}
+	return writeJSON(filepath.Join(dir, groupBackupFile(gid)), g)
+}
+
+func writeBackup(w *backupWriter, g *GroupBackup, wantPred map[string]bool) error {
+	txn := pstore.NewTransactionAt(g.ReadTs, false)
+	defer txn.Discard()
+	var since *badger.Txn
+	if g.SinceTs > 0 {
+		since = pstore.NewTransactionAt(g.SinceTs, false)
+		defer since.Discard()
+	}
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := txn.NewIterator(iterOpts)
+	defer it.Close()
+
+	// Do NOT go to next by default. Be careful when you "continue" in loop.
+	for it.Rewind(); it.Valid(); {
+		item := it.Item()
+		pk := x.Parse(item.Key())
+		if pk == nil {
+			it.Next()
+			continue
+		}
+		if !groups().ServesTablet(pk.Attr) || (wantPred != nil && !wantPred[pk.Attr]) {
+			it.Seek(pk.SkipPredicate())
+			continue
+		}
+		key := make([]byte, len(item.Key()))
+		copy(key, item.Key())
+
+		kv, err := backupKV(txn, since, it, pk, g.SinceTs)
+		if err != nil {
+			return x.Wrapf(err, "While backing up key %x", key)
+		}
+		// Skip the older versions, which the KV already accounts for.
+		for it.Valid() && bytes.Equal(it.Item().Key(), key) {
+			it.Next()
+		}
+		if kv == nil {
+			continue
+		}
+		n, err := w.write(kv)
+		if err != nil {
+			return err
+		}
+		g.Keys++
+		g.Predicates[pk.Attr] += int64(n)
+	}
+	return nil
+}
+
+// backupKV returns the KV to write for the key the iterator is at, or nil if it didn't change
+// since sinceTs. Schema keys are always written, so that every backup lists all the predicates.
+func backupKV(txn, since *badger.Txn, it *badger.Iterator, pk *x.ParsedKey,
+	sinceTs uint64) (*intern.KV, error) {
+	item := it.Item()
+	key := make([]byte, len(item.Key()))
+	copy(key, item.Key())
+	if pk.IsSchema() {
+		val, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		if val, err = x.DecryptValue(val); err != nil {
+			return nil, err
+		}
+		// The value is only valid until the iterator moves on.
+		val = append([]byte(nil), val...)
+		return &intern.KV{Key: key, Val: val, UserMeta: []byte{item.UserMeta()}}, nil
+	}
+
+	// The iterator shows the versions under a deletion, so check that the key is still there.
+	_, err := txn.Get(key)
+	if err != nil && err != badger.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil && item.Version() <= sinceTs {
+		return nil, nil
+	}
+	var kv *intern.KV
+	if err == nil {
+		l, err := posting.ReadPostingList(key, it)
+		if err != nil {
+			return nil, err
+		}
+		if kv, err = l.MarshalToKv(); err != nil {
+			return nil, err
+		}
+		kv.Version = 0
+	}
+	if kv != nil && len(kv.Val) > 0 {
+		return kv, nil
+	}
+	// The key is gone. Only incremental backups need to record that, if it was there before.
+	if since == nil {
+		return nil, nil
+	}
+	if _, err := since.Get(key); err == badger.ErrKeyNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &intern.KV{Key: key}, nil
+}
+
+func handleBackupForGroup(ctx context.Context, in *intern.ExportPayload) *intern.ExportPayload {
+	if err := backup(in.Backup, in.ReadTs, in.SinceTs, in.Predicates); err != nil {
+		if tr, ok := trace.FromContext(ctx); ok {
+			tr.LazyPrintf(err.Error())
+		}
+		x.Printf("Error while writing backup %s: %v\n", in.Backup, err)
+		in.Status = intern.ExportPayload_FAILED
+		return in
+	}
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf("Backup done for group: %d.", in.GroupId)
+	}
+	in.Status = intern.ExportPayload_SUCCESS
+	return in
+}
+
+// BackupOverNetwork has the leader of every group write a backup of its data, as of a timestamp
+// from Zero, into a new directory under the backup path. With incremental set, the backup only
+// holds the changes since the latest backup found there. For a namespace other than the default
+// one, set in ctx, the backup only holds the predicates of the namespace, and incremental
+// backups follow the latest backup of the same namespace.
+func BackupOverNetwork(ctx context.Context, incremental bool) (*BackupManifest, error) {
+	// If we haven't even had a single membership update, don't run the backup.
+	if err := x.HealthCheck(); err != nil {
+		if tr, ok := trace.FromContext(ctx); ok {
+			tr.LazyPrintf("Request rejected %v", err)
+		}
+		return nil, err
+	}
+	ns := x.Namespace(ctx)
+	var preds []string
+	if ns != 0 {
+		var err error
+		if preds, _, err = NamespacePredicates(ctx); err != nil {
+			return nil, err
+		}
+		if len(preds) == 0 {
+			return nil, x.Errorf("Namespace %d has no predicates to back up", ns)
+		}
+	}
+	var parent *BackupManifest
+	if incremental {
+		var err error
+		parent, err = latestBackup(Config.BackupPath, func(m *BackupManifest) bool {
+			return m.Namespace == ns
+		})
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, x.Errorf("No backup found in %s for an incremental backup",
+				Config.BackupPath)
+		}
+	}
+	ts, err := Timestamps(ctx, &intern.Num{Val: 1})
+	if err != nil {
+		return nil, err
+	}
+	readTs := ts.StartId
+	posting.Oracle().WaitForTs(ctx, readTs)
+
+	now := time.Now().UTC()
+	m := &BackupManifest{
+		Name:      fmt.Sprintf("%s-%d", now.Format("2006-01-02-15-04-05"), readTs),
+		ReadTs:    readTs,
+		Time:      now,
+		Encrypted: Config.EncryptExports,
+		Groups:    groups().KnownGroups(),
+		Namespace: ns,
+	}
+	if parent != nil {
+		m.Parent, m.SinceTs = parent.Name, parent.ReadTs
+	}
+	sort.Slice(m.Groups, func(i, j int) bool { return m.Groups[i] < m.Groups[j] })
+
+	ch := make(chan *intern.ExportPayload, len(m.Groups))
+	for _, gid := range m.Groups {
+		go func(group uint32) {
+			req := &intern.ExportPayload{
+				ReqId:      uint64(rand.Int63()),
+				GroupId:    group,
+				ReadTs:     readTs,
+				Backup:     m.Name,
+				SinceTs:    m.SinceTs,
+				Predicates: preds,
+			}
+			ch <- handleExportForGroupOverNetwork(ctx, req)
+		}(gid)
+	}
+	for range m.Groups {
+		bp := <-ch
+		if bp.Status != intern.ExportPayload_SUCCESS {
+			if tr, ok := trace.FromContext(ctx); ok {
+				tr.LazyPrintf("Backup status: %v for group id: %d", bp.Status, bp.GroupId)
+			}
+			return nil, x.Errorf("Backup status: %v for group id: %d", bp.Status, bp.GroupId)
+		}
+	}
+
+	// The uids in the backup were all leased before it was taken.
+	m.MaxUid = MaxLeaseId()
+	dir := filepath.Join(Config.BackupPath, m.Name)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	// Each group writes its files under its own backup path, so they only end up together if
+	// the path is shared by the servers. Without all of them, the backup can't be restored.
+	for _, gid := range m.Groups {
+		g, err := ReadGroupBackup(dir, gid)
+		if err == nil {
+			_, err = os.Stat(filepath.Join(dir, g.File))
+		}
+		if err != nil {
+			return nil, x.Errorf("The files of group %d are missing from %s: %v. The backup "+
+				"path (--backup) has to be shared by all the servers", gid, dir, err)
+		}
+	}
+	if err := writeJSON(filepath.Join(dir, BackupManifestFile), m); err != nil {
+		return nil, err
+	}
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf("DONE backup %s", m.Name)
+	}
+	return m, nil
+}
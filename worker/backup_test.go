@@ -0,0 +1,139 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func readBackup(t *testing.T, name string) (*GroupBackup, map[string]*intern.KV) {
+	dir := filepath.Join(Config.BackupPath, name)
+	g, err := ReadGroupBackup(dir, 1)
+	require.NoError(t, err)
+	r, err := OpenBackup(dir, g)
+	require.NoError(t, err)
+	defer r.Close()
+	kvs := make(map[string]*intern.KV)
+	for {
+		kv, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		kvs[string(kv.Key)] = kv
+	}
+	require.Equal(t, int(g.Keys), len(kvs))
+	return g, kvs
+}
+
+func addBackupEdge(t *testing.T, attr string, uid, vid uint64) {
+	edge := &intern.DirectedEdge{Entity: uid, Attr: attr, ValueId: vid}
+	addEdge(t, edge, getOrCreate(x.DataKey(attr, uid)))
+}
+
+func TestBackup(t *testing.T) {
+	bdir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+	Config.BackupPath = bdir
+
+	schema.ParseBytes([]byte("backup_friend: uid .\nbackup_other: uid ."), 1)
+	gr.Lock()
+	gr.tablets["backup_friend"] = &intern.Tablet{GroupId: 1}
+	gr.tablets["backup_other"] = &intern.Tablet{GroupId: 2}
+	gr.Unlock()
+	// Move them away, to keep the other tests from seeing these predicates.
+	defer func() {
+		gr.Lock()
+		gr.tablets["backup_friend"] = &intern.Tablet{GroupId: 2}
+		gr.Unlock()
+	}()
+	addBackupEdge(t, "backup_friend", 1, 10)
+	addBackupEdge(t, "backup_friend", 2, 10)
+	addBackupEdge(t, "backup_other", 1, 10)
+
+	full := timestamp()
+	require.NoError(t, backup("full", full, 0, nil))
+	g, kvs := readBackup(t, "full")
+	require.Contains(t, kvs, string(x.DataKey("backup_friend", 1)))
+	require.Contains(t, kvs, string(x.DataKey("backup_friend", 2)))
+	require.NotContains(t, kvs, string(x.DataKey("backup_other", 1)))
+	require.Contains(t, g.Predicates, "backup_friend")
+	require.NotContains(t, g.Predicates, "backup_other")
+
+	// Change one key and delete the other.
+	addBackupEdge(t, "backup_friend", 2, 11)
+	txn := pstore.NewTransactionAt(math.MaxUint64, true)
+	require.NoError(t, txn.Delete(x.DataKey("backup_friend", 1)))
+	require.NoError(t, txn.CommitAt(timestamp(), nil))
+	txn.Discard()
+
+	require.NoError(t, backup("incremental", timestamp(), full, nil))
+	_, kvs = readBackup(t, "incremental")
+	deleted := kvs[string(x.DataKey("backup_friend", 1))]
+	require.NotNil(t, deleted)
+	require.Empty(t, deleted.Val)
+	changed := kvs[string(x.DataKey("backup_friend", 2))]
+	require.NotNil(t, changed)
+	require.NotEmpty(t, changed.Val)
+	// Keys unchanged since the full backup are left out.
+	require.NotContains(t, kvs, string(x.DataKey("friend", 1)))
+}
+
+func TestBackupChain(t *testing.T) {
+	root, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	write := func(m *BackupManifest) {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, m.Name), 0700))
+		require.NoError(t, writeJSON(filepath.Join(root, m.Name, BackupManifestFile), m))
+	}
+	write(&BackupManifest{Name: "a", ReadTs: 10})
+	write(&BackupManifest{Name: "b", ReadTs: 20, SinceTs: 10, Parent: "a"})
+	write(&BackupManifest{Name: "c", ReadTs: 30, SinceTs: 20, Parent: "b"})
+	// Not complete, so it isn't the latest.
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "d"), 0700))
+
+	chain, err := BackupChain(root, "")
+	require.NoError(t, err)
+	var names []string
+	for _, m := range chain {
+		names = append(names, m.Name)
+	}
+	require.Equal(t, []string{"a", "b", "c"}, names)
+
+	chain, err = BackupChain(root, "a")
+	require.NoError(t, err)
+	require.Len(t, chain, 1)
+
+	write(&BackupManifest{Name: "e", ReadTs: 40, SinceTs: 25, Parent: "c"})
+	_, err = BackupChain(root, "e")
+	require.Error(t, err)
+}
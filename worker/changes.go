@@ -0,0 +1,676 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	maxBufferedChanges = 10000
+	subscriberBuffer   = 1000
+	maxChangeLogSize   = 64 << 20
+	// How often the stream of a group sends a progress mark, releasing the changes before it.
+	changeMarkInterval = 100 * time.Millisecond
+	// Subscribers get a progress mark at most this often.
+	subscriberMarkInterval = time.Second
+	// How often a subscription checks that the groups of the cluster are still the same.
+	groupsCheckInterval = 10 * time.Second
+)
+
+var (
+	changes = newChangeFeed()
+
+	errSlowSubscriber = x.Errorf("Subscriber fell behind. Resume after the last commit_ts seen.")
+	errGroupsChanged  = x.Errorf("Groups of the cluster changed. Resume after the last commit_ts seen.")
+)
+
+type pendingChange struct {
+	index uint64
+	done  bool
+	ev    *api.ChangeEvent
+}
+
+// changeFeed fans out the edges of committed transactions to subscribers and to the change
+// log, in the order the group applied the commits. It keeps the most recent events around
+// so that subscribers can resume from a commit_ts.
+type changeFeed struct {
+	sync.Mutex
+	pending []*pendingChange
+	events  []*api.ChangeEvent  // Oldest first.
+	seen    map[uint64]struct{} // Commit timestamps of the buffered events.
+	subs    map[chan *api.ChangeEvent]struct{}
+	// The highest commit_ts among the events dropped from the buffer.
+	droppedTs uint64
+
+	// The change log, and the events not written to it yet, which writeLog writes outside of
+	// the lock. Events are only dropped from the buffer once written, so that subscribers
+	// can read them back.
+	log      *changeLog
+	unlogged []*api.ChangeEvent
+	logReady chan struct{}
+}
+
+func newChangeFeed() *changeFeed {
+	return &changeFeed{
+		seen:     make(map[uint64]struct{}),
+		subs:     make(map[chan *api.ChangeEvent]struct{}),
+		logReady: make(chan struct{}, 1),
+	}
+}
+
+// begin reserves a spot for the commit or abort proposal at the given raft index. It must
+// be called in the order the proposals are applied, and followed by a publish.
+func (f *changeFeed) begin(index uint64) {
+	f.Lock()
+	defer f.Unlock()
+	f.pending = append(f.pending, &pendingChange{index: index})
+}
+
+// publish hands over the outcome of the commit or abort at the given raft index. Commits
+// which didn't come through raft pass index zero and go out after the ones already pending.
+func (f *changeFeed) publish(index uint64, txn *posting.Txn, tctx *api.TxnContext, err error) {
+	var ev *api.ChangeEvent
+	if err == nil && txn != nil && tctx.CommitTs > 0 {
+		set, del := txn.Changes()
+		if len(set) > 0 || len(del) > 0 {
+			ev = &api.ChangeEvent{
+				CommitTs: tctx.CommitTs,
+				StartTs:  tctx.StartTs,
+				GroupId:  groups().groupId(),
+				Set:      set,
+				Del:      del,
+			}
+		}
+	}
+
+	f.done(index, ev)
+}
+
+// done marks the proposal at the given raft index as applied, and sends out the events
+// which are no longer waiting on an earlier proposal.
+func (f *changeFeed) done(index uint64, ev *api.ChangeEvent) {
+	f.Lock()
+	defer f.Unlock()
+	if index == 0 {
+		f.pending = append(f.pending, &pendingChange{done: true, ev: ev})
+	} else {
+		for _, pc := range f.pending {
+			if pc.index == index && !pc.done {
+				pc.done, pc.ev = true, ev
+				break
+			}
+		}
+	}
+	for len(f.pending) > 0 && f.pending[0].done {
+		if ev := f.pending[0].ev; ev != nil {
+			f.emit(ev)
+		}
+		f.pending = f.pending[1:]
+	}
+}
+
+func (f *changeFeed) emit(ev *api.ChangeEvent) {
+	if _, ok := f.seen[ev.CommitTs]; ok {
+		// A transaction aborted on conflict can be committed both directly and via raft.
+		return
+	}
+	f.events = append(f.events, ev)
+	f.seen[ev.CommitTs] = struct{}{}
+	if f.log != nil {
+		f.unlogged = append(f.unlogged, ev)
+	}
+	if len(f.events) > maxBufferedChanges {
+		// Drop the older half in one go, instead of shifting on every event.
+		n := len(f.events) / 2
+		if logged := len(f.events) - len(f.unlogged); n > logged {
+			n = logged
+		}
+		for _, old := range f.events[:n] {
+			delete(f.seen, old.CommitTs)
+			if old.CommitTs > f.droppedTs {
+				f.droppedTs = old.CommitTs
+			}
+		}
+		f.events = append([]*api.ChangeEvent{}, f.events[n:]...)
+	}
+	if f.log != nil {
+		select {
+		case f.logReady <- struct{}{}:
+		default:
+		}
+	}
+	notifyLocalCommit(ev)
+	for ch := range f.subs {
+		select {
+		case ch <- ev:
+		default:
+			// Don't let a slow subscriber hold up commits.
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// writeLog writes the emitted events to the change log, so that commits don't wait on it.
+func (f *changeFeed) writeLog() {
+	for range f.logReady {
+		f.Lock()
+		evs := f.unlogged
+		f.Unlock()
+		for _, ev := range evs {
+			if err := f.log.write(ev); err != nil {
+				x.Printf("Error while writing to change log: %v\n", err)
+			}
+		}
+		f.Lock()
+		f.unlogged = f.unlogged[len(evs):]
+		f.Unlock()
+	}
+}
+
+// subscribe returns the buffered events committed after sinceTs, and a channel on which the
+// later ones would be sent. Without sinceTs, it starts with the next event. It also returns
+// whether events committed after sinceTs were dropped from the buffer, and need to be read
+// back from the change log.
+func (f *changeFeed) subscribe(sinceTs uint64) ([]*api.ChangeEvent,
+	chan *api.ChangeEvent, bool, error) {
+	f.Lock()
+	defer f.Unlock()
+	fromLog := sinceTs > 0 && f.droppedTs > sinceTs
+	if fromLog && f.log == nil {
+		return nil, nil, false, x.Errorf(
+			"Changes after ts: %d are no longer buffered, and there is no change log", sinceTs)
+	}
+	var backlog []*api.ChangeEvent
+	if sinceTs > 0 {
+		for _, ev := range f.events {
+			if ev.CommitTs > sinceTs {
+				backlog = append(backlog, ev)
+			}
+		}
+	}
+	ch := make(chan *api.ChangeEvent, subscriberBuffer)
+	f.subs[ch] = struct{}{}
+	return backlog, ch, fromLog, nil
+}
+
+func (f *changeFeed) unsubscribe(ch chan *api.ChangeEvent) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.subs, ch)
+}
+
+// progress returns a commit_ts up to which every change of the group was emitted. Commits
+// are applied out of commit_ts order, so it stays below the ones Zero decided on which are
+// still being applied, or waiting on an earlier proposal to be emitted.
+func (f *changeFeed) progress() uint64 {
+	// Zero tells us about every commit up to the max pending timestamp with it, and the
+	// transactions stay pending until their commit is published.
+	mark := posting.Oracle().MaxPending()
+	if ts := posting.Txns().MinPendingCommitTs(); ts > 0 && ts <= mark {
+		mark = ts - 1
+	}
+	f.Lock()
+	defer f.Unlock()
+	for _, pc := range f.pending {
+		if pc.ev != nil && pc.ev.CommitTs <= mark {
+			mark = pc.ev.CommitTs - 1
+		}
+	}
+	return mark
+}
+
+// orderedChanges holds back the events of a group until a progress mark says that no earlier
+// commit is still to come.
+type orderedChanges struct {
+	gid    uint32
+	events []*api.ChangeEvent
+	sentTs uint64 // Every event up to it was sent.
+}
+
+func (o *orderedChanges) add(evs ...*api.ChangeEvent) {
+	for _, ev := range evs {
+		if ev.CommitTs > o.sentTs {
+			o.events = append(o.events, ev)
+		}
+	}
+}
+
+// release sends the events up to mark in commit_ts order, once each, followed by the mark.
+func (o *orderedChanges) release(mark uint64, send func(*api.ChangeEvent) error) error {
+	if mark <= o.sentTs {
+		return nil
+	}
+	sort.Slice(o.events, func(i, j int) bool {
+		return o.events[i].CommitTs < o.events[j].CommitTs
+	})
+	i := 0
+	for ; i < len(o.events) && o.events[i].CommitTs <= mark; i++ {
+		ev := o.events[i]
+		if ev.CommitTs <= o.sentTs {
+			// Read back from the change log, and buffered too.
+			continue
+		}
+		if err := send(ev); err != nil {
+			return err
+		}
+		o.sentTs = ev.CommitTs
+	}
+	o.events = append(o.events[:0:0], o.events[i:]...)
+	o.sentTs = mark
+	return send(&api.ChangeEvent{CommitTs: mark, GroupId: o.gid})
+}
+
+// streamGroupChanges sends the changes to this server's group committed after sinceTs in
+// commit_ts order, with a progress mark every changeMarkInterval.
+func streamGroupChanges(ctx context.Context, sinceTs uint64,
+	send func(*api.ChangeEvent) error) error {
+	backlog, ch, fromLog, err := changes.subscribe(sinceTs)
+	if err != nil {
+		return err
+	}
+	defer changes.unsubscribe(ch)
+	if fromLog {
+		logged, err := changes.log.read(sinceTs)
+		if err != nil {
+			return x.Wrapf(err, "While reading the change log")
+		}
+		backlog = append(logged, backlog...)
+	}
+
+	o := &orderedChanges{gid: groups().groupId(), sentTs: sinceTs}
+	o.add(backlog...)
+	ticker := time.NewTicker(changeMarkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return errSlowSubscriber
+			}
+			o.add(ev)
+		case <-ticker.C:
+			mark := changes.progress()
+			// The events emitted before the mark was taken are in ch already.
+		drain:
+			for {
+				select {
+				case ev, ok := <-ch:
+					if !ok {
+						return errSlowSubscriber
+					}
+					o.add(ev)
+				default:
+					break drain
+				}
+			}
+			if err := o.release(mark, send); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// GroupChanges streams the changes to this server's group, for a subscription merging those
+// of every group.
+func (w *grpcWorker) GroupChanges(req *api.SubscribeRequest,
+	stream intern.Worker_GroupChangesServer) error {
+	return streamGroupChanges(stream.Context(), req.SinceTs, stream.Send)
+}
+
+// changeMerger merges the ordered streams of changes of the groups into one, in commit_ts
+// order. The edges a transaction wrote to several groups go out in one event.
+type changeMerger struct {
+	gids   []uint32
+	queues map[uint32][]*api.ChangeEvent
+	marks  map[uint32]uint64
+	sentTs uint64 // Every event up to it was sent.
+}
+
+func newChangeMerger(gids []uint32, sinceTs uint64) *changeMerger {
+	m := &changeMerger{
+		gids:   gids,
+		queues: make(map[uint32][]*api.ChangeEvent),
+		marks:  make(map[uint32]uint64),
+		sentTs: sinceTs,
+	}
+	for _, gid := range gids {
+		m.marks[gid] = sinceTs
+	}
+	return m
+}
+
+func (m *changeMerger) add(gid uint32, ev *api.ChangeEvent) {
+	if len(ev.Set) == 0 && len(ev.Del) == 0 {
+		if ev.CommitTs > m.marks[gid] {
+			m.marks[gid] = ev.CommitTs
+		}
+		return
+	}
+	m.queues[gid] = append(m.queues[gid], ev)
+}
+
+// ready returns the events no group can send an earlier commit than anymore, followed by a
+// progress mark if every group moved past the last event sent.
+func (m *changeMerger) ready() []*api.ChangeEvent {
+	var out []*api.ChangeEvent
+	for {
+		var head uint64
+		for _, gid := range m.gids {
+			if q := m.queues[gid]; len(q) > 0 && (head == 0 || q[0].CommitTs < head) {
+				head = q[0].CommitTs
+			}
+		}
+		if head == 0 {
+			break
+		}
+		for _, gid := range m.gids {
+			if len(m.queues[gid]) == 0 && m.marks[gid] < head {
+				// The group could still send a commit before head.
+				return m.withMark(out)
+			}
+		}
+		var parts []*api.ChangeEvent
+		for _, gid := range m.gids {
+			if q := m.queues[gid]; len(q) > 0 && q[0].CommitTs == head {
+				parts = append(parts, q[0])
+				m.queues[gid] = q[1:]
+			}
+		}
+		out = append(out, mergeChanges(parts))
+		m.sentTs = head
+	}
+	return m.withMark(out)
+}
+
+func (m *changeMerger) withMark(out []*api.ChangeEvent) []*api.ChangeEvent {
+	mark := uint64(math.MaxUint64)
+	for _, gid := range m.gids {
+		gm := m.marks[gid]
+		if q := m.queues[gid]; len(q) > 0 {
+			gm = q[0].CommitTs - 1
+		}
+		if gm < mark {
+			mark = gm
+		}
+	}
+	if len(m.gids) == 0 || mark <= m.sentTs {
+		return out
+	}
+	m.sentTs = mark
+	return append(out, &api.ChangeEvent{CommitTs: mark})
+}
+
+// mergeChanges returns one event with the edges of the events of a transaction.
+func mergeChanges(parts []*api.ChangeEvent) *api.ChangeEvent {
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	ev := &api.ChangeEvent{CommitTs: parts[0].CommitTs, StartTs: parts[0].StartTs}
+	for _, part := range parts {
+		ev.Set = append(ev.Set, part.Set...)
+		ev.Del = append(ev.Del, part.Del...)
+	}
+	return ev
+}
+
+// filterChanges returns the event with only the edges of namespace ns, and of the given
+// predicates if any, or nil if it has none of them. Predicates ctx doesn't allow are left out.
+// The predicates of the edges returned don't have the namespace prefix.
+func filterChanges(ctx context.Context, ev *api.ChangeEvent, ns uint64,
+	preds map[string]struct{}) *api.ChangeEvent {
+	keep := func(nqs []*api.NQuad) []*api.NQuad {
+		var out []*api.NQuad
+		for _, nq := range nqs {
+			pred, ok := inNamespace(ns, nq.Predicate)
+			if !ok {
+				continue
+			}
+			if _, ok := preds[pred]; len(preds) > 0 && !ok {
+				continue
+			}
+			if !x.PredicateAllowed(ctx, pred) {
+				continue
+			}
+			if pred != nq.Predicate {
+				nqc := *nq
+				nqc.Predicate = pred
+				nq = &nqc
+			}
+			out = append(out, nq)
+		}
+		return out
+	}
+	res := *ev
+	res.Set, res.Del = keep(ev.Set), keep(ev.Del)
+	if len(res.Set) == 0 && len(res.Del) == 0 {
+		return nil
+	}
+	return &res
+}
+
+type groupChange struct {
+	gid uint32
+	ev  *api.ChangeEvent
+	err error
+}
+
+// Subscribe streams the edges of committed transactions, merged from every group in commit_ts
+// order. Pass the commit_ts of the last event seen in req.AfterTs to resume, or the read_ts
+// of a backup in req.SinceTs to start with the changes missing from it. Only the edges of the
+// namespace set in ctx are sent.
+func Subscribe(ctx context.Context, req *api.SubscribeRequest,
+	stream api.Dgraph_SubscribeServer) error {
+	sinceTs := req.AfterTs
+	if sinceTs == 0 {
+		sinceTs = req.SinceTs
+	}
+	if sinceTs == 0 {
+		// Every group starts at the same commit, so that no transaction is sent in part.
+		sinceTs = posting.Oracle().MaxPending()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	gids := groups().KnownGroups()
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	ch := make(chan groupChange, subscriberBuffer)
+	for _, gid := range gids {
+		go tailGroup(ctx, gid, sinceTs, ch)
+	}
+
+	preds := make(map[string]struct{})
+	for _, pred := range req.Predicates {
+		preds[pred] = struct{}{}
+	}
+	ns := x.Namespace(ctx)
+	m := newChangeMerger(gids, sinceTs)
+	var lastMark time.Time
+	ticker := time.NewTicker(groupsCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case gc := <-ch:
+			if gc.err != nil {
+				return gc.err
+			}
+			m.add(gc.gid, gc.ev)
+			for _, ev := range m.ready() {
+				if len(ev.Set) == 0 && len(ev.Del) == 0 {
+					if time.Since(lastMark) < subscriberMarkInterval {
+						continue
+					}
+					lastMark = time.Now()
+				} else if ev = filterChanges(ctx, ev, ns, preds); ev == nil {
+					continue
+				}
+				if err := stream.Send(ev); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if len(groups().KnownGroups()) != len(gids) {
+				return errGroupsChanged
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// tailGroup sends the changes to group gid to ch, from this server if it serves the group, or
+// else from a server of the group. It ends with the error which stopped the stream.
+func tailGroup(ctx context.Context, gid uint32, sinceTs uint64, ch chan<- groupChange) {
+	send := func(ev *api.ChangeEvent) error {
+		select {
+		case ch <- groupChange{gid: gid, ev: ev}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	var err error
+	if gid == groups().groupId() {
+		err = streamGroupChanges(ctx, sinceTs, send)
+	} else {
+		err = tailRemoteGroup(ctx, gid, sinceTs, send)
+	}
+	select {
+	case ch <- groupChange{gid: gid, err: err}:
+	case <-ctx.Done():
+	}
+}
+
+func tailRemoteGroup(ctx context.Context, gid uint32, sinceTs uint64,
+	send func(*api.ChangeEvent) error) error {
+	pl := groups().AnyServer(gid)
+	if pl == nil {
+		return x.Errorf("No server of group %d to stream its changes from", gid)
+	}
+	stream, err := intern.NewWorkerClient(pl.Get()).GroupChanges(ctx,
+		&api.SubscribeRequest{SinceTs: sinceTs})
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := send(ev); err != nil {
+			return err
+		}
+	}
+}
+
+// changeLog writes change events as JSON lines to files in dir, starting a new file once
+// the current one grows past maxChangeLogSize. Files are named after the commit_ts of
+// their first event.
+type changeLog struct {
+	dir  string
+	f    *os.File
+	size int64
+}
+
+func (l *changeLog) write(ev *api.ChangeEvent) error {
+	var buf bytes.Buffer
+	m := jsonpb.Marshaler{OrigName: true}
+	if err := m.Marshal(&buf, ev); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	if l.f == nil || l.size >= maxChangeLogSize {
+		if err := l.rotate(ev.CommitTs); err != nil {
+			return err
+		}
+	}
+	n, err := l.f.Write(buf.Bytes())
+	l.size += int64(n)
+	return err
+}
+
+// read returns the events of the log committed after sinceTs, in the order they were written.
+func (l *changeLog) read(sinceTs uint64) ([]*api.ChangeEvent, error) {
+	files, err := filepath.Glob(filepath.Join(l.dir, "changes-*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	var evs []*api.ChangeEvent
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for len(data) > 0 {
+			i := bytes.IndexByte(data, '\n')
+			if i < 0 {
+				// Still being written.
+				break
+			}
+			var ev api.ChangeEvent
+			if err := jsonpb.Unmarshal(bytes.NewReader(data[:i]), &ev); err != nil {
+				return nil, x.Wrapf(err, "While reading %s", file)
+			}
+			if ev.CommitTs > sinceTs {
+				evs = append(evs, &ev)
+			}
+			data = data[i+1:]
+		}
+	}
+	return evs, nil
+}
+
+func (l *changeLog) rotate(ts uint64) error {
+	if l.f != nil {
+		if err := l.f.Close(); err != nil {
+			return err
+		}
+		l.f = nil
+	}
+	name := filepath.Join(l.dir, fmt.Sprintf("changes-%020d.jsonl", ts))
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	l.f, l.size = f, fi.Size()
+	return nil
+}
@@ -0,0 +1,264 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func newTestFeed() *changeFeed {
+	return newChangeFeed()
+}
+
+func changeEvent(commitTs uint64, preds ...string) *api.ChangeEvent {
+	ev := &api.ChangeEvent{CommitTs: commitTs}
+	for _, pred := range preds {
+		ev.Set = append(ev.Set, &api.NQuad{Subject: "0x1", Predicate: pred, ObjectId: "0x2"})
+	}
+	return ev
+}
+
+func commitTimestamps(evs []*api.ChangeEvent) []uint64 {
+	var res []uint64
+	for _, ev := range evs {
+		res = append(res, ev.CommitTs)
+	}
+	return res
+}
+
+func TestChangeFeedOrder(t *testing.T) {
+	f := newTestFeed()
+	f.begin(10)
+	f.begin(11)
+	f.begin(12)
+
+	f.done(12, changeEvent(7, "name"))
+	f.done(11, nil) // Aborted.
+	require.Empty(t, f.events)
+
+	f.done(10, changeEvent(9, "name"))
+	require.Equal(t, []uint64{9, 7}, commitTimestamps(f.events))
+	require.Empty(t, f.pending)
+
+	// The same commit applied again is only sent out once.
+	f.done(0, changeEvent(9, "name"))
+	require.Equal(t, []uint64{9, 7}, commitTimestamps(f.events))
+}
+
+func TestChangeFeedSubscribe(t *testing.T) {
+	f := newTestFeed()
+	f.done(0, changeEvent(3, "name"))
+	f.done(0, changeEvent(5, "age"))
+
+	backlog, ch, fromLog, err := f.subscribe(3)
+	require.NoError(t, err)
+	require.False(t, fromLog)
+	require.Equal(t, []uint64{5}, commitTimestamps(backlog))
+
+	f.done(0, changeEvent(8, "name"))
+	require.Equal(t, uint64(8), (<-ch).CommitTs)
+
+	// A subscriber which doesn't keep up gets dropped.
+	for i := 0; i <= subscriberBuffer; i++ {
+		f.done(0, changeEvent(uint64(100+i), "name"))
+	}
+	for range ch {
+	}
+	require.Empty(t, f.subs)
+}
+
+func TestChangeFeedSubscribeSince(t *testing.T) {
+	f := newTestFeed()
+	f.done(0, changeEvent(5, "name"))
+	f.done(0, changeEvent(3, "name"))
+	f.done(0, changeEvent(8, "age"))
+
+	backlog, _, _, err := f.subscribe(4)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 8}, commitTimestamps(backlog))
+
+	for i := 0; i < maxBufferedChanges; i++ {
+		f.done(0, changeEvent(uint64(100+i), "name"))
+	}
+	// Without a change log, the dropped events are gone.
+	_, _, _, err = f.subscribe(4)
+	require.Error(t, err)
+	backlog, _, fromLog, err := f.subscribe(100 + maxBufferedChanges - 2)
+	require.NoError(t, err)
+	require.False(t, fromLog)
+	require.Equal(t, []uint64{100 + maxBufferedChanges - 1}, commitTimestamps(backlog))
+}
+
+func TestChangeFeedResumeFromLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	f := newTestFeed()
+	f.log = &changeLog{dir: dir}
+	for i := 0; i <= maxBufferedChanges; i++ {
+		f.done(0, changeEvent(uint64(100+i), "name"))
+	}
+	// Events not written to the log yet stay buffered.
+	require.Len(t, f.events, maxBufferedChanges+1)
+
+	go f.writeLog()
+	for {
+		f.Lock()
+		n := len(f.unlogged)
+		f.Unlock()
+		if n == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	f.done(0, changeEvent(100+maxBufferedChanges+1, "name"))
+	require.True(t, len(f.events) <= maxBufferedChanges)
+
+	backlog, _, fromLog, err := f.subscribe(150)
+	require.NoError(t, err)
+	require.True(t, fromLog)
+	logged, err := f.log.read(150)
+	require.NoError(t, err)
+	require.Equal(t, uint64(151), logged[0].CommitTs)
+	require.True(t, logged[len(logged)-1].CommitTs >= backlog[0].CommitTs-1)
+}
+
+func TestOrderedChanges(t *testing.T) {
+	o := &orderedChanges{gid: 1, sentTs: 4}
+	o.add(changeEvent(9, "name"), changeEvent(3, "name"), changeEvent(6, "name"))
+	// Read back both from the change log and from the buffer.
+	o.add(changeEvent(6, "name"))
+
+	var sent []*api.ChangeEvent
+	send := func(ev *api.ChangeEvent) error {
+		sent = append(sent, ev)
+		return nil
+	}
+	require.NoError(t, o.release(7, send))
+	require.Equal(t, []uint64{6, 7}, commitTimestamps(sent))
+	require.Empty(t, sent[1].Set)
+	require.Equal(t, uint32(1), sent[1].GroupId)
+
+	// A mark which doesn't move forward isn't sent again.
+	sent = nil
+	require.NoError(t, o.release(7, send))
+	require.Empty(t, sent)
+	require.NoError(t, o.release(10, send))
+	require.Equal(t, []uint64{9, 10}, commitTimestamps(sent))
+}
+
+func TestChangeMerger(t *testing.T) {
+	m := newChangeMerger([]uint32{1, 2}, 2)
+	m.add(1, changeEvent(5, "name"))
+	m.add(1, changeEvent(8, "name"))
+	// Group 2 could still send an earlier commit.
+	require.Empty(t, m.ready())
+
+	m.add(2, &api.ChangeEvent{CommitTs: 4, GroupId: 2})
+	out := m.ready()
+	require.Equal(t, []uint64{4}, commitTimestamps(out))
+	require.Empty(t, out[0].Set)
+
+	// A transaction which wrote to both groups goes out as one event.
+	m.add(2, changeEvent(5, "age"))
+	m.add(2, &api.ChangeEvent{CommitTs: 9, GroupId: 2})
+	out = m.ready()
+	require.Equal(t, []uint64{5, 8}, commitTimestamps(out))
+	require.Len(t, out[0].Set, 2)
+	require.Zero(t, out[0].GroupId)
+
+	m.add(1, &api.ChangeEvent{CommitTs: 12, GroupId: 1})
+	require.Equal(t, []uint64{9}, commitTimestamps(m.ready()))
+}
+
+func TestFilterChanges(t *testing.T) {
+	ctx := context.Background()
+	ev := changeEvent(3, "name", "age")
+	preds := map[string]struct{}{"age": {}}
+	res := filterChanges(ctx, ev, 0, preds)
+	require.Len(t, res.Set, 1)
+	require.Equal(t, "age", res.Set[0].Predicate)
+	require.Len(t, ev.Set, 2)
+
+	require.Nil(t, filterChanges(ctx, changeEvent(4, "name"), 0, preds))
+	require.Equal(t, ev, filterChanges(ctx, ev, 0, nil))
+
+	// Edges of other namespaces are left out, and the prefix of those kept removed.
+	ev = changeEvent(5, "name", "7|name", "7|age")
+	res = filterChanges(ctx, ev, 0, nil)
+	require.Len(t, res.Set, 1)
+	require.Equal(t, "name", res.Set[0].Predicate)
+	res = filterChanges(ctx, ev, 7, preds)
+	require.Len(t, res.Set, 1)
+	require.Equal(t, "age", res.Set[0].Predicate)
+	require.Equal(t, "7|age", ev.Set[2].Predicate)
+	require.Nil(t, filterChanges(ctx, ev, 8, nil))
+
+	// So are the predicates the request isn't allowed to see.
+	ctx = x.WithPredicateFilter(ctx, func(pred string) bool { return pred != "name" })
+	res = filterChanges(ctx, changeEvent(6, "name", "age"), 0, nil)
+	require.Len(t, res.Set, 1)
+	require.Equal(t, "age", res.Set[0].Predicate)
+}
+
+func TestChangeLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	l := &changeLog{dir: dir}
+	require.NoError(t, l.write(changeEvent(3, "name")))
+	require.NoError(t, l.write(changeEvent(5, "age")))
+	l.size = maxChangeLogSize
+	require.NoError(t, l.write(changeEvent(8, "name")))
+	require.NoError(t, l.f.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "changes-*.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "changes-00000000000000000003.jsonl"),
+		filepath.Join(dir, "changes-00000000000000000008.jsonl"),
+	}, files)
+
+	fd, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer fd.Close()
+	var got []*api.ChangeEvent
+	scanner := bufio.NewScanner(fd)
+	for scanner.Scan() {
+		var ev api.ChangeEvent
+		require.NoError(t, jsonpb.UnmarshalString(scanner.Text(), &ev))
+		got = append(got, &ev)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []uint64{3, 5}, commitTimestamps(got))
+	require.Equal(t, "age", got[1].Set[0].Predicate)
+}
@@ -16,9 +16,15 @@
  */
 package worker
 
+import "time"
+
 type Options struct {
 	BaseWorkerPort      int
 	ExportPath          string
+	BackupPath          string
+	CdcPath             string
+	WalArchivePath      string
+	WalArchiveRetention time.Duration
 	NumPendingProposals int
 	Tracing             float64
 	GroupIds            string
@@ -27,6 +33,8 @@ type Options struct {
 	RaftId              uint64
 	MaxPendingCount     uint64
 	ExpandEdge          bool
+	TTLPurgeInterval    time.Duration
+	EncryptExports      bool
 }
 
 var Config Options
@@ -0,0 +1,162 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Compiled @pattern regexps, keyed by the pattern text.
+var patterns struct {
+	sync.RWMutex
+	m map[string]*regexp.Regexp
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	patterns.RLock()
+	re, ok := patterns.m[pattern]
+	patterns.RUnlock()
+	if ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Lock()
+	if patterns.m == nil {
+		patterns.m = make(map[string]*regexp.Regexp)
+	}
+	patterns.m[pattern] = re
+	patterns.Unlock()
+	return re, nil
+}
+
+// checkConstraint returns an error if the value, already converted to the schema type of the
+// predicate, doesn't satisfy its constraint.
+func checkConstraint(attr string, c *intern.Constraint, val types.Val) error {
+	if c == nil {
+		return nil
+	}
+	switch v := val.Value.(type) {
+	case int64:
+		return checkRange(attr, c, float64(v), strconv.FormatInt(v, 10))
+	case float64:
+		return checkRange(attr, c, v, strconv.FormatFloat(v, 'g', -1, 64))
+	case string:
+		if len(c.Pattern) > 0 {
+			re, err := compilePattern(c.Pattern)
+			if err != nil {
+				return err
+			}
+			if !re.MatchString(v) {
+				return x.Errorf("Value %q for predicate %s doesn't match @pattern(%s)",
+					v, attr, strconv.Quote(c.Pattern))
+			}
+		}
+		if len(c.Enum) > 0 {
+			for _, e := range c.Enum {
+				if e == v {
+					return nil
+				}
+			}
+			return x.Errorf("Value %q for predicate %s is not one of @enum(%s)",
+				v, attr, quoteAll(c.Enum))
+		}
+	}
+	return nil
+}
+
+func checkRange(attr string, c *intern.Constraint, v float64, text string) error {
+	if (c.HasMin && v < c.Min) || (c.HasMax && v > c.Max) {
+		return x.Errorf("Value %s for predicate %s is out of range @constraint(%s)",
+			text, attr, constraintRange(c))
+	}
+	return nil
+}
+
+// constraintRange formats the min and max of the constraint the way the schema parser reads
+// them, e.g. "min: 0, max: 150".
+func constraintRange(c *intern.Constraint) string {
+	var parts []string
+	if c.HasMin {
+		parts = append(parts, "min: "+strconv.FormatFloat(c.Min, 'g', -1, 64))
+	}
+	if c.HasMax {
+		parts = append(parts, "max: "+strconv.FormatFloat(c.Max, 'g', -1, 64))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func quoteAll(vals []string) string {
+	quoted := make([]string, 0, len(vals))
+	for _, v := range vals {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// constraintDirectives returns the schema directives for the constraint, each preceded by a
+// space, or an empty string if there is no constraint.
+func constraintDirectives(c *intern.Constraint) string {
+	if c == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	if c.HasMin || c.HasMax {
+		fmt.Fprintf(&buf, " @constraint(%s)", constraintRange(c))
+	}
+	if len(c.Pattern) > 0 {
+		fmt.Fprintf(&buf, " @pattern(%s)", strconv.Quote(c.Pattern))
+	}
+	if len(c.Enum) > 0 {
+		fmt.Fprintf(&buf, " @enum(%s)", quoteAll(c.Enum))
+	}
+	return buf.String()
+}
+
+// validateExistingValues checks the values stored for attr as of readTs against a constraint
+// that is about to be added to its schema, so that the schema never describes data that
+// doesn't satisfy it. Values that can't be converted to typ are skipped if skipInvalid is set,
+// because a type migration is about to drop them.
+func validateExistingValues(attr string, typ types.TypeID, c *intern.Constraint,
+	readTs uint64, skipInvalid bool) error {
+	return posting.IterateValues(attr, readTs, func(uid uint64, p *intern.Posting) error {
+		val, err := convertPosting(p, typ)
+		if err != nil && skipInvalid {
+			return nil
+		}
+		if err == nil {
+			err = checkConstraint(attr, c, val)
+		}
+		if err != nil {
+			return x.Errorf("Existing data for uid %#x violates the new schema: %v", uid, err)
+		}
+		return nil
+	})
+}
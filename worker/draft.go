@@ -208,11 +208,14 @@ func (n *node) ProposeAndWait(ctx context.Context, proposal *intern.Proposal) er
 				// Tablet can move by the time request reaches here.
 				return errUnservedTablet
 			}
-			if typ, err := schema.State().TypeOf(edge.Attr); err != nil {
+			su, ok := schema.State().Get(edge.Attr)
+			if !ok {
 				continue
-			} else if err := ValidateAndConvert(edge, typ); err != nil {
+			}
+			if err := ValidateAndConvert(edge, &su); err != nil {
 				return err
 			}
+			setExpiry(edge, &su)
 		}
 		for _, schema := range proposal.Mutations.Schema {
 			if tablet := groups().Tablet(schema.Predicate); tablet != nil && tablet.ReadOnly {
@@ -286,11 +289,11 @@ func (n *node) processMutation(task *task) error {
 }
 
 func (n *node) processSchemaMutations(pid uint32, index uint64,
-	startTs uint64, s *intern.SchemaUpdate) error {
+	startTs, commitTs uint64, s *intern.SchemaUpdate, dropInvalid bool) error {
 	ctx, _ := n.props.CtxAndTxn(pid)
 	rv := x.RaftValue{Group: n.gid, Index: index}
 	ctx = context.WithValue(ctx, "raft", rv)
-	if err := runSchemaMutation(ctx, s, startTs); err != nil {
+	if err := runSchemaMutation(ctx, s, startTs, commitTs, dropInvalid); err != nil {
 		if tr, ok := trace.FromContext(n.ctx); ok {
 			tr.LazyPrintf(err.Error())
 		}
@@ -369,7 +372,12 @@ func (n *node) processApplyCh() {
 			n.props.Done(proposal.Id, nil)
 		} else if len(proposal.CleanPredicate) > 0 {
 			go n.deletePredicate(e.Index, proposal.Id, proposal.CleanPredicate)
+		} else if proposal.Rename != nil {
+			go n.renamePredicate(e.Index, proposal.Id, proposal.Rename)
+		} else if proposal.Split != nil {
+			go n.splitPredicate(e.Index, proposal.Id, proposal.Split)
 		} else if proposal.TxnContext != nil {
+			changes.begin(e.Index)
 			go n.commitOrAbort(e.Index, proposal.Id, proposal.TxnContext)
 		} else {
 			x.Fatalf("Unknown proposal")
@@ -379,10 +387,12 @@ func (n *node) processApplyCh() {
 
 func (n *node) commitOrAbort(index uint64, pid uint32, tctx *api.TxnContext) {
 	ctx, _ := n.props.CtxAndTxn(pid)
+	txn := posting.Txns().Get(tctx.StartTs)
 	_, err := commitOrAbort(ctx, tctx)
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("Status of commitOrAbort %+v %v\n", tctx, err)
 	}
+	changes.publish(index, txn, tctx, err)
 	if err == nil {
 		posting.Txns().Done(tctx.StartTs)
 		posting.Oracle().Done(tctx.StartTs)
@@ -525,6 +535,9 @@ func (n *node) Run() {
 					n.applyCh <- entry
 				}
 			}
+			if err := n.Wal.ArchiveApplied(n.gid, rd.CommittedEntries); err != nil {
+				x.Printf("Error while archiving entries of group %d: %v\n", n.gid, err)
+			}
 
 			if !leader {
 				// Followers should send messages later.
@@ -607,6 +620,10 @@ func (n *node) snapshot(skip uint64) {
 		return
 	}
 	snapshotIdx := le - skip
+	// The entries not in the WAL archive yet have to stay in the WAL.
+	if snapshotIdx = n.Wal.Archived(n.gid, snapshotIdx); snapshotIdx <= si {
+		return
+	}
 	if tr, ok := trace.FromContext(n.ctx); ok {
 		tr.LazyPrintf("Taking snapshot for group: %d at watermark: %d\n", n.gid, snapshotIdx)
 	}
@@ -0,0 +1,251 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/dgraph/y"
+)
+
+// Number of expired postings deleted per transaction by the purge.
+const purgeBatchSize = 1000
+
+// setExpiry stamps a SET edge of a predicate with a TTL in its schema with the time at which it
+// expires, unless the mutation gave the edge a TTL of its own. This happens before the edge is
+// proposed, so that all replicas store the same expiry.
+func setExpiry(edge *intern.DirectedEdge, su *intern.SchemaUpdate) {
+	if su.Ttl == 0 || edge.ExpiresAt != 0 || edge.Op != intern.DirectedEdge_SET {
+		return
+	}
+	edge.ExpiresAt = uint64(time.Now().Unix()) + su.Ttl
+}
+
+// ttlString formats a TTL in seconds the way it's written in the schema.
+func ttlString(ttl uint64) string {
+	return (time.Duration(ttl) * time.Second).String()
+}
+
+// ttlPredicates keeps track of the predicates without postings that have a TTL, so that the
+// purge doesn't scan them over and over. Every replica applies the same mutations, so a new
+// leader knows about the postings given a TTL before it took over.
+type ttlPredicates struct {
+	sync.Mutex
+	// Predicates a scan found no posting with a TTL in, and that got none since.
+	clean map[string]bool
+	// Start timestamps of the transactions that gave postings of a predicate a TTL, kept until
+	// the transaction is done.
+	txns map[string]map[uint64]struct{}
+	// Counts the postings given a TTL per predicate, to tell whether any were during a scan.
+	notes map[string]uint64
+}
+
+var ttlPreds = ttlPredicates{
+	clean: make(map[string]bool),
+	txns:  make(map[string]map[uint64]struct{}),
+	notes: make(map[string]uint64),
+}
+
+// note records that the transaction at startTs gave a posting of attr a TTL.
+func (t *ttlPredicates) note(attr string, startTs uint64) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.clean, attr)
+	if t.txns[attr] == nil {
+		t.txns[attr] = make(map[uint64]struct{})
+	}
+	t.txns[attr][startTs] = struct{}{}
+	t.notes[attr]++
+}
+
+// dirty makes the purge scan attr again, because its keys were written some other way.
+func (t *ttlPredicates) dirty(attr string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.clean, attr)
+	t.notes[attr]++
+}
+
+// toScan tells whether attr has to be scanned for expired postings. If it has, it also returns
+// whether a scan starting now can find it clean, and the mark to pass to markClean then.
+// It can't while a transaction that gave it a TTL is pending, as the scan might not see its
+// postings.
+func (t *ttlPredicates) toScan(attr string) (scan, canClean bool, mark uint64) {
+	t.Lock()
+	defer t.Unlock()
+	if t.clean[attr] {
+		return false, false, 0
+	}
+	for startTs := range t.txns[attr] {
+		if posting.Txns().Get(startTs) == nil {
+			delete(t.txns[attr], startTs)
+		}
+	}
+	if len(t.txns[attr]) == 0 {
+		delete(t.txns, attr)
+	}
+	return true, len(t.txns[attr]) == 0, t.notes[attr]
+}
+
+// markClean skips attr in the next purges, unless a posting of it was given a TTL since toScan
+// returned mark.
+func (t *ttlPredicates) markClean(attr string, mark uint64) {
+	t.Lock()
+	defer t.Unlock()
+	if t.notes[attr] == mark {
+		t.clean[attr] = true
+	}
+}
+
+// purgeExpiredPeriodically deletes the postings whose TTL has run out. Reads skip them as soon
+// as they expire, but they stay on disk, along with their count entries, until purged. Only
+// the group leader purges, so every expired posting is deleted once. Predicates are only
+// scanned while they may have postings with a TTL.
+func (g *groupi) purgeExpiredPeriodically() {
+	if Config.TTLPurgeInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(Config.TTLPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-g.ctx.Done():
+			return
+		}
+		if !g.Node.AmLeader() {
+			continue
+		}
+		for _, attr := range schema.State().Predicates() {
+			if !g.ServesTablet(attr) {
+				continue
+			}
+			if tablet := g.Tablet(attr); tablet != nil && tablet.ReadOnly {
+				// Being moved or renamed. Purge it next time.
+				continue
+			}
+			scan, canClean, mark := ttlPreds.toScan(attr)
+			if !scan {
+				continue
+			}
+			left, err := purgeExpired(g.ctx, attr)
+			if err != nil {
+				x.Printf("Error while purging expired edges of predicate %s: %v\n", attr, err)
+				continue
+			}
+			if !left && canClean {
+				ttlPreds.markClean(attr, mark)
+			}
+		}
+	}
+}
+
+// errBatchFull stops the scan for expired postings once a batch is full.
+var errBatchFull = x.Errorf("Batch of expired postings is full")
+
+// purgeExpired deletes the expired postings of attr. They are deleted in ordinary
+// transactions, so their index, reverse and count entries go away with them. Each batch is
+// found and deleted at the same timestamp, so an edge that is set again in the meantime
+// conflicts with the purge instead of being deleted by it. It returns whether attr still has
+// postings with a TTL.
+func purgeExpired(ctx context.Context, attr string) (bool, error) {
+	var left bool
+	for {
+		ts, err := Timestamps(ctx, &intern.Num{Val: 1})
+		if err != nil {
+			return true, err
+		}
+		now := time.Now().Unix()
+		var edges []*intern.DirectedEdge
+		err = posting.IterateValues(attr, ts.StartId, func(uid uint64, p *intern.Posting) error {
+			if !posting.Expired(p, now) {
+				left = left || p.ExpiresAt != 0
+				return nil
+			}
+			edges = append(edges, expiredEdge(attr, uid, p))
+			if len(edges) == purgeBatchSize {
+				return errBatchFull
+			}
+			return nil
+		})
+		if err == errBatchFull {
+			// The rest of the postings weren't looked at.
+			left = true
+		} else if err != nil {
+			return true, err
+		}
+		if len(edges) == 0 {
+			return left, nil
+		}
+		if aborted, err := deleteEdges(ctx, ts.StartId, edges); err != nil || aborted {
+			// If aborted, the edges were written to in the meantime, so have another look
+			// at them next time.
+			return true, err
+		}
+		if len(edges) < purgeBatchSize {
+			return left, nil
+		}
+		left = false
+	}
+}
+
+// expiredEdge returns the edge that deletes the posting p from the list of uid for attr.
+func expiredEdge(attr string, uid uint64, p *intern.Posting) *intern.DirectedEdge {
+	edge := &intern.DirectedEdge{
+		Entity: uid,
+		Attr:   attr,
+		Op:     intern.DirectedEdge_DEL,
+	}
+	if p.PostingType == intern.Posting_REF {
+		edge.ValueId = p.Uid
+	} else {
+		edge.Value = p.Value
+		edge.ValueType = p.ValType
+		edge.Lang = string(p.LangTag)
+	}
+	return edge
+}
+
+// deleteEdges runs the edges in a transaction at startTs and commits it. It returns whether
+// the transaction was aborted because of a conflict.
+func deleteEdges(ctx context.Context, startTs uint64,
+	edges []*intern.DirectedEdge) (bool, error) {
+	m := &intern.Mutations{Edges: edges, StartTs: startTs}
+	tctx, err := MutateOverNetwork(ctx, m)
+	if err != nil {
+		tctx.Aborted = true
+		CommitOverNetwork(ctx, tctx)
+		if err == y.ErrConflict {
+			return true, nil
+		}
+		return false, err
+	}
+	if _, err := CommitOverNetwork(ctx, tctx); err == y.ErrAborted {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return false, nil
+}
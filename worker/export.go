@@ -21,21 +21,27 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/dgraph-io/badger"
+	geom "github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
 	"golang.org/x/net/context"
 
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
-	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -44,8 +50,21 @@ import (
 
 const numExportRoutines = 100
 
+const (
+	ExportFormatRDF  = "rdf"
+	ExportFormatJSON = "json"
+
+	// ExportManifestFile is written into the directory of an export once all the groups are done.
+	ExportManifestFile = "manifest.json"
+
+	// Separates the predicate from the facet in the keys of JSON mutations.
+	jsonFacetDelimiter = "|"
+)
+
 type kv struct {
-	prefix string
+	prefix string // Subject and predicate of the RDF triples.
+	attr   string
+	uid    uint64
 	key    []byte
 }
 
@@ -68,9 +87,12 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.PasswordID: "xs:string",
 }
 
-func toRDF(buf *bytes.Buffer, item kv, readTs uint64) {
+// toRDF writes the triples of the posting list, and returns how many there were.
+func toRDF(buf *bytes.Buffer, item kv, readTs uint64) int {
+	var count int
 	l := posting.GetNoStore(item.key)
 	err := l.Iterate(readTs, 0, func(p *intern.Posting) bool {
+		count++
 		buf.WriteString(item.prefix)
 		if p.PostingType != intern.Posting_REF {
 			// Value posting
@@ -130,6 +152,89 @@ func toRDF(buf *bytes.Buffer, item kv, readTs uint64) {
 		// Ensure that we are not missing errCheck at other places.
 		x.Printf("Error while exporting :%v\n", err)
 	}
+	return count
+}
+
+// toJSON writes an object for each posting of the list, in the format of JSON mutations, and
+// returns how many there were. The objects are preceded by commas, which writeToFile drops
+// from the first one.
+func toJSON(buf *bytes.Buffer, item kv, readTs uint64) int {
+	var count int
+	// Blank nodes, like in RDF, so that the export can be loaded into another cluster.
+	uid := fmt.Sprintf("_:uid%x", item.uid)
+	l := posting.GetNoStore(item.key)
+	err := l.Iterate(readTs, 0, func(p *intern.Posting) bool {
+		obj := map[string]interface{}{"uid": uid}
+		// Facets are on the object of uid edges, along with its uid.
+		fobj := obj
+		if p.PostingType == intern.Posting_REF {
+			fobj = map[string]interface{}{"uid": fmt.Sprintf("_:uid%x", p.Uid)}
+			obj[item.attr] = fobj
+		} else {
+			val, err := jsonValue(p)
+			if err != nil {
+				x.Printf("Error while exporting %s of %s: %v\n", item.attr, uid, err)
+				return true
+			}
+			pred := item.attr
+			if p.PostingType == intern.Posting_VALUE_LANG {
+				pred += "@" + string(p.LangTag)
+			}
+			obj[pred] = val
+		}
+		for _, f := range p.Facets {
+			fobj[item.attr+jsonFacetDelimiter+f.Key] = jsonFacet(f)
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			x.Printf("Error while exporting %s of %s: %v\n", item.attr, uid, err)
+			return true
+		}
+		count++
+		buf.WriteString(",\n")
+		buf.Write(data)
+		return true
+	})
+	if err != nil {
+		x.Printf("Error while exporting :%v\n", err)
+	}
+	return count
+}
+
+// jsonValue returns the value of the posting as JSON mutations take it: numbers and booleans as
+// such, geo values as GeoJSON, and the others as strings.
+func jsonValue(p *intern.Posting) (interface{}, error) {
+	vID := types.TypeID(p.ValType)
+	src := types.ValueForType(vID)
+	src.Value = p.Value
+	switch vID {
+	case types.IntID, types.FloatID, types.BoolID:
+		v, err := types.Convert(src, vID)
+		if err != nil {
+			return nil, err
+		}
+		return v.Value, nil
+	case types.GeoID:
+		v, err := types.Convert(src, vID)
+		if err != nil {
+			return nil, err
+		}
+		data, err := geojson.Marshal(v.Value.(geom.T))
+		return json.RawMessage(data), err
+	}
+	v, err := types.Convert(src, types.StringID)
+	if err != nil {
+		return nil, err
+	}
+	return v.Value, nil
+}
+
+func jsonFacet(f *api.Facet) interface{} {
+	v := facets.ValFor(f)
+	if v.Tid == types.DateTimeID {
+		return v.Value.(time.Time).Format(time.RFC3339Nano)
+	}
+	return v.Value
 }
 
 func toSchema(buf *bytes.Buffer, s *skv) {
@@ -141,7 +246,7 @@ func toSchema(buf *bytes.Buffer, s *skv) {
 		buf.WriteString(s.attr)
 	}
 	buf.WriteByte(':')
-	isList := schema.State().IsList(s.attr)
+	isList := s.schema.List
 	if isList {
 		buf.WriteRune('[')
 	}
@@ -159,10 +264,23 @@ func toSchema(buf *bytes.Buffer, s *skv) {
 	if s.schema.Count {
 		buf.WriteString(" @count")
 	}
+	buf.WriteString(constraintDirectives(s.schema.Constraint))
+	if s.schema.Ttl > 0 {
+		fmt.Fprintf(buf, " @ttl(%s)", ttlString(s.schema.Ttl))
+	}
 	buf.WriteString(" . \n")
 }
 
-func writeToFile(fpath string, ch chan []byte) error {
+// SchemaString returns the schema update in the schema language, as taken by Alter.
+func SchemaString(update *intern.SchemaUpdate) string {
+	var buf bytes.Buffer
+	toSchema(&buf, &skv{attr: update.Predicate, schema: update})
+	return buf.String()
+}
+
+// writeToFile writes the chunks into a gzipped file. With isJSON set, they're objects, each
+// preceded by a comma, that are written as a JSON list.
+func writeToFile(fpath string, ch chan []byte, isJSON bool) error {
 	f, err := os.Create(fpath)
 	if err != nil {
 		return err
@@ -171,58 +289,112 @@ func writeToFile(fpath string, ch chan []byte) error {
 	defer f.Close()
 	x.Check(err)
 	w := bufio.NewWriterSize(f, 1000000)
-	gw, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	var out io.Writer = w
+	var ew io.WriteCloser
+	if Config.EncryptExports {
+		if ew, err = x.ValueEncryption().NewWriter(w); err != nil {
+			return err
+		}
+		out = ew
+	}
+	gw, err := gzip.NewWriterLevel(out, gzip.BestCompression)
 	if err != nil {
 		return err
 	}
 
+	first := true
+	if isJSON {
+		if _, err := gw.Write([]byte("[")); err != nil {
+			return err
+		}
+	}
 	for buf := range ch {
+		if isJSON && first && len(buf) > 0 {
+			buf, first = buf[1:], false
+		}
 		if _, err := gw.Write(buf); err != nil {
 			return err
 		}
 	}
+	if isJSON {
+		if _, err := gw.Write([]byte("\n]\n")); err != nil {
+			return err
+		}
+	}
 	if err := gw.Flush(); err != nil {
 		return err
 	}
 	if err := gw.Close(); err != nil {
 		return err
 	}
+	if ew != nil {
+		if err := ew.Close(); err != nil {
+			return err
+		}
+	}
 	return w.Flush()
 }
 
-// Export creates a export of data by exporting it as an RDF gzip.
-func export(bdir string, readTs uint64) error {
+// export writes the data and schema of the tablets served by this group, as of in.ReadTs, into
+// bdir. The names of the files written and the number of triples, or objects, exported for each
+// predicate are set in in.
+func export(bdir string, in *intern.ExportPayload) error {
+	readTs := in.ReadTs
+	format := in.Format
+	if format == "" {
+		format = ExportFormatRDF
+	}
+	var wantPred map[string]bool
+	if len(in.Predicates) > 0 {
+		wantPred = make(map[string]bool, len(in.Predicates))
+		for _, pred := range in.Predicates {
+			wantPred[pred] = true
+		}
+	}
+
 	// Use a goroutine to write to file.
 	err := os.MkdirAll(bdir, 0700)
 	if err != nil {
 		return err
 	}
 	gid := groups().groupId()
-	fpath := path.Join(bdir, fmt.Sprintf("dgraph-%d-%s.rdf.gz", gid,
-		time.Now().Format("2006-01-02-15-04")))
-	fspath := path.Join(bdir, fmt.Sprintf("dgraph-%d-%s.schema.gz", gid,
-		time.Now().Format("2006-01-02-15-04")))
+	fname := fmt.Sprintf("dgraph-%d.%s.gz", gid, format)
+	fsname := fmt.Sprintf("dgraph-%d.schema.gz", gid)
+	if Config.EncryptExports {
+		fname += x.EncryptedFileSuffix
+		fsname += x.EncryptedFileSuffix
+	}
+	in.Files = []string{fname, fsname}
+	fpath := path.Join(bdir, fname)
+	fspath := path.Join(bdir, fsname)
 	x.Printf("Exporting to: %v, schema at %v\n", fpath, fspath)
 	chb := make(chan []byte, 1000)
 	errChan := make(chan error, 2)
 	go func() {
-		errChan <- writeToFile(fpath, chb)
+		errChan <- writeToFile(fpath, chb, format == ExportFormatJSON)
 	}()
 	chsb := make(chan []byte, 1000)
 	go func() {
-		errChan <- writeToFile(fspath, chsb)
+		errChan <- writeToFile(fspath, chsb, false)
 	}()
 
-	// Use a bunch of goroutines to convert to RDF format.
+	convert := toRDF
+	if format == ExportFormatJSON {
+		convert = toJSON
+	}
+	// Use a bunch of goroutines to convert to RDF or JSON.
 	chkv := make(chan kv, 1000)
+	counts := make(map[string]uint64)
+	var countsMu sync.Mutex
 	var wg sync.WaitGroup
 	wg.Add(numExportRoutines)
 	for i := 0; i < numExportRoutines; i++ {
 		go func(i int) {
 			buf := new(bytes.Buffer)
 			buf.Grow(50000)
+			local := make(map[string]uint64)
 			for item := range chkv {
-				toRDF(buf, item, readTs)
+				local[item.attr] += uint64(convert(buf, item, readTs))
 				if buf.Len() >= 40000 {
 					tmp := make([]byte, buf.Len())
 					copy(tmp, buf.Bytes())
@@ -235,6 +407,11 @@ func export(bdir string, readTs uint64) error {
 				copy(tmp, buf.Bytes())
 				chb <- tmp
 			}
+			countsMu.Lock()
+			for attr, n := range local {
+				counts[attr] += n
+			}
+			countsMu.Unlock()
 			wg.Done()
 		}(i)
 	}
@@ -287,17 +464,21 @@ func export(bdir string, readTs uint64) error {
 			continue
 		}
 
-		// Skip if we don't serve the tablet.
-		if !groups().ServesTablet(pk.Attr) {
+		// Skip if we don't serve the tablet, or it wasn't asked for. The predicates of
+		// namespaces other than the default one are only exported when asked for.
+		ns, attr := x.ParseNamespaceAttr(pk.Attr)
+		if !groups().ServesTablet(pk.Attr) || (wantPred != nil && !wantPred[pk.Attr]) ||
+			(wantPred == nil && ns != 0) {
 			if pk.IsData() {
 				it.Seek(pk.SkipPredicate())
-			} else if pk.IsSchema() {
-				it.Seek(pk.SkipSchema())
+			} else {
+				// The schema keys of the other predicates follow.
+				it.Next()
 			}
 			continue
 		}
 
-		if pk.Attr == "_predicate_" || pk.Attr == "_dummy_" {
+		if attr == x.PredicateListAttr || attr == "_dummy_" {
 			// Skip the UID mappings.
 			it.Seek(pk.SkipPredicate())
 			continue
@@ -309,9 +490,12 @@ func export(bdir string, readTs uint64) error {
 			if err != nil {
 				return err
 			}
+			if val, err = x.DecryptValue(val); err != nil {
+				return err
+			}
 			x.Check(s.Unmarshal(val))
 			chs <- &skv{
-				attr:   pk.Attr,
+				attr:   attr,
 				schema: s,
 			}
 			// skip predicate
@@ -319,16 +503,20 @@ func export(bdir string, readTs uint64) error {
 			continue
 		}
 		x.AssertTrue(pk.IsData())
-		pred, uid := pk.Attr, pk.Uid
-		prefix.WriteString("<_:uid")
-		prefix.WriteString(strconv.FormatUint(uid, 16))
-		prefix.WriteString("> <")
-		prefix.WriteString(pred)
-		prefix.WriteString("> ")
+		pred, uid := attr, pk.Uid
+		if format == ExportFormatRDF {
+			prefix.WriteString("<_:uid")
+			prefix.WriteString(strconv.FormatUint(uid, 16))
+			prefix.WriteString("> <")
+			prefix.WriteString(pred)
+			prefix.WriteString("> ")
+		}
 		nkey := make([]byte, len(key))
 		copy(nkey, key)
 		chkv <- kv{
 			prefix: prefix.String(),
+			attr:   pred,
+			uid:    uid,
 			key:    nkey,
 		}
 		prefix.Reset()
@@ -342,8 +530,20 @@ func export(bdir string, readTs uint64) error {
 	close(chsb) // we have stopped output to chs (schema)
 
 	err = <-errChan
-	err = <-errChan
-	return err
+	if err2 := <-errChan; err == nil {
+		err = err2
+	}
+	if err != nil {
+		return err
+	}
+	in.Counts = in.Counts[:0]
+	for attr, n := range counts {
+		in.Counts = append(in.Counts, &intern.ExportCount{Predicate: attr, Count: n})
+	}
+	sort.Slice(in.Counts, func(i, j int) bool {
+		return in.Counts[i].Predicate < in.Counts[j].Predicate
+	})
+	return nil
 }
 
 // TODO: How do we want to handle export for group, do we pause mutations, sync all and then export ?
@@ -387,10 +587,19 @@ func handleExportForGroup(ctx context.Context, in *intern.ExportPayload) *intern
 		return in
 	}
 	n.applyAllMarks(n.ctx)
+	// Wait for the commits up to the read timestamp, which other groups may have learnt of first.
+	posting.Oracle().WaitForTs(ctx, in.ReadTs)
+	if in.Backup != "" {
+		return handleBackupForGroup(ctx, in)
+	}
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("Leader of group: %d. Running export.", in.GroupId)
 	}
-	if err := export(Config.ExportPath, in.ReadTs); err != nil {
+	dir, err := exportDir(in.Dir)
+	if err == nil {
+		err = export(dir, in)
+	}
+	if err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf(err.Error())
 		}
@@ -432,21 +641,88 @@ func (w *grpcWorker) Export(ctx context.Context, req *intern.ExportPayload) (*in
 	}
 }
 
-func ExportOverNetwork(ctx context.Context) error {
+// ExportOptions choose what an export writes, and where.
+type ExportOptions struct {
+	Format     string   // ExportFormatRDF, the default, or ExportFormatJSON.
+	Predicates []string // Only export these, when set.
+	// Directory under the export path to write to. It's named after the time and the timestamp
+	// of the export when empty.
+	Dir string
+}
+
+// ExportManifest describes an export of all the groups, taken at one timestamp.
+type ExportManifest struct {
+	Dir        string           `json:"dir"`
+	ReadTs     uint64           `json:"read_ts"`
+	Format     string           `json:"format"`
+	Predicates []string         `json:"predicates,omitempty"`
+	Groups     []*ExportedGroup `json:"groups"`
+}
+
+// ExportedGroup lists the files written by the leader of a group, and the number of triples, or
+// JSON objects, exported for each predicate.
+type ExportedGroup struct {
+	GroupId    uint32            `json:"group_id"`
+	Files      []string          `json:"files"`
+	Predicates map[string]uint64 `json:"predicates"`
+}
+
+// exportDir returns where to write an export, which has to be under the export path.
+func exportDir(dir string) (string, error) {
+	dir = filepath.Clean(dir)
+	if filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, "../") {
+		return "", x.Errorf("Export directory %s isn't under the export path", dir)
+	}
+	return filepath.Join(Config.ExportPath, dir), nil
+}
+
+// ExportOverNetwork has the leader of every group export its data, as of a timestamp from Zero,
+// into the same directory under the export path. The directory gets a manifest, once all the
+// groups are done.
+//
+// Only the predicates of the namespace set in ctx are exported, without the namespace prefix.
+func ExportOverNetwork(ctx context.Context, opts ExportOptions) (*ExportManifest, error) {
+	switch opts.Format {
+	case "":
+		opts.Format = ExportFormatRDF
+	case ExportFormatRDF, ExportFormatJSON:
+	default:
+		return nil, x.Errorf("Invalid export format: %s", opts.Format)
+	}
+	if opts.Dir != "" {
+		if _, err := exportDir(opts.Dir); err != nil {
+			return nil, err
+		}
+	}
 	// If we haven't even had a single membership update, don't run export.
 	if err := x.HealthCheck(); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Request rejected %v", err)
 		}
-		return err
+		return nil, err
 	}
 	// Get ReadTs from zero and wait for stream to catch up.
 	ts, err := Timestamps(ctx, &intern.Num{Val: 1})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	readTs := ts.StartId
 	posting.Oracle().WaitForTs(ctx, readTs)
+	preds := opts.Predicates
+	if ns := x.Namespace(ctx); ns != 0 {
+		if preds, err = exportNamespacePredicates(ctx, ns, opts.Predicates); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Dir == "" {
+		opts.Dir = fmt.Sprintf("%s-%d", time.Now().UTC().Format("2006-01-02-15-04-05"), readTs)
+	}
+	m := &ExportManifest{
+		Dir:        opts.Dir,
+		ReadTs:     readTs,
+		Format:     opts.Format,
+		Predicates: opts.Predicates,
+	}
 
 	// Let's first collect all groups.
 	gids := groups().KnownGroups()
@@ -455,9 +731,12 @@ func ExportOverNetwork(ctx context.Context) error {
 	for _, gid := range gids {
 		go func(group uint32) {
 			req := &intern.ExportPayload{
-				ReqId:   uint64(rand.Int63()),
-				GroupId: group,
-				ReadTs:  readTs,
+				ReqId:      uint64(rand.Int63()),
+				GroupId:    group,
+				ReadTs:     readTs,
+				Format:     opts.Format,
+				Predicates: preds,
+				Dir:        opts.Dir,
 			}
 			ch <- handleExportForGroupOverNetwork(ctx, req)
 		}(gid)
@@ -469,14 +748,55 @@ func ExportOverNetwork(ctx context.Context) error {
 			if tr, ok := trace.FromContext(ctx); ok {
 				tr.LazyPrintf("Export status: %v for group id: %d", bp.Status, bp.GroupId)
 			}
-			return fmt.Errorf("Export status: %v for group id: %d", bp.Status, bp.GroupId)
+			return nil, fmt.Errorf("Export status: %v for group id: %d", bp.Status, bp.GroupId)
 		}
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Export successful for group: %v", bp.GroupId)
 		}
+		g := &ExportedGroup{
+			GroupId:    bp.GroupId,
+			Files:      bp.Files,
+			Predicates: make(map[string]uint64, len(bp.Counts)),
+		}
+		for _, c := range bp.Counts {
+			g.Predicates[c.Predicate] = c.Count
+		}
+		m.Groups = append(m.Groups, g)
+	}
+	sort.Slice(m.Groups, func(i, j int) bool { return m.Groups[i].GroupId < m.Groups[j].GroupId })
+
+	dir, err := exportDir(m.Dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if err := writeJSON(filepath.Join(dir, ExportManifestFile), m); err != nil {
+		return nil, err
 	}
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("DONE export")
 	}
-	return nil
+	return m, nil
+}
+
+// exportNamespacePredicates returns the attributes of the predicates of namespace ns to export,
+// which are the ones given or else all of them.
+func exportNamespacePredicates(ctx context.Context, ns uint64, preds []string) ([]string, error) {
+	if len(preds) > 0 {
+		attrs := make([]string, 0, len(preds))
+		for _, pred := range preds {
+			attrs = append(attrs, x.NamespaceAttr(ns, pred))
+		}
+		return attrs, nil
+	}
+	attrs, _, err := NamespacePredicates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) == 0 {
+		return nil, x.Errorf("Namespace %d has no predicates to export", ns)
+	}
+	return attrs, nil
 }
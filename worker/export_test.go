@@ -20,6 +20,7 @@ package worker
 import (
 	"bufio"
 	"compress/gzip"
+	"encoding/json"
 	"io/ioutil"
 	"math"
 	"os"
@@ -110,8 +111,12 @@ func TestExport(t *testing.T) {
 	time.Sleep(1 * time.Second)
 
 	// We have 4 friend type edges. FP("friends")%10 = 2.
-	err = export(bdir, timestamp())
+	in := &intern.ExportPayload{ReadTs: timestamp()}
+	err = export(bdir, in)
 	require.NoError(t, err)
+	require.Equal(t, []string{"dgraph-1.rdf.gz", "dgraph-1.schema.gz"}, in.Files)
+	require.Equal(t, []*intern.ExportCount{{Predicate: "friend", Count: 4},
+		{Predicate: "name", Count: 4}}, in.Counts)
 
 	searchDir := bdir
 	fileList := []string{}
@@ -230,6 +235,65 @@ func TestExport(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestExportJSON(t *testing.T) {
+	initTestExport(t, "name:string @index .")
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	in := &intern.ExportPayload{
+		ReadTs:     timestamp(),
+		Format:     ExportFormatJSON,
+		Predicates: []string{"friend"},
+	}
+	require.NoError(t, export(bdir, in))
+	require.Equal(t, []*intern.ExportCount{{Predicate: "friend", Count: 4}}, in.Counts)
+
+	f, err := os.Open(filepath.Join(bdir, "dgraph-1.json.gz"))
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	var objs []map[string]interface{}
+	require.NoError(t, json.NewDecoder(r).Decode(&objs))
+	require.Len(t, objs, 4)
+	for _, obj := range objs {
+		require.Len(t, obj, 2)
+		friend := obj["friend"].(map[string]interface{})
+		require.Equal(t, "_:uid5", friend["uid"])
+		if obj["uid"] == "_:uid4" {
+			require.Equal(t, 33.0, friend["friend|age"])
+			require.Equal(t, true, friend["friend|close"])
+			require.Equal(t, "football", friend["friend|game"])
+			require.Equal(t, "2005-05-02T15:04:05Z", friend["friend|since"])
+		}
+	}
+
+	// Only the schema of friend is exported.
+	f, err = os.Open(filepath.Join(bdir, "dgraph-1.schema.gz"))
+	require.NoError(t, err)
+	defer f.Close()
+	r, err = gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "friend:uid . \n", string(data))
+}
+
+func TestExportDir(t *testing.T) {
+	Config.ExportPath = "export"
+	dir, err := exportDir("")
+	require.NoError(t, err)
+	require.Equal(t, "export", dir)
+	dir, err = exportDir("a/../b")
+	require.NoError(t, err)
+	require.Equal(t, "export/b", dir)
+	_, err = exportDir("a/../../b")
+	require.Error(t, err)
+	_, err = exportDir("/tmp")
+	require.Error(t, err)
+}
+
 // func generateBenchValues() []kv {
 // 	byteInt := make([]byte, 4)
 // 	binary.LittleEndian.PutUint32(byteInt, 123)
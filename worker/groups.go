@@ -119,6 +119,11 @@ func StartRaftNodes(walStore *badger.ManagedDB, bindall bool) {
 	gr.applyState(connState.GetState())
 
 	gr.wal = raftwal.Init(walStore, Config.RaftId)
+	if len(Config.WalArchivePath) > 0 {
+		archive, err := raftwal.OpenArchive(Config.WalArchivePath, Config.WalArchiveRetention)
+		x.Checkf(err, "Error while opening WAL archive")
+		gr.wal.SetArchive(archive)
+	}
 	gr.triggerCh = make(chan struct{}, 1)
 	gr.delPred = make(chan struct{}, 1)
 	gid := gr.groupId()
@@ -132,6 +137,9 @@ func StartRaftNodes(walStore *badger.ManagedDB, bindall bool) {
 	go gr.cleanupTablets()
 	go gr.processOracleDeltaStream()
 	go gr.periodicAbortOldTxns()
+	go gr.updateHistoryHorizon()
+	go gr.purgeExpiredPeriodically()
+	go refreshGroupTails()
 	gr.proposeInitialSchema()
 }
 
@@ -174,9 +182,13 @@ func (g *groupi) groupId() uint32 {
 	return atomic.LoadUint32(&g.gid)
 }
 
+// calculateTabletSizes returns the tablets served by this group, with the space they take on
+// disk.
 func (g *groupi) calculateTabletSizes() map[string]*intern.Tablet {
 	opt := badger.DefaultIteratorOptions
 	opt.PrefetchValues = false
+	// Older versions take space too, until they're purged.
+	opt.AllVersions = true
 	txn := pstore.NewTransactionAt(math.MaxUint64, false)
 	defer txn.Discard()
 	itr := txn.NewIterator(opt)
@@ -184,6 +196,7 @@ func (g *groupi) calculateTabletSizes() map[string]*intern.Tablet {
 
 	gid := g.groupId()
 	tablets := make(map[string]*intern.Tablet)
+	var total int64
 
 	for itr.Rewind(); itr.Valid(); {
 		item := itr.Item()
@@ -208,8 +221,20 @@ func (g *groupi) calculateTabletSizes() map[string]*intern.Tablet {
 			tablets[pk.Attr] = tablet
 		}
 		tablet.Space += item.EstimatedSize()
+		total += item.EstimatedSize()
 		itr.Next()
 	}
+
+	// The estimates leave out the overhead of the files, like the index blocks of the tables
+	// and the values in the value log waiting to be garbage collected. The size of the files
+	// is spread over the tablets by their estimates.
+	lsm, vlog := pstore.Size()
+	if disk := lsm + vlog; disk > 0 && total > 0 {
+		scale := float64(disk) / float64(total)
+		for _, tablet := range tablets {
+			tablet.Space = int64(float64(tablet.Space) * scale)
+		}
+	}
 	return tablets
 }
 
@@ -279,14 +304,76 @@ func (g *groupi) BelongsTo(key string) uint32 {
 	return 0
 }
 
+// BelongsToUid returns the group serving the uid for the predicate, which is the group serving
+// the predicate unless it's split.
+func (g *groupi) BelongsToUid(key string, uid uint64) uint32 {
+	tablet := g.Tablet(key)
+	if tablet == nil {
+		return 0
+	}
+	return tabletGroup(tablet, uid)
+}
+
+// TabletGroups returns the groups serving the predicate, which are more than one if it's split.
+func (g *groupi) TabletGroups(key string) []uint32 {
+	tablet := g.Tablet(key)
+	if tablet == nil {
+		return []uint32{0}
+	}
+	return tabletGroups(tablet)
+}
+
+// ServesTablet returns whether this group serves the predicate, or a range of uids of it.
 func (g *groupi) ServesTablet(key string) bool {
 	tablet := g.Tablet(key)
-	if tablet != nil && tablet.GroupId == groups().groupId() {
+	if tablet != nil && servedBy(tablet, groups().groupId()) {
 		return true
 	}
 	return false
 }
 
+// ServesUid returns whether this group serves the uid for the predicate.
+func (g *groupi) ServesUid(key string, uid uint64) bool {
+	return g.BelongsToUid(key, uid) == g.groupId()
+}
+
+// tabletGroup returns the group serving uid for the tablet. The splits are sorted by start_uid.
+func tabletGroup(tablet *intern.Tablet, uid uint64) uint32 {
+	gid := tablet.GroupId
+	for _, split := range tablet.Splits {
+		if uid < split.StartUid {
+			break
+		}
+		gid = split.GroupId
+	}
+	return gid
+}
+
+// tabletGroups returns the groups serving the tablet, starting with the one it belongs to.
+func tabletGroups(tablet *intern.Tablet) []uint32 {
+	gids := []uint32{tablet.GroupId}
+	for _, split := range tablet.Splits {
+		if !containsGroup(gids, split.GroupId) {
+			gids = append(gids, split.GroupId)
+		}
+	}
+	return gids
+}
+
+// servedBy returns whether the group serves the tablet, or a range of uids of it.
+func servedBy(tablet *intern.Tablet, gid uint32) bool {
+	return containsGroup(tabletGroups(tablet), gid)
+}
+
+func containsGroup(gids []uint32, gid uint32) bool {
+	for _, g := range gids {
+		if g == gid {
+			return true
+		}
+	}
+	return false
+}
+
 // Do not modify the returned Tablet
 func (g *groupi) Tablet(key string) *intern.Tablet {
 	// TODO: Remove all this later, create a membership state and apply it
@@ -486,10 +573,15 @@ OUTER:
 			if g.Node.AmLeader() {
 				prevTablets := tablets
 				tablets = g.calculateTabletSizes()
+				tabletLoad.setRates(tablets, len(g.members(g.groupId())), time.Now())
 				if prevTablets != nil {
 					allTablets = make(map[string]*intern.Tablet)
 					g.RLock()
-					for attr := range g.tablets {
+					for attr, tab := range g.tablets {
+						// Groups serving part of a split predicate may have no data for it.
+						if len(tab.Splits) > 0 {
+							continue
+						}
 						if tablets[attr] == nil && prevTablets[attr] == nil {
 							allTablets[attr] = &intern.Tablet{
 								GroupId:   g.gid,
@@ -574,7 +666,7 @@ func (g *groupi) cleanupTablets() {
 				// request made to group zero fails. We might end up deleting a predicate
 				// on failure of network request even though no one else is serving this
 				// tablet.
-				if tablet := g.Tablet(pk.Attr); tablet != nil && tablet.GroupId != g.groupId() {
+				if tablet := g.Tablet(pk.Attr); tablet != nil && !servedBy(tablet, g.groupId()) {
 					if g.hasReadOnlyTablets() {
 						return
 					}
@@ -694,3 +786,27 @@ func (g *groupi) periodicAbortOldTxns() {
 		zc.TryAbort(context.Background(), req)
 	}
 }
+
+// updateHistoryHorizon periodically asks Zero which timestamp was handed out one retention
+// period ago. Posting lists keep the versions needed to read at that timestamp.
+func (g *groupi) updateHistoryHorizon() {
+	retention := posting.Config.HistoryRetention
+	if retention <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		ts, err := TimestampAt(g.ctx, time.Now().Add(-retention))
+		if err != nil {
+			x.Printf("Error while fetching history retention horizon from Zero: %v\n", err)
+		} else if ts > 0 {
+			posting.SetHistoryHorizon(ts)
+		}
+		select {
+		case <-ticker.C:
+		case <-g.ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,323 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// CommitWatcher gets a signal on C whenever a commit touches any of the predicates it
+// watches. Signals coalesce, so one receive can stand for many commits.
+type CommitWatcher struct {
+	C  <-chan struct{}
+	ch chan struct{}
+
+	sync.Mutex
+	preds map[string]struct{} // Nil matches every predicate.
+
+	// Guarded by groupTails.
+	gids   map[uint32]struct{} // The other groups followed for the predicates.
+	closed bool
+}
+
+const (
+	// The latest commits a group tail keeps, for the watchers which start following it.
+	maxTailedCommits = 1000
+	// How long a group tail waits before starting over, once its stream broke.
+	tailRetryInterval = time.Second
+)
+
+var commitWatchers = struct {
+	sync.Mutex
+	m map[*CommitWatcher]struct{}
+}{m: make(map[*CommitWatcher]struct{})}
+
+// groupTail follows the change stream of another group, for the watchers of the predicates it
+// serves. It keeps the latest commits around, for the watchers which start following it
+// after their read_ts.
+type groupTail struct {
+	gid      uint32
+	watchers map[*CommitWatcher]struct{}
+	cancel   func()
+	recent   []*api.ChangeEvent // Oldest first.
+	// Every commit of the group after it is in recent, or still to come.
+	sinceTs uint64
+}
+
+var groupTails = struct {
+	sync.Mutex
+	m map[uint32]*groupTail
+}{m: make(map[uint32]*groupTail)}
+
+// WatchCommits returns a watcher which fires on every commit applied by this group, until
+// SetPredicates narrows it down. Close it when done.
+func WatchCommits() *CommitWatcher {
+	ch := make(chan struct{}, 1)
+	w := &CommitWatcher{C: ch, ch: ch}
+	commitWatchers.Lock()
+	commitWatchers.m[w] = struct{}{}
+	commitWatchers.Unlock()
+	return w
+}
+
+// SetPredicates narrows the watcher down to commits touching the given predicates, which were
+// read at readTs. A change to a predicate also covers its index, reverse and count keys. The
+// commits to the predicates served by other groups come from their change streams. The
+// predicates are in the namespace set in ctx.
+func (w *CommitWatcher) SetPredicates(ctx context.Context, preds []string, readTs uint64) {
+	m := make(map[string]struct{})
+	ns := x.Namespace(ctx)
+	for _, pred := range preds {
+		m[x.NamespaceAttr(ns, strings.TrimPrefix(pred, "~"))] = struct{}{}
+	}
+	w.Lock()
+	w.preds = m
+	w.Unlock()
+	followGroups(w, remoteGroups(m), readTs)
+}
+
+func (w *CommitWatcher) Close() {
+	commitWatchers.Lock()
+	delete(commitWatchers.m, w)
+	commitWatchers.Unlock()
+
+	groupTails.Lock()
+	defer groupTails.Unlock()
+	for gid := range w.gids {
+		unfollowGroup(w, gid)
+	}
+	w.gids, w.closed = nil, true
+}
+
+func (w *CommitWatcher) signal() {
+	select {
+	case w.ch <- struct{}{}:
+	default:
+	}
+}
+
+func (w *CommitWatcher) matches(ev *api.ChangeEvent) bool {
+	w.Lock()
+	defer w.Unlock()
+	if w.preds == nil {
+		return true
+	}
+	for _, nqs := range [][]*api.NQuad{ev.Set, ev.Del} {
+		for _, nq := range nqs {
+			if _, ok := w.preds[nq.Predicate]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// remoteGroups returns the other groups serving the predicates, including the ranges of split
+// ones. Predicates we haven't seen could end up in any group. It doesn't ask Zero about them,
+// so that watching them doesn't claim a tablet.
+func remoteGroups(preds map[string]struct{}) map[uint32]struct{} {
+	g := groups()
+	gids := make(map[uint32]struct{})
+	var unknown bool
+	g.RLock()
+	for pred := range preds {
+		tablet, ok := g.tablets[pred]
+		if !ok {
+			unknown = true
+			continue
+		}
+		gids[tablet.GroupId] = struct{}{}
+		for _, split := range tablet.Splits {
+			gids[split.GroupId] = struct{}{}
+		}
+	}
+	g.RUnlock()
+	if unknown {
+		for _, gid := range g.KnownGroups() {
+			gids[gid] = struct{}{}
+		}
+	}
+	delete(gids, g.groupId())
+	return gids
+}
+
+// followGroups makes the watcher follow the changes of the given groups, and only those. The
+// groups it starts following send it the commits it missed after readTs.
+func followGroups(w *CommitWatcher, gids map[uint32]struct{}, readTs uint64) {
+	groupTails.Lock()
+	defer groupTails.Unlock()
+	if w.closed {
+		return
+	}
+	for gid := range w.gids {
+		if _, ok := gids[gid]; !ok {
+			unfollowGroup(w, gid)
+		}
+	}
+	for gid := range gids {
+		if _, ok := w.gids[gid]; ok {
+			continue
+		}
+		t, ok := groupTails.m[gid]
+		if !ok {
+			t = startGroupTail(gid, readTs)
+			groupTails.m[gid] = t
+		}
+		t.join(w, readTs)
+	}
+	w.gids = gids
+}
+
+func unfollowGroup(w *CommitWatcher, gid uint32) {
+	t, ok := groupTails.m[gid]
+	if !ok {
+		return
+	}
+	delete(t.watchers, w)
+	if len(t.watchers) == 0 {
+		t.cancel()
+		delete(groupTails.m, gid)
+	}
+}
+
+// startGroupTail starts streaming the changes of group gid committed after sinceTs.
+func startGroupTail(gid uint32, sinceTs uint64) *groupTail {
+	if sinceTs == 0 {
+		sinceTs = posting.Oracle().MaxPending()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &groupTail{
+		gid:      gid,
+		watchers: make(map[*CommitWatcher]struct{}),
+		cancel:   cancel,
+		sinceTs:  sinceTs,
+	}
+	go t.run(ctx, sinceTs)
+	return t
+}
+
+func (t *groupTail) run(ctx context.Context, sinceTs uint64) {
+	for {
+		err := tailRemoteGroup(ctx, t.gid, sinceTs, t.add)
+		if ctx.Err() != nil {
+			return
+		}
+		x.Printf("Error while following the changes of group %d for live queries: %v\n",
+			t.gid, err)
+		select {
+		case <-time.After(tailRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+		sinceTs = t.restart()
+	}
+}
+
+// restart starts the tail over from the latest commit, once its stream broke. The watchers
+// could have missed commits in between, so they all get a signal.
+func (t *groupTail) restart() uint64 {
+	groupTails.Lock()
+	defer groupTails.Unlock()
+	for w := range t.watchers {
+		w.signal()
+	}
+	t.recent = nil
+	t.sinceTs = posting.Oracle().MaxPending()
+	return t.sinceTs
+}
+
+// join adds the watcher to the tail, and signals it if the group committed to its predicates
+// after readTs, or if the tail can't tell. The caller holds groupTails.
+func (t *groupTail) join(w *CommitWatcher, readTs uint64) {
+	t.watchers[w] = struct{}{}
+	if readTs == 0 || readTs < t.sinceTs {
+		w.signal()
+		return
+	}
+	for _, ev := range t.recent {
+		if ev.CommitTs > readTs && w.matches(ev) {
+			w.signal()
+			return
+		}
+	}
+}
+
+// add signals the watchers of the predicates the commit touched.
+func (t *groupTail) add(ev *api.ChangeEvent) error {
+	if len(ev.Set) == 0 && len(ev.Del) == 0 {
+		// A progress mark.
+		return nil
+	}
+	groupTails.Lock()
+	defer groupTails.Unlock()
+	t.recent = append(t.recent, ev)
+	if len(t.recent) > maxTailedCommits {
+		// Drop the older half in one go, instead of shifting on every commit.
+		n := len(t.recent) / 2
+		t.sinceTs = t.recent[n-1].CommitTs
+		t.recent = append([]*api.ChangeEvent{}, t.recent[n:]...)
+	}
+	for w := range t.watchers {
+		if w.matches(ev) {
+			w.signal()
+		}
+	}
+	return nil
+}
+
+// refreshGroupTails follows the predicates of the watchers as they move between groups. A
+// watcher which starts following a group gets a signal, as it could have missed commits.
+func refreshGroupTails() {
+	ticker := time.NewTicker(groupsCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		commitWatchers.Lock()
+		ws := make([]*CommitWatcher, 0, len(commitWatchers.m))
+		for w := range commitWatchers.m {
+			ws = append(ws, w)
+		}
+		commitWatchers.Unlock()
+		for _, w := range ws {
+			w.Lock()
+			preds := w.preds
+			w.Unlock()
+			if preds != nil {
+				followGroups(w, remoteGroups(preds), 0)
+			}
+		}
+	}
+}
+
+// notifyLocalCommit is called with the changes of every commit applied by this group.
+func notifyLocalCommit(ev *api.ChangeEvent) {
+	commitWatchers.Lock()
+	defer commitWatchers.Unlock()
+	for w := range commitWatchers.m {
+		if w.matches(ev) {
+			w.signal()
+		}
+	}
+}
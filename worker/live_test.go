@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testWatcher(preds ...string) *CommitWatcher {
+	ch := make(chan struct{}, 1)
+	w := &CommitWatcher{C: ch, ch: ch, preds: make(map[string]struct{})}
+	for _, pred := range preds {
+		w.preds[pred] = struct{}{}
+	}
+	return w
+}
+
+func signalled(w *CommitWatcher) bool {
+	select {
+	case <-w.C:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestGroupTail(t *testing.T) {
+	tail := &groupTail{gid: 2, watchers: make(map[*CommitWatcher]struct{}), sinceTs: 10}
+	name, age := testWatcher("name"), testWatcher("age")
+	tail.join(name, 10)
+	tail.join(age, 10)
+	require.False(t, signalled(name))
+	require.False(t, signalled(age))
+
+	// Only the watchers of the predicates committed to get a signal.
+	require.NoError(t, tail.add(changeEvent(11, "name")))
+	require.True(t, signalled(name))
+	require.False(t, signalled(age))
+	require.NoError(t, tail.add(changeEvent(12)))
+	require.False(t, signalled(name))
+
+	// A watcher joining late catches up on the commits after its read_ts.
+	late := testWatcher("name")
+	tail.join(late, 10)
+	require.True(t, signalled(late))
+	late = testWatcher("name")
+	tail.join(late, 11)
+	require.False(t, signalled(late))
+	late = testWatcher("age")
+	tail.join(late, 10)
+	require.False(t, signalled(late))
+	// Commits before the tail started are unknown.
+	late = testWatcher("age")
+	tail.join(late, 9)
+	require.True(t, signalled(late))
+
+	for ts := uint64(13); ts < 13+maxTailedCommits; ts++ {
+		require.NoError(t, tail.add(changeEvent(ts, "age")))
+	}
+	require.True(t, len(tail.recent) <= maxTailedCommits)
+	require.Equal(t, tail.recent[0].CommitTs-1, tail.sinceTs)
+	late = testWatcher("name")
+	tail.join(late, 11)
+	require.True(t, signalled(late))
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dgryski/go-farm"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Number of values that failed conversion listed in the error rejecting a type change, or in
+// the warning that they were dropped.
+const maxReportedValues = 10
+
+type schemaWarningsKey struct{}
+
+// schemaWarnings collects the warnings of the schema updates of a proposal, on the server
+// which proposed it.
+type schemaWarnings struct {
+	sync.Mutex
+	list []string
+}
+
+// withSchemaWarnings returns a context collecting the warnings of the schema updates proposed
+// with it.
+func withSchemaWarnings(ctx context.Context) (context.Context, *schemaWarnings) {
+	sw := &schemaWarnings{}
+	return context.WithValue(ctx, schemaWarningsKey{}, sw), sw
+}
+
+// addSchemaWarning hands the warning to the proposer of the schema update, if it was proposed
+// by this server.
+func addSchemaWarning(ctx context.Context, warning string) {
+	if sw, ok := ctx.Value(schemaWarningsKey{}).(*schemaWarnings); ok {
+		sw.Lock()
+		sw.list = append(sw.list, warning)
+		sw.Unlock()
+	}
+}
+
+func (sw *schemaWarnings) get() []string {
+	sw.Lock()
+	defer sw.Unlock()
+	return sw.list
+}
+
+// needsMigration returns true if changing the schema of a predicate from old to current
+// changes the scalar type its values are stored as.
+func needsMigration(old, current intern.SchemaUpdate) bool {
+	from, to := types.TypeID(old.ValueType), types.TypeID(current.ValueType)
+	return from != to && from.IsScalar() && to.IsScalar()
+}
+
+// convertPosting converts the value of the posting to typ.
+func convertPosting(p *intern.Posting, typ types.TypeID) (types.Val, error) {
+	src := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+	return types.Convert(src, typ)
+}
+
+// postingString returns the value of the posting as text, for error messages and logs.
+func postingString(p *intern.Posting) string {
+	src := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+	if v, err := types.Convert(src, types.StringID); err == nil {
+		return fmt.Sprintf("%q", v.Value)
+	}
+	return fmt.Sprintf("%q", p.Value)
+}
+
+// checkConversion returns an error listing the values of attr as of readTs that can't be
+// converted to typ, if there are any.
+func checkConversion(attr string, typ types.TypeID, readTs uint64) error {
+	var failed []string
+	var count int
+	if err := posting.IterateValues(attr, readTs, func(uid uint64, p *intern.Posting) error {
+		if _, err := convertPosting(p, typ); err != nil {
+			count++
+			if len(failed) < maxReportedValues {
+				failed = append(failed, fmt.Sprintf("%#x: %s", uid, postingString(p)))
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+	return x.Errorf("Cannot convert %d values of predicate %s to %s, including [%s]."+
+		" Use drop_invalid to drop them instead.", count, attr, typ.Name(),
+		strings.Join(failed, ", "))
+}
+
+// droppedWarning returns the warning that count values of attr were dropped, which failed
+// conversion to typ, listing some of them.
+func droppedWarning(attr string, typ types.TypeID, count int, failed []string) string {
+	return fmt.Sprintf("Dropped %d values of predicate %s that can't be converted to %s,"+
+		" including [%s].", count, attr, typ.Name(), strings.Join(failed, ", "))
+}
+
+// migrateValues converts the values of attr to the type of the new schema, dropping the ones
+// that can't be converted. It returns the number of values dropped, and some of them.
+func migrateValues(attr string, current intern.SchemaUpdate, commitTs uint64) (int, []string,
+	error) {
+	typ := types.TypeID(current.ValueType)
+	var dropped int
+	var failed []string
+	err := posting.RewriteValues(attr, commitTs,
+		func(uid uint64, postings []*intern.Posting) ([]*intern.Posting, error) {
+			out := postings[:0]
+			seen := make(map[uint64]bool)
+			for _, p := range postings {
+				dst, err := convertPosting(p, typ)
+				if err != nil {
+					x.Printf("Dropping value %s of uid %#x for predicate %s: %v\n",
+						postingString(p), uid, attr, err)
+					dropped++
+					if len(failed) < maxReportedValues {
+						failed = append(failed, fmt.Sprintf("%#x: %s", uid, postingString(p)))
+					}
+					continue
+				}
+				b := types.ValueForType(types.BinaryID)
+				if err := types.Marshal(dst, &b); err != nil {
+					return nil, err
+				}
+				p.Value = b.Value.([]byte)
+				p.ValType = typ.Enum()
+				if current.List && len(p.LangTag) == 0 {
+					// List values are keyed by their fingerprint, which changed with the value.
+					p.Uid = farm.Fingerprint64(p.Value)
+				}
+				if seen[p.Uid] {
+					// Another value of the list converted to the same one.
+					continue
+				}
+				seen[p.Uid] = true
+				out = append(out, p)
+			}
+			return out, nil
+		})
+	return dropped, failed, err
+}
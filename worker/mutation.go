@@ -22,10 +22,13 @@ import (
 	"errors"
 	"math"
 	"math/rand"
+	"reflect"
 	"time"
 
 	"golang.org/x/net/context"
 	"golang.org/x/net/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/dgraph/conn"
@@ -53,14 +56,15 @@ func runMutation(ctx context.Context, edge *intern.DirectedEdge, txn *posting.Tx
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("In run mutations")
 	}
-	if !groups().ServesTablet(edge.Attr) {
+	if !groups().ServesUid(edge.Attr, edge.Entity) {
 		// Don't assert, can happen during replay of raft logs if server crashes immediately
 		// after predicate move and before snapshot.
 		return errUnservedTablet
 	}
+	tabletLoad.write(edge.Attr)
 
-	typ, err := schema.State().TypeOf(edge.Attr)
-	x.Checkf(err, "Schema is not present for predicate %s", edge.Attr)
+	su, ok := schema.State().Get(edge.Attr)
+	x.AssertTruef(ok, "Schema is not present for predicate %s", edge.Attr)
 
 	if deletePredicateEdge(edge) {
 		return errors.New("We should never reach here")
@@ -68,7 +72,10 @@ func runMutation(ctx context.Context, edge *intern.DirectedEdge, txn *posting.Tx
 	// Once mutation comes via raft we do best effort conversion
 	// Type check is done before proposing mutation, in case schema is not
 	// present, some invalid entries might be written initially
-	err = ValidateAndConvert(edge, typ)
+	err := ValidateAndConvert(edge, &su)
+	if edge.ExpiresAt != 0 && edge.Op == intern.DirectedEdge_SET {
+		ttlPreds.note(edge.Attr, txn.StartTs)
+	}
 
 	key := x.DataKey(edge.Attr, edge.Entity)
 
@@ -87,9 +94,12 @@ func runMutation(ctx context.Context, edge *intern.DirectedEdge, txn *posting.Tx
 }
 
 // This is serialized with mutations, called after applied watermarks catch up
-// and further mutations are blocked until this is done.
-func runSchemaMutation(ctx context.Context, update *intern.SchemaUpdate, startTs uint64) error {
-	if err := runSchemaMutationHelper(ctx, update, startTs); err != nil {
+// and further mutations are blocked until this is done. If the update changes the
+// type of the predicate, its values are converted and the ones that can't be are
+// dropped if dropInvalid is set, or else the update is rejected.
+func runSchemaMutation(ctx context.Context, update *intern.SchemaUpdate, startTs, commitTs uint64,
+	dropInvalid bool) error {
+	if err := runSchemaMutationHelper(ctx, update, startTs, commitTs, dropInvalid); err != nil {
 		return err
 	}
 
@@ -107,7 +117,8 @@ func runSchemaMutation(ctx context.Context, update *intern.SchemaUpdate, startTs
 	return nil
 }
 
-func runSchemaMutationHelper(ctx context.Context, update *intern.SchemaUpdate, startTs uint64) error {
+func runSchemaMutationHelper(ctx context.Context, update *intern.SchemaUpdate,
+	startTs, commitTs uint64, dropInvalid bool) error {
 	n := groups().Node
 	if !groups().ServesTablet(update.Predicate) {
 		return errUnservedTablet
@@ -117,6 +128,41 @@ func runSchemaMutationHelper(ctx context.Context, update *intern.SchemaUpdate, s
 	}
 	old, ok := schema.State().Get(update.Predicate)
 	current := *update
+	migrate := ok && needsMigration(old, current)
+	if migrate && commitTs <= startTs {
+		return x.Errorf("Converting the values of predicate %s needs a commit timestamp",
+			update.Predicate)
+	}
+	if migrate && !dropInvalid {
+		if err := checkConversion(update.Predicate, types.TypeID(current.ValueType),
+			startTs); err != nil {
+			return err
+		}
+	}
+	if current.Constraint != nil && !reflect.DeepEqual(old.Constraint, current.Constraint) {
+		if err := validateExistingValues(update.Predicate, types.TypeID(current.ValueType),
+			current.Constraint, startTs, migrate); err != nil {
+			return err
+		}
+	}
+	var dropped int
+	if migrate {
+		// The converted values are written at commitTs, and the new schema is set right after
+		// within this proposal. Reads at earlier timestamps keep the old values, converted to
+		// the schema type on read.
+		var failed []string
+		var err error
+		if dropped, failed, err = migrateValues(update.Predicate, current, commitTs); err != nil {
+			return err
+		}
+		x.Printf("Converted predicate %s from %s to %s, dropped %d values\n", update.Predicate,
+			types.TypeID(old.ValueType).Name(), types.TypeID(current.ValueType).Name(), dropped)
+		if dropped > 0 {
+			_, pred := x.ParseNamespaceAttr(update.Predicate)
+			addSchemaWarning(ctx, droppedWarning(pred, types.TypeID(current.ValueType), dropped,
+				failed))
+		}
+	}
 	// Sets only in memory, we will update it on disk only after schema mutations is successful and persisted
 	// to disk.
 	schema.State().Set(update.Predicate, current)
@@ -166,7 +212,7 @@ func runSchemaMutationHelper(ctx context.Context, update *intern.SchemaUpdate, s
 		}
 	}
 
-	if current.Count != old.Count {
+	if current.Count != old.Count || (current.Count && dropped > 0) {
 		if err := n.rebuildOrDelCountIndex(ctx, update.Predicate, current.Count, startTs); err != nil {
 		}
 	}
@@ -208,7 +254,7 @@ func updateSchema(attr string, s intern.SchemaUpdate, index uint64) error {
 	defer txn.Discard()
 	data, err := s.Marshal()
 	x.Check(err)
-	if err := txn.Set(x.SchemaKey(attr), data); err != nil {
+	if err := txn.Set(x.SchemaKey(attr), x.EncryptValue(data)); err != nil {
 		return err
 	}
 	return txn.CommitAt(1, nil)
@@ -222,6 +268,10 @@ func updateSchemaType(attr string, typ types.TypeID, index uint64) {
 		s.ValueType = typ.Enum()
 	} else {
 		s = intern.SchemaUpdate{ValueType: typ.Enum()}
+		// Namespaces get their own list of predicates, set on their first mutation.
+		if _, pred := x.ParseNamespaceAttr(attr); pred == x.PredicateListAttr {
+			s.List = true
+		}
 	}
 	updateSchema(attr, s, index)
 }
@@ -287,8 +337,9 @@ func checkSchema(s *intern.SchemaUpdate) error {
 }
 
 // If storage type is specified, then check compatibility or convert to schema type
-// if no storage type is specified then convert to schema type.
-func ValidateAndConvert(edge *intern.DirectedEdge, schemaType types.TypeID) error {
+// if no storage type is specified then convert to schema type. Values being set must
+// also satisfy the constraint of the schema, if any.
+func ValidateAndConvert(edge *intern.DirectedEdge, su *intern.SchemaUpdate) error {
 	if deletePredicateEdge(edge) {
 		return nil
 	}
@@ -298,12 +349,19 @@ func ValidateAndConvert(edge *intern.DirectedEdge, schemaType types.TypeID) erro
 	// <s> <p> <o> Del on non list scalar type.
 	if edge.ValueId == 0 && !bytes.Equal(edge.Value, []byte(x.Star)) &&
 		edge.Op == intern.DirectedEdge_DEL {
-		if !schema.State().IsList(edge.Attr) {
+		if !su.List {
 			return x.Errorf("Please use * with delete operation for non-list type")
 		}
 	}
 
+	schemaType := types.TypeID(su.ValueType)
 	storageType := posting.TypeID(edge)
+	isInc := edge.Op == intern.DirectedEdge_INC
+	if isInc {
+		if err := validateIncrement(edge, su); err != nil {
+			return err
+		}
+	}
 	if !schemaType.IsScalar() && !storageType.IsScalar() {
 		return nil
 	} else if !schemaType.IsScalar() && storageType.IsScalar() {
@@ -314,7 +372,8 @@ func ValidateAndConvert(edge *intern.DirectedEdge, schemaType types.TypeID) erro
 		// Both are scalars. Continue.
 	}
 
-	if storageType == schemaType {
+	checkValue := su.Constraint != nil && edge.Op == intern.DirectedEdge_SET
+	if storageType == schemaType && !checkValue {
 		return nil
 	}
 
@@ -327,9 +386,16 @@ func ValidateAndConvert(edge *intern.DirectedEdge, schemaType types.TypeID) erro
 	if dst, err = types.Convert(src, schemaType); err != nil {
 		return err
 	}
+	if checkValue {
+		if err = checkConstraint(edge.Attr, su.Constraint, dst); err != nil {
+			// The code tells the mutation apart from one which may succeed when retried.
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 
-	// if storage type was specified skip
-	if storageType != types.DefaultID {
+	// if storage type was specified skip, unless it's an increment which is added up in the
+	// schema type.
+	if (storageType != types.DefaultID || storageType == schemaType) && !isInc {
 		return nil
 	}
 
@@ -343,6 +409,27 @@ func ValidateAndConvert(edge *intern.DirectedEdge, schemaType types.TypeID) erro
 	return nil
 }
 
+// validateIncrement checks that the edge increments a single number without a language.
+func validateIncrement(edge *intern.DirectedEdge, su *intern.SchemaUpdate) error {
+	typ := types.TypeID(su.ValueType)
+	switch {
+	case typ != types.IntID && typ != types.FloatID:
+		return x.Errorf("Increments need a predicate of type int or float, %s is of type %s",
+			edge.Attr, typ.Name())
+	case edge.ValueId != 0:
+		return x.Errorf("Increment of predicate %s needs a value", edge.Attr)
+	case su.List:
+		return x.Errorf("Increments aren't supported on list predicate %s", edge.Attr)
+	case len(edge.Lang) > 0:
+		return x.Errorf("Increment of predicate %s can't have a language", edge.Attr)
+	case su.Constraint != nil:
+		// The final value is only known at commit, too late to reject the mutation.
+		return x.Errorf("Increments aren't supported on predicate %s with value constraints",
+			edge.Attr)
+	}
+	return nil
+}
+
 func AssignUidsOverNetwork(ctx context.Context, num *intern.Num) (*api.AssignedIds, error) {
 	pl := groups().Leader(0)
 	if pl == nil {
@@ -365,15 +452,33 @@ func Timestamps(ctx context.Context, num *intern.Num) (*api.AssignedIds, error)
 	return c.Timestamps(ctx, num)
 }
 
+// TimestampAt returns the latest timestamp Zero handed out at or before t, or zero if Zero's
+// history doesn't go back that far.
+func TimestampAt(ctx context.Context, t time.Time) (uint64, error) {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return 0, conn.ErrNoConnection
+	}
+
+	conn := pl.Get()
+	c := intern.NewZeroClient(conn)
+	num, err := c.TimestampAt(ctx, &intern.Num{Val: uint64(t.Unix())})
+	if err != nil {
+		return 0, err
+	}
+	return num.Val, nil
+}
+
 // proposeOrSend either proposes the mutation if the node serves the group gid or sends it to
 // the leader of the group gid for proposing.
 func proposeOrSend(ctx context.Context, gid uint32, m *intern.Mutations, chr chan res) {
 	res := res{}
 	if groups().ServesGroup(gid) {
 		node := groups().Node
+		pctx, warnings := withSchemaWarnings(ctx)
 		// we don't timeout after proposing
-		res.err = node.ProposeAndWait(ctx, &intern.Proposal{Mutations: m})
-		res.ctx = &api.TxnContext{}
+		res.err = node.ProposeAndWait(pctx, &intern.Proposal{Mutations: m})
+		res.ctx = &api.TxnContext{Warnings: warnings.get()}
 		if txn := posting.Txns().Get(m.StartTs); txn != nil {
 			txn.Fill(res.ctx)
 		}
@@ -421,32 +526,35 @@ func proposeOrSend(ctx context.Context, gid uint32, m *intern.Mutations, chr cha
 // should be sent to that group.
 func populateMutationMap(src *intern.Mutations) map[uint32]*intern.Mutations {
 	mm := make(map[uint32]*intern.Mutations)
-	for _, edge := range src.Edges {
-		gid := groups().BelongsTo(edge.Attr)
+	mutations := func(gid uint32) *intern.Mutations {
 		mu := mm[gid]
 		if mu == nil {
 			mu = &intern.Mutations{GroupId: gid}
 			mm[gid] = mu
 		}
+		return mu
+	}
+	for _, edge := range src.Edges {
+		if deletePredicateEdge(edge) {
+			// Every group serving part of a split predicate drops it.
+			for _, gid := range groups().TabletGroups(edge.Attr) {
+				mu := mutations(gid)
+				mu.Edges = append(mu.Edges, edge)
+			}
+			continue
+		}
+		mu := mutations(groups().BelongsToUid(edge.Attr, edge.Entity))
 		mu.Edges = append(mu.Edges, edge)
 	}
 	for _, schema := range src.Schema {
-		gid := groups().BelongsTo(schema.Predicate)
-		mu := mm[gid]
-		if mu == nil {
-			mu = &intern.Mutations{GroupId: gid}
-			mm[gid] = mu
+		for _, gid := range groups().TabletGroups(schema.Predicate) {
+			mu := mutations(gid)
+			mu.Schema = append(mu.Schema, schema)
 		}
-		mu.Schema = append(mu.Schema, schema)
 	}
 	if src.DropAll {
 		for _, gid := range groups().KnownGroups() {
-			mu := mm[gid]
-			if mu == nil {
-				mu = &intern.Mutations{GroupId: gid}
-				mm[gid] = mu
-			}
-			mu.DropAll = true
+			mutations(gid).DropAll = true
 		}
 	}
 	return mm
@@ -476,6 +584,9 @@ type res struct {
 // MutateOverNetwork checks which group should be running the mutations
 // according to the group config and sends it to that instance.
 func MutateOverNetwork(ctx context.Context, m *intern.Mutations) (*api.TxnContext, error) {
+	if ns := x.Namespace(ctx); ns != 0 {
+		m = namespaceMutations(ns, m)
+	}
 	tctx := &api.TxnContext{StartTs: m.StartTs}
 	tctx.LinRead = &api.LinRead{Ids: make(map[uint32]uint64)}
 	mutationMap := populateMutationMap(m)
@@ -487,6 +598,8 @@ func MutateOverNetwork(ctx context.Context, m *intern.Mutations) (*api.TxnContex
 		}
 		mu.StartTs = m.StartTs
 		mu.IgnoreIndexConflict = m.IgnoreIndexConflict
+		mu.DropInvalid = m.DropInvalid
+		mu.CommitTs = m.CommitTs
 		go proposeOrSend(ctx, gid, mu, resCh)
 	}
 
@@ -504,6 +617,7 @@ func MutateOverNetwork(ctx context.Context, m *intern.Mutations) (*api.TxnContex
 		if res.ctx != nil {
 			y.MergeLinReads(tctx.LinRead, res.ctx.LinRead)
 			tctx.Keys = append(tctx.Keys, res.ctx.Keys...)
+			tctx.Warnings = append(tctx.Warnings, res.ctx.Warnings...)
 		}
 	}
 	close(resCh)
@@ -556,8 +670,10 @@ func (w *grpcWorker) Mutate(ctx context.Context, m *intern.Mutations) (*api.TxnC
 		defer tr.Finish()
 	}
 
-	err := node.ProposeAndWait(ctx, &intern.Proposal{Mutations: m})
+	pctx, warnings := withSchemaWarnings(ctx)
+	err := node.ProposeAndWait(pctx, &intern.Proposal{Mutations: m})
 	txnCtx.StartTs = m.StartTs
+	txnCtx.Warnings = warnings.get()
 	txnCtx.LinRead = &api.LinRead{
 		Ids: map[uint32]uint64{
 			m.GroupId: node.Applied.DoneUntil(),
@@ -583,10 +699,12 @@ func tryAbortTransactions(startTimestamps []uint64) {
 
 	for i, startTs := range startTimestamps {
 		tctx := &api.TxnContext{StartTs: startTs, CommitTs: commitTimestamps[i]}
+		txn := posting.Txns().Get(startTs)
 		_, err := commitOrAbort(context.Background(), tctx)
 		for err != nil {
 			// This will fail only due to badger error.
 			_, err = commitOrAbort(context.Background(), tctx)
 		}
+		changes.publish(0, txn, tctx, nil)
 	}
 }
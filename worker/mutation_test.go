@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/intern"
@@ -82,7 +84,8 @@ func TestConvertEdgeType(t *testing.T) {
 	}
 
 	for _, testEdge := range testEdges {
-		err := ValidateAndConvert(testEdge.input, testEdge.to)
+		err := ValidateAndConvert(testEdge.input,
+			&intern.SchemaUpdate{ValueType: testEdge.to.Enum()})
 		if testEdge.expectErr {
 			require.Error(t, err)
 		} else {
@@ -100,10 +103,69 @@ func TestValidateEdgeTypeError(t *testing.T) {
 		Attr:  "name",
 	}
 
-	err := ValidateAndConvert(edge, types.DateTimeID)
+	err := ValidateAndConvert(edge, &intern.SchemaUpdate{ValueType: intern.Posting_DATETIME})
 	require.Error(t, err)
 }
 
+func TestValidateEdgeConstraint(t *testing.T) {
+	age := &intern.SchemaUpdate{
+		ValueType:  intern.Posting_INT,
+		Constraint: &intern.Constraint{HasMin: true, Min: 0, HasMax: true, Max: 150},
+	}
+	require.NoError(t, ValidateAndConvert(&intern.DirectedEdge{
+		Value: []byte("30"),
+		Attr:  "age",
+	}, age))
+	err := ValidateAndConvert(&intern.DirectedEdge{
+		Value: []byte("200"),
+		Attr:  "age",
+	}, age)
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		"Value 200 for predicate age is out of range @constraint(min: 0, max: 150)")
+	// Retrying won't help.
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	// Deletions aren't checked.
+	require.NoError(t, ValidateAndConvert(&intern.DirectedEdge{
+		Value: []byte("200"),
+		Attr:  "age",
+		Op:    intern.DirectedEdge_DEL,
+	}, &intern.SchemaUpdate{ValueType: intern.Posting_INT, List: true,
+		Constraint: age.Constraint}))
+
+	status := &intern.SchemaUpdate{
+		ValueType:  intern.Posting_STRING,
+		Constraint: &intern.Constraint{Pattern: "^[a-z]+$", Enum: []string{"open", "closed"}},
+	}
+	require.NoError(t, ValidateAndConvert(&intern.DirectedEdge{
+		Value:     []byte("open"),
+		ValueType: intern.Posting_STRING,
+		Attr:      "status",
+	}, status))
+	err = ValidateAndConvert(&intern.DirectedEdge{
+		Value: []byte("Open"),
+		Attr:  "status",
+	}, status)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Value "Open" for predicate status doesn't match @pattern`)
+	err = ValidateAndConvert(&intern.DirectedEdge{
+		Value: []byte("pending"),
+		Attr:  "status",
+	}, status)
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		`Value "pending" for predicate status is not one of @enum("open", "closed")`)
+}
+
+func TestConstraintDirectives(t *testing.T) {
+	require.Equal(t, "", constraintDirectives(nil))
+	require.Equal(t, ` @constraint(min: -1.5, max: 1e+06) @pattern("^\\d+$") @enum("a", "b")`,
+		constraintDirectives(&intern.Constraint{
+			HasMin: true, Min: -1.5, HasMax: true, Max: 1e6,
+			Pattern: `^\d+$`, Enum: []string{"a", "b"},
+		}))
+}
+
 func TestPopulateMutationMap(t *testing.T) {
 	edges := []*intern.DirectedEdge{{
 		Value: []byte("set edge"),
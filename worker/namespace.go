@@ -0,0 +1,114 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The predicates of a namespace are stored under attributes prefixed with it, see
+// x.NamespaceAttr. The functions called over the network take the predicates of the namespace
+// set with x.WithNamespace in their context, and prefix them. Predicates handed back, like
+// those in a schema, have the prefix removed. Servers receiving a request from another server
+// get the prefixed attributes, and don't need to know about namespaces.
+
+// namespaceAttr returns the attribute of a predicate, which may be reversed, in namespace ns.
+func namespaceAttr(ns uint64, attr string) string {
+	if strings.HasPrefix(attr, "~") {
+		return "~" + x.NamespaceAttr(ns, attr[1:])
+	}
+	return x.NamespaceAttr(ns, attr)
+}
+
+// inNamespace returns the predicate of the attribute, if it belongs to namespace ns.
+func inNamespace(ns uint64, attr string) (string, bool) {
+	attrNs, pred := x.ParseNamespaceAttr(attr)
+	return pred, attrNs == ns
+}
+
+// filterSchema keeps the schema of the predicates in namespace ns, without the prefix.
+func filterSchema(ns uint64, nodes []*api.SchemaNode) []*api.SchemaNode {
+	out := nodes[:0]
+	for _, node := range nodes {
+		if pred, ok := inNamespace(ns, node.Predicate); ok {
+			node.Predicate = pred
+			out = append(out, node)
+		}
+	}
+	return out
+}
+
+// NamespacePredicates returns the attributes of the predicates in the namespace of the
+// request, as stored, and whether other namespaces have predicates too.
+func NamespacePredicates(ctx context.Context) ([]string, bool, error) {
+	nodes, err := getSchemaOverNetworkAll(ctx, &intern.SchemaRequest{})
+	if err != nil {
+		return nil, false, err
+	}
+	ns := x.Namespace(ctx)
+	var preds []string
+	var others bool
+	for _, node := range nodes {
+		if _, ok := inNamespace(ns, node.Predicate); ok {
+			preds = append(preds, node.Predicate)
+		} else {
+			others = true
+		}
+	}
+	return preds, others, nil
+}
+
+// PredicateNamespaces returns the namespaces in which the predicate has a schema.
+func PredicateNamespaces(ctx context.Context, pred string) ([]uint64, error) {
+	nodes, err := getSchemaOverNetworkAll(ctx, &intern.SchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []uint64
+	for _, node := range nodes {
+		if ns, p := x.ParseNamespaceAttr(node.Predicate); p == pred {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces, nil
+}
+
+// namespaceMutations returns a copy of the mutations, with the predicates of the edges and
+// schema updates in namespace ns.
+func namespaceMutations(ns uint64, m *intern.Mutations) *intern.Mutations {
+	mc := *m
+	mc.Edges = make([]*intern.DirectedEdge, 0, len(m.Edges))
+	for _, edge := range m.Edges {
+		ec := *edge
+		ec.Attr = x.NamespaceAttr(ns, edge.Attr)
+		mc.Edges = append(mc.Edges, &ec)
+	}
+	mc.Schema = make([]*intern.SchemaUpdate, 0, len(m.Schema))
+	for _, su := range m.Schema {
+		sc := *su
+		sc.Predicate = x.NamespaceAttr(ns, su.Predicate)
+		mc.Schema = append(mc.Schema, &sc)
+	}
+	return &mc
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+func TestNamespaceAttrReverse(t *testing.T) {
+	require.Equal(t, "~3|friend", namespaceAttr(3, "~friend"))
+	require.Equal(t, "3|friend", namespaceAttr(3, "friend"))
+	require.Equal(t, "~friend", namespaceAttr(0, "~friend"))
+}
+
+func TestFilterSchema(t *testing.T) {
+	nodes := func() []*api.SchemaNode {
+		return []*api.SchemaNode{
+			{Predicate: "name"}, {Predicate: "3|name"}, {Predicate: "3|age"}, {Predicate: "4|age"},
+		}
+	}
+	preds := func(nodes []*api.SchemaNode) []string {
+		var res []string
+		for _, node := range nodes {
+			res = append(res, node.Predicate)
+		}
+		return res
+	}
+	require.Equal(t, []string{"name"}, preds(filterSchema(0, nodes())))
+	require.Equal(t, []string{"name", "age"}, preds(filterSchema(3, nodes())))
+	require.Empty(t, filterSchema(5, nodes()))
+}
+
+func TestNamespaceMutations(t *testing.T) {
+	m := &intern.Mutations{
+		StartTs: 5,
+		Edges:   []*intern.DirectedEdge{{Entity: 1, Attr: "name"}},
+		Schema:  []*intern.SchemaUpdate{{Predicate: "age"}},
+	}
+	res := namespaceMutations(3, m)
+	require.Equal(t, uint64(5), res.StartTs)
+	require.Equal(t, "3|name", res.Edges[0].Attr)
+	require.Equal(t, "3|age", res.Schema[0].Predicate)
+	// The mutations passed in are left alone.
+	require.Equal(t, "name", m.Edges[0].Attr)
+	require.Equal(t, "age", m.Schema[0].Predicate)
+}
@@ -46,7 +46,7 @@ func writeBatch(ctx context.Context, pstore *badger.ManagedDB, kv chan *intern.K
 		if len(i.Val) == 0 {
 			pstore.PurgeVersionsBelow(i.Key, math.MaxUint64)
 		} else {
-			txn.SetWithMeta(i.Key, i.Val, i.UserMeta[0])
+			txn.SetWithMeta(i.Key, x.EncryptValue(i.Val), i.UserMeta[0])
 			txn.CommitAt(i.Version, func(err error) {
 				// We don't care about exact error
 				x.Printf("Error while committing kv to badger %v\n", err)
@@ -208,6 +208,11 @@ func sendKV(stream intern.Worker_PredicateAndSchemaDataServer, it *badger.Iterat
 		if err != nil {
 			return err
 		}
+		if val, err = x.DecryptValue(val); err != nil {
+			return err
+		}
+		// The value is only valid until the iterator moves on.
+		val = append([]byte(nil), val...)
 		kv = &intern.KV{
 			Key:      key,
 			Val:      val,
@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+// predicateLoad counts the reads and writes of each predicate processed by this instance. The
+// leader of the group reports them to Zero as rates along with the tablet sizes, which Zero uses
+// to rebalance the tablets.
+type predicateLoad struct {
+	sync.Mutex
+	reads  map[string]uint64
+	writes map[string]uint64
+	since  time.Time
+}
+
+var tabletLoad = newPredicateLoad()
+
+func newPredicateLoad() *predicateLoad {
+	return &predicateLoad{
+		reads:  make(map[string]uint64),
+		writes: make(map[string]uint64),
+		since:  time.Now(),
+	}
+}
+
+func (l *predicateLoad) read(attr string) {
+	l.Lock()
+	l.reads[attr]++
+	l.Unlock()
+}
+
+func (l *predicateLoad) write(attr string) {
+	l.Lock()
+	l.writes[attr]++
+	l.Unlock()
+}
+
+// setRates sets the reads and writes per second of the tablets since the last call, and resets
+// the counts. Reads are spread over the replicas of the group, so the ones processed here are
+// scaled by their number. That's an estimate, as followers don't report the reads they serve,
+// and queries aren't spread evenly when some replicas answer slower than others.
+func (l *predicateLoad) setRates(tablets map[string]*intern.Tablet, replicas int, now time.Time) {
+	l.Lock()
+	reads, writes, since := l.reads, l.writes, l.since
+	l.reads = make(map[string]uint64)
+	l.writes = make(map[string]uint64)
+	l.since = now
+	l.Unlock()
+
+	secs := now.Sub(since).Seconds()
+	if secs <= 0 {
+		return
+	}
+	if replicas < 1 {
+		replicas = 1
+	}
+	for attr, tablet := range tablets {
+		tablet.ReadQps = float64(reads[attr]*uint64(replicas)) / secs
+		tablet.WriteQps = float64(writes[attr]) / secs
+	}
+}
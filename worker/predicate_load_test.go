@@ -0,0 +1,53 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+func TestPredicateLoadRates(t *testing.T) {
+	l := newPredicateLoad()
+	for i := 0; i < 10; i++ {
+		l.read("name")
+	}
+	for i := 0; i < 5; i++ {
+		l.write("name")
+		l.write("age")
+	}
+
+	tablets := map[string]*intern.Tablet{
+		"name": {Predicate: "name"},
+		"age":  {Predicate: "age"},
+	}
+	l.setRates(tablets, 3, l.since.Add(10*time.Second))
+	require.Equal(t, 3.0, tablets["name"].ReadQps)
+	require.Equal(t, 0.5, tablets["name"].WriteQps)
+	require.Equal(t, 0.0, tablets["age"].ReadQps)
+	require.Equal(t, 0.5, tablets["age"].WriteQps)
+
+	// The counts start over.
+	l.setRates(tablets, 3, l.since.Add(10*time.Second))
+	require.Equal(t, 0.0, tablets["name"].ReadQps)
+	require.Equal(t, 0.0, tablets["age"].WriteQps)
+}
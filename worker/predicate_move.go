@@ -25,8 +25,10 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/dgraph/posting"
@@ -43,6 +45,48 @@ var (
 	emptyPayload      = api.Payload{}
 )
 
+const (
+	// Keys received for a predicate are proposed once they add up to maxMoveBatchSize, or
+	// maxMoveBatchWait after the last proposal, so that progress is reported regularly even
+	// when the move is throttled.
+	maxMoveBatchSize = 32 << 20
+	maxMoveBatchWait = 10 * time.Second
+)
+
+// moveThrottle limits the bytes sent while moving a predicate to rate per second, so that
+// the move doesn't hurt serving latency.
+type moveThrottle struct {
+	rate  uint64
+	start time.Time
+	sent  uint64
+}
+
+// delay returns how long to wait at now before sending n more bytes.
+func (t *moveThrottle) delay(n int, now time.Time) time.Duration {
+	if t.rate == 0 {
+		return 0
+	}
+	if t.start.IsZero() {
+		t.start = now
+	}
+	t.sent += uint64(n)
+	due := time.Duration(float64(t.sent) / float64(t.rate) * float64(time.Second))
+	return due - now.Sub(t.start)
+}
+
+func (t *moveThrottle) wait(ctx context.Context, n int) error {
+	d := t.delay(n, time.Now())
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // size of kvs won't be too big, we would take care before proposing.
 func populateKeyValues(ctx context.Context, kvs []*intern.KV) error {
 	// No new deletion/background cleanup would start after we start streaming tablet,
@@ -50,13 +94,19 @@ func populateKeyValues(ctx context.Context, kvs []*intern.KV) error {
 	// single tablet.
 	groups().waitForBackgroundDeletion()
 	x.Printf("Writing %d keys\n", len(kvs))
+	for _, kv := range kvs {
+		// The keys may have postings with a TTL.
+		if pk := x.Parse(kv.Key); pk != nil {
+			ttlPreds.dirty(pk.Attr)
+		}
+	}
 
 	var hasError uint32
 	var wg sync.WaitGroup
 	wg.Add(len(kvs))
 	for _, kv := range kvs {
 		txn := pstore.NewTransactionAt(math.MaxUint64, true)
-		txn.SetWithMeta(kv.Key, kv.Val, kv.UserMeta[0])
+		txn.SetWithMeta(kv.Key, x.EncryptValue(kv.Val), kv.UserMeta[0])
 		txn.CommitAt(kv.Version, func(err error) {
 			if err != nil {
 				atomic.StoreUint32(&hasError, 1)
@@ -71,24 +121,38 @@ func populateKeyValues(ctx context.Context, kvs []*intern.KV) error {
 	return nil
 }
 
-func movePredicateHelper(ctx context.Context, predicate string, gid uint32) error {
+func movePredicateHelper(ctx context.Context, in *intern.MovePredicatePayload) error {
+	predicate, gid := in.Predicate, in.DestGroupId
 	pl := groups().Leader(gid)
 	if pl == nil {
 		return x.Errorf("Unable to find a connection for groupd: %d\n", gid)
 	}
 	c := intern.NewWorkerClient(pl.Get())
-	stream, err := c.ReceivePredicate(ctx)
+	pairs := []string{"attempt", strconv.FormatUint(uint64(in.Attempt), 10)}
+	if len(in.ResumeKey) > 0 {
+		// The destination keeps the keys it got before, instead of cleaning the predicate.
+		pairs = append(pairs, "resume-bin", string(in.ResumeKey))
+	}
+	sctx := metadata.NewOutgoingContext(ctx, metadata.Pairs(pairs...))
+	stream, err := c.ReceivePredicate(sctx)
 	if err != nil {
 		return err
 	}
 
 	count := 0
-	sendPl := func(stream intern.Worker_ReceivePredicateClient, l *posting.List) error {
+	throttle := &moveThrottle{rate: in.Rate}
+	send := func(kv *intern.KV) error {
+		if err := throttle.wait(ctx, len(kv.Key)+len(kv.Val)); err != nil {
+			return err
+		}
+		return stream.Send(kv)
+	}
+	sendPl := func(l *posting.List) error {
 		kv, err := l.MarshalToKv()
 		if err != nil {
 			return err
 		}
-		return stream.Send(kv)
+		return send(kv)
 	}
 
 	// sends all data except schema, schema key has different prefix
@@ -100,24 +164,40 @@ func movePredicateHelper(ctx context.Context, predicate string, gid uint32) erro
 	defer it.Close()
 
 	prefix := x.PredicatePrefix(predicate)
+	start := prefix
+	if in.StartUid > 0 {
+		// Only the data of a range of uids is moved, the destination rebuilds the indexes.
+		pk := x.ParsedKey{Attr: predicate}
+		prefix = pk.DataPrefix()
+		start = x.DataKey(predicate, in.StartUid)
+	}
 	var prevKey []byte
-	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+	if len(in.ResumeKey) > 0 {
+		// The destination already has the keys up to the resume key. If that is the schema
+		// key, only the schema is sent again.
+		start = in.ResumeKey
+		prevKey = append(prevKey, in.ResumeKey...)
+	}
+	// ReadPostingList moves the iterator past the versions it reads.
+	for it.Seek(start); it.ValidForPrefix(prefix); {
 		item := it.Item()
 		key := item.Key()
 		if bytes.Equal(key, prevKey) {
 			it.Next()
 			continue
 		}
-		if cap(prevKey) < len(key) {
-			prevKey = make([]byte, len(key))
+		if in.StartUid > 0 && in.EndUid > 0 {
+			if pk := x.Parse(key); pk != nil && pk.Uid >= in.EndUid {
+				break
+			}
 		}
-		copy(prevKey, key)
+		prevKey = append(prevKey[:0], key...)
 		l, err := posting.ReadPostingList(key, it)
 		if err != nil {
 			return err
 		}
 		count++
-		if err := sendPl(stream, l); err != nil {
+		if err := sendPl(l); err != nil {
 			return err
 		}
 	}
@@ -132,12 +212,16 @@ func movePredicateHelper(ctx context.Context, predicate string, gid uint32) erro
 	if err != nil {
 		return err
 	}
+	if val, err = x.DecryptValue(val); err != nil {
+		return err
+	}
 	kv := &intern.KV{}
 	kv.Key = schemaKey
-	kv.Val = val
+	// The value is only valid until the transaction is discarded.
+	kv.Val = append([]byte(nil), val...)
 	kv.Version = 1
 	kv.UserMeta = []byte{item.UserMeta()}
-	if err := stream.Send(kv); err != nil {
+	if err := send(kv); err != nil {
 		return err
 	}
 	count++
@@ -157,39 +241,80 @@ func movePredicateHelper(ctx context.Context, predicate string, gid uint32) erro
 	return nil
 }
 
-func batchAndProposeKeyValues(ctx context.Context, kvs chan *intern.KV) error {
+// reportMoveProgress tells Zero that the keys up to p.LastKey of a predicate moving to this
+// group are written, so that a failed move can resume after them.
+func reportMoveProgress(ctx context.Context, p *intern.MoveProgress) {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return
+	}
+	zc := intern.NewZeroClient(pl.Get())
+	if _, err := zc.ReportMoveProgress(ctx, p); err != nil {
+		x.Printf("Error while reporting progress of moving predicate %v: %v\n", p.Predicate, err)
+	}
+}
+
+// batchAndProposeKeyValues writes the keys of a predicate moved to this group. A resumed move
+// keeps the keys up to resumeKey, which are counted by the attempt which wrote them.
+func batchAndProposeKeyValues(ctx context.Context, kvs chan *intern.KV, resumeKey []byte,
+	attempt uint32) error {
 	n := groups().Node
 	proposal := &intern.Proposal{}
-	size := 0
+	size, keys := 0, 0
 	firstKV := true
 	var predicate string
+	last := time.Now()
 
-	for kv := range kvs {
-		if size >= 32<<20 { // 32 MB
-			if err := n.ProposeAndWait(ctx, proposal); err != nil {
-				return err
-			}
-			proposal.Kv = proposal.Kv[:0]
-			size = 0
-			continue
+	propose := func() error {
+		if len(proposal.Kv) == 0 {
+			return nil
+		}
+		if err := n.ProposeAndWait(ctx, proposal); err != nil {
+			return err
 		}
+		reportMoveProgress(ctx, &intern.MoveProgress{
+			Predicate: predicate,
+			DstGroup:  groups().groupId(),
+			LastKey:   proposal.Kv[len(proposal.Kv)-1].Key,
+			Keys:      uint64(keys),
+			Bytes:     uint64(size),
+			Attempt:   attempt,
+		})
+		proposal.Kv = proposal.Kv[:0]
+		size, keys = 0, 0
+		last = time.Now()
+		return nil
+	}
 
+	for kv := range kvs {
 		if firstKV {
 			firstKV = false
 			pk := x.Parse(kv.Key)
 			predicate = pk.Attr
-			// Delete on all nodes.
-			p := &intern.Proposal{CleanPredicate: pk.Attr}
-			err := groups().Node.ProposeAndWait(ctx, p)
-			if err != nil {
-				x.Printf("Error while cleaning predicate %v %v\n", pk.Attr, err)
+			// A resumed move keeps the keys written before.
+			if len(resumeKey) == 0 {
+				// Delete on all nodes.
+				p := &intern.Proposal{CleanPredicate: pk.Attr}
+				err := groups().Node.ProposeAndWait(ctx, p)
+				if err != nil {
+					x.Printf("Error while cleaning predicate %v %v\n", pk.Attr, err)
+				}
 			}
 		}
 		proposal.Kv = append(proposal.Kv, kv)
-		size = size + len(kv.Key) + len(kv.Val)
+		// The schema is sent again when resuming from its key.
+		if bytes.Compare(kv.Key, resumeKey) > 0 {
+			size = size + len(kv.Key) + len(kv.Val)
+			keys++
+		}
+		if size >= maxMoveBatchSize || time.Since(last) >= maxMoveBatchWait {
+			if err := propose(); err != nil {
+				return err
+			}
+		}
 	}
 	// Propose remaining keys.
-	if err := n.ProposeAndWait(ctx, proposal); err != nil {
+	if err := propose(); err != nil {
 		return err
 	}
 	return schema.Load(predicate)
@@ -205,16 +330,28 @@ func (w *grpcWorker) ReceivePredicate(stream intern.Worker_ReceivePredicateServe
 	count := 0
 	ctx := stream.Context()
 	payload := &api.Payload{}
+	var resumeKey []byte
+	var attempt uint32
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md["resume-bin"]; len(v) > 0 {
+			resumeKey = []byte(v[0])
+		}
+		if v := md["attempt"]; len(v) > 0 {
+			a, err := strconv.ParseUint(v[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			attempt = uint32(a)
+		}
+	}
 
 	go func() {
 		// Takes care of throttling and batching.
-		che <- batchAndProposeKeyValues(ctx, kvs)
+		che <- batchAndProposeKeyValues(ctx, kvs, resumeKey, attempt)
 	}()
 	for {
 		kv, err := stream.Recv()
 		if err == io.EOF {
-			payload.Data = []byte(fmt.Sprintf("%d", count))
-			stream.SendAndClose(payload)
 			break
 		}
 		if err != nil {
@@ -236,9 +373,14 @@ func (w *grpcWorker) ReceivePredicate(stream intern.Worker_ReceivePredicateServe
 		}
 	}
 	close(kvs)
+	// Only answer once all the keys are written, so that the sender knows the move is done.
 	err := <-che
 	x.Printf("received %d number of keys, error %v\n", count, err)
-	return err
+	if err != nil {
+		return err
+	}
+	payload.Data = []byte(fmt.Sprintf("%d", count))
+	return stream.SendAndClose(payload)
 }
 
 func (w *grpcWorker) MovePredicate(ctx context.Context,
@@ -274,6 +416,6 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	// We iterate over badger, so need to flush and wait for sync watermark to catch up.
 	n.applyAllMarks(ctx)
 
-	err := movePredicateHelper(ctx, in.Predicate, in.DestGroupId)
+	err := movePredicateHelper(ctx, in)
 	return &emptyPayload, err
 }
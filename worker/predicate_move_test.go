@@ -0,0 +1,38 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveThrottle(t *testing.T) {
+	start := time.Now()
+	th := &moveThrottle{rate: 1000}
+	require.Equal(t, time.Second, th.delay(1000, start))
+	// Waiting for the first delay leaves no delay for data sent at the rate.
+	require.Equal(t, time.Duration(0), th.delay(500, start.Add(1500*time.Millisecond)))
+	// Sending ahead of the rate waits until it catches up.
+	require.Equal(t, 2*time.Second, th.delay(2000, start.Add(1500*time.Millisecond)))
+
+	unlimited := &moveThrottle{}
+	require.Equal(t, time.Duration(0), unlimited.delay(1<<30, start))
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"math"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// RenamePredicateOverNetwork asks Zero to rename a predicate, which it coordinates with the
+// group serving it.
+func RenamePredicateOverNetwork(ctx context.Context, attr, newName string) error {
+	ns := x.Namespace(ctx)
+	attr, newName = x.NamespaceAttr(ns, attr), x.NamespaceAttr(ns, newName)
+	if _, pred := x.ParseNamespaceAttr(attr); pred == x.PredicateListAttr {
+		return x.Errorf("Predicate %s can't be renamed", x.PredicateListAttr)
+	}
+	if _, pred := x.ParseNamespaceAttr(newName); pred == x.PredicateListAttr {
+		return x.Errorf("Predicate %s can't be renamed", x.PredicateListAttr)
+	}
+	if attr == newName {
+		return x.Errorf("Predicate %s can't be renamed to itself", attr)
+	}
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	c := intern.NewZeroClient(pl.Get())
+	_, err := c.RenamePredicate(ctx, &intern.RenamePredicatePayload{
+		Predicate: attr,
+		NewName:   newName,
+	})
+	return err
+}
+
+func (w *grpcWorker) RenamePredicate(ctx context.Context,
+	in *intern.RenamePredicatePayload) (*api.Payload, error) {
+	if groups().gid != in.GroupId {
+		return &emptyPayload,
+			x.Errorf("Group id doesn't match, received request for %d, my gid: %d",
+				in.GroupId, groups().gid)
+	}
+	if len(in.Predicate) == 0 || len(in.NewName) == 0 {
+		return &emptyPayload, errEmptyPredicate
+	}
+	if !groups().ServesTablet(in.Predicate) {
+		return &emptyPayload, errUnservedTablet
+	}
+	n := groups().Node
+	if !n.AmLeader() {
+		return &emptyPayload, errNotLeader
+	}
+
+	// Ensures that all future mutations beyond this point are rejected.
+	if err := n.ProposeAndWait(ctx, &intern.Proposal{State: in.State}); err != nil {
+		return &emptyPayload, err
+	}
+	tctxs := posting.Txns().Iterate(func(key []byte) bool {
+		pk := x.Parse(key)
+		return pk.Attr == in.Predicate
+	})
+	if len(tctxs) > 0 {
+		tryAbortTransactions(tctxs)
+	}
+	n.applyAllMarks(ctx)
+	if err := updatePredicateLists(ctx, in); err != nil {
+		return &emptyPayload, err
+	}
+
+	err := n.ProposeAndWait(ctx, &intern.Proposal{Rename: &intern.RenamePredicatePayload{
+		Predicate: in.Predicate,
+		NewName:   in.NewName,
+		GroupId:   in.GroupId,
+	}})
+	return &emptyPayload, err
+}
+
+// updatePredicateLists replaces the predicate with its new name in the _predicate_ lists of the
+// nodes which have it, in the txn Zero started for the rename. Zero commits the txn in the same
+// proposal as the new tablets, or aborts it if the rename failed.
+func updatePredicateLists(ctx context.Context, in *intern.RenamePredicatePayload) error {
+	if !Config.ExpandEdge || in.StartTs == 0 {
+		return nil
+	}
+	out := &intern.Result{}
+	q := &intern.Query{Attr: in.Predicate, ReadTs: math.MaxUint64, IncludeCached: true}
+	if err := handleHasFunction(ctx, q, out); err != nil {
+		return err
+	}
+	ns, attr := x.ParseNamespaceAttr(in.Predicate)
+	_, newName := x.ParseNamespaceAttr(in.NewName)
+	listAttr := x.NamespaceAttr(ns, x.PredicateListAttr)
+	m := &intern.Mutations{StartTs: in.StartTs}
+	for _, uid := range out.UidMatrix[0].Uids {
+		m.Edges = append(m.Edges, &intern.DirectedEdge{
+			Entity: uid,
+			Attr:   listAttr,
+			Value:  []byte(attr),
+			Op:     intern.DirectedEdge_DEL,
+		}, &intern.DirectedEdge{
+			Entity: uid,
+			Attr:   listAttr,
+			Value:  []byte(newName),
+			Op:     intern.DirectedEdge_SET,
+		})
+	}
+	if len(m.Edges) == 0 {
+		return nil
+	}
+	_, err := MutateOverNetwork(ctx, m)
+	return err
+}
+
+// renamePredicate moves the keys and schema of attr under newName on this server.
+func renamePredicate(ctx context.Context, attr, newName string) error {
+	rv := ctx.Value("raft").(x.RaftValue)
+	if s, ok := schema.State().Get(attr); ok {
+		if err := updateSchema(newName, s, rv.Index); err != nil {
+			return err
+		}
+	}
+	if err := posting.CopyPredicate(attr, newName); err != nil {
+		return err
+	}
+	x.Printf("Renamed predicate %s to %s\n", attr, newName)
+	return posting.DeletePredicate(ctx, attr)
+}
+
+func (n *node) renamePredicate(index uint64, pid uint32, in *intern.RenamePredicatePayload) {
+	ctx, _ := n.props.CtxAndTxn(pid)
+	rv := x.RaftValue{Group: n.gid, Index: index}
+	ctx = context.WithValue(ctx, "raft", rv)
+	err := renamePredicate(ctx, in.Predicate, in.NewName)
+	posting.TxnMarks().Done(index)
+	n.props.Done(pid, err)
+}
@@ -0,0 +1,401 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/dgraph/y"
+)
+
+// A split predicate is served by several groups, each serving a range of uids, see
+// intern.Tablet. Each group stores the data of its uids, and indexes, reverse edges and count
+// indexes built from them. Queries for some uids go to the groups serving them, while the ones
+// which look up an index are sent to all the groups, and the results are merged.
+
+// SplitPredicate is called by Zero on each group serving a predicate which is being split, once
+// the ranges it's going to serve have been moved to it.
+func (w *grpcWorker) SplitPredicate(ctx context.Context,
+	in *intern.SplitPredicatePayload) (*api.Payload, error) {
+	if groups().gid != in.GroupId {
+		return &emptyPayload,
+			x.Errorf("Group id doesn't match, received request for %d, my gid: %d",
+				in.GroupId, groups().gid)
+	}
+	if len(in.Predicate) == 0 || in.Tablet == nil {
+		return &emptyPayload, errEmptyPredicate
+	}
+	if !servedBy(in.Tablet, in.GroupId) {
+		return &emptyPayload, errUnservedTablet
+	}
+	n := groups().Node
+	if !n.AmLeader() {
+		return &emptyPayload, errNotLeader
+	}
+	// Mutations are blocked while the predicate is read only, we only need the ones already
+	// proposed to be applied.
+	n.applyAllMarks(ctx)
+
+	err := n.ProposeAndWait(ctx, &intern.Proposal{Split: in})
+	return &emptyPayload, err
+}
+
+// splitPredicate deletes the data of the uids of the predicate which this group doesn't serve
+// in the split tablet, and rebuilds the indexes from the data of the ones it does.
+func splitPredicate(ctx context.Context, in *intern.SplitPredicatePayload) error {
+	attr := in.Predicate
+	posting.CommitLists(func(key []byte) bool {
+		pk := x.Parse(key)
+		return pk != nil && pk.Attr == attr
+	})
+	if err := schema.Load(attr); err != nil {
+		return err
+	}
+	err := posting.DeleteUids(ctx, attr, func(uid uint64) bool {
+		return tabletGroup(in.Tablet, uid) != in.GroupId
+	})
+	if err != nil {
+		return err
+	}
+
+	n := groups().Node
+	if schema.State().IsIndexed(attr) {
+		if err := n.rebuildOrDelIndex(ctx, attr, true, in.ReadTs); err != nil {
+			return err
+		}
+	} else if schema.State().IsReversed(attr) {
+		if err := n.rebuildOrDelRevEdge(ctx, attr, true, in.ReadTs); err != nil {
+			return err
+		}
+	}
+	if schema.State().HasCount(attr) {
+		if err := n.rebuildOrDelCountIndex(ctx, attr, true, in.ReadTs); err != nil {
+			return err
+		}
+	}
+	x.Printf("Rebuilt predicate %s for the uids served by group %d\n", attr, in.GroupId)
+	return nil
+}
+
+func (n *node) splitPredicate(index uint64, pid uint32, in *intern.SplitPredicatePayload) {
+	ctx, _ := n.props.CtxAndTxn(pid)
+	rv := x.RaftValue{Group: n.gid, Index: index}
+	ctx = context.WithValue(ctx, "raft", rv)
+	err := splitPredicate(ctx, in)
+	posting.TxnMarks().Done(index)
+	n.props.Done(pid, err)
+}
+
+// readsUidData returns whether the query reads the data of the uids in its list, which the
+// groups serving them can answer for alone, rather than an index or reverse edges.
+func readsUidData(q *intern.Query, fnType FuncType) bool {
+	if q.Reverse || len(q.UidList.GetUids()) == 0 {
+		return false
+	}
+	switch fnType {
+	case NotAFunction, AggregatorFn, PasswordFn, CompareScalarFn, HasFn, UidInFn:
+		return true
+	}
+	return false
+}
+
+// splitPart is the part of a query for a split predicate sent to one of its groups.
+type splitPart struct {
+	gid uint32
+	q   *intern.Query
+}
+
+// partitionQuery splits the uids of the query in runs served by the same group, in order.
+func partitionQuery(q *intern.Query, tablet *intern.Tablet) []splitPart {
+	var parts []splitPart
+	uids := q.UidList.Uids
+	for start := 0; start < len(uids); {
+		gid := tabletGroup(tablet, uids[start])
+		end := start + 1
+		for end < len(uids) && tabletGroup(tablet, uids[end]) == gid {
+			end++
+		}
+		qc := *q
+		qc.UidList = &intern.List{Uids: uids[start:end]}
+		parts = append(parts, splitPart{gid: gid, q: &qc})
+		start = end
+	}
+	return parts
+}
+
+// processSplitTask processes the query for a split predicate. Queries reading the data of some
+// uids go to the groups serving them, and the results are concatenated. Others go to all the
+// groups, and the results are merged row by row.
+func processSplitTask(ctx context.Context, q *intern.Query,
+	tablet *intern.Tablet) (*intern.Result, error) {
+	fnType, fname := parseFuncType(q.SrcFunc)
+	if q.Reverse && fnType == CompareScalarFn {
+		return &emptyResult, x.Errorf("Function %s on count of reverse edges isn't supported"+
+			" for split predicate %s", fname, q.Attr)
+	}
+	// Until the split is finished, the group the tablet belongs to still has the data of every
+	// uid, see finishSplit. Its results are restricted to its own uids, which the other groups
+	// don't have, so that nothing is counted twice.
+	splitting := tablet.ReadOnly && !readsUidData(q, fnType)
+	var parts []splitPart
+	if readsUidData(q, fnType) {
+		parts = partitionQuery(q, tablet)
+	} else {
+		for _, gid := range tabletGroups(tablet) {
+			parts = append(parts, splitPart{gid: gid, q: q})
+		}
+	}
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf("attr: %v split across %d requests, readTs: %d", q.Attr, len(parts),
+			q.ReadTs)
+	}
+
+	results := make([]*intern.Result, len(parts))
+	errCh := make(chan error, len(parts))
+	for i, part := range parts {
+		go func(i int, part splitPart) {
+			var err error
+			if splitting && part.gid == tablet.GroupId {
+				results[i], err = processOwnUids(ctx, part.q, tablet)
+			} else {
+				results[i], err = processTaskInGroup(ctx, part.q, part.gid)
+			}
+			errCh <- err
+		}(i, part)
+	}
+	var rerr error
+	for range parts {
+		if err := <-errCh; err != nil && rerr == nil {
+			rerr = err
+		}
+	}
+	if rerr != nil {
+		return &emptyResult, rerr
+	}
+
+	if readsUidData(q, fnType) {
+		return concatResults(results), nil
+	}
+	if len(q.UidList.GetUids()) > 0 && (fnType == HasFn || fnType == UidInFn) {
+		// Filters on reverse edges return a row for each uid kept.
+		return mergeUidRows(results), nil
+	}
+	return mergeResults(results)
+}
+
+// processOwnUids processes the query in the group the split tablet belongs to, keeping only the
+// uids the group serves in the results. Counts are worked out from the uids kept.
+func processOwnUids(ctx context.Context, q *intern.Query,
+	tablet *intern.Tablet) (*intern.Result, error) {
+	qc := *q
+	qc.DoCount = false
+	r, err := processTaskInGroup(ctx, &qc, tablet.GroupId)
+	if err != nil {
+		return r, err
+	}
+	filterOwnUids(r, tablet, q.DoCount)
+	return r, nil
+}
+
+func filterOwnUids(r *intern.Result, tablet *intern.Tablet, count bool) {
+	withFacets := len(r.FacetMatrix) == len(r.UidMatrix)
+	for i, row := range r.UidMatrix {
+		kept := &intern.List{}
+		facets := &intern.FacetsList{}
+		for j, uid := range row.Uids {
+			if tabletGroup(tablet, uid) != tablet.GroupId {
+				continue
+			}
+			kept.Uids = append(kept.Uids, uid)
+			if withFacets && j < len(r.FacetMatrix[i].FacetsList) {
+				facets.FacetsList = append(facets.FacetsList, r.FacetMatrix[i].FacetsList[j])
+			}
+		}
+		r.UidMatrix[i] = kept
+		if withFacets {
+			r.FacetMatrix[i] = facets
+		}
+	}
+	if !count {
+		return
+	}
+	r.Counts = make([]uint32, len(r.UidMatrix))
+	for i, row := range r.UidMatrix {
+		r.Counts[i] = uint32(len(row.Uids))
+		r.UidMatrix[i] = &intern.List{}
+	}
+	r.FacetMatrix = nil
+}
+
+func newSplitResult(results []*intern.Result) *intern.Result {
+	out := &intern.Result{LinRead: &api.LinRead{Ids: make(map[uint32]uint64)}}
+	for _, r := range results {
+		out.IntersectDest = out.IntersectDest || r.IntersectDest
+		y.MergeLinReads(out.LinRead, r.LinRead)
+	}
+	return out
+}
+
+// concatResults concatenates the results for consecutive runs of uids.
+func concatResults(results []*intern.Result) *intern.Result {
+	out := newSplitResult(results)
+	for _, r := range results {
+		out.UidMatrix = append(out.UidMatrix, r.UidMatrix...)
+		out.ValueMatrix = append(out.ValueMatrix, r.ValueMatrix...)
+		out.Counts = append(out.Counts, r.Counts...)
+		out.FacetMatrix = append(out.FacetMatrix, r.FacetMatrix...)
+		out.LangMatrix = append(out.LangMatrix, r.LangMatrix...)
+	}
+	return out
+}
+
+// mergeUidRows merges results which have a row with a single uid for each uid they keep.
+func mergeUidRows(results []*intern.Result) *intern.Result {
+	out := newSplitResult(results)
+	var lists []*intern.List
+	for _, r := range results {
+		lists = append(lists, r.UidMatrix...)
+	}
+	for _, uid := range algo.MergeSorted(lists).Uids {
+		out.UidMatrix = append(out.UidMatrix, &intern.List{Uids: []uint64{uid}})
+	}
+	return out
+}
+
+// mergeResults merges the results of the same query from each group, which have the same rows.
+// Uids are merged, counts added up, and values, which are only stored by one group, taken from
+// the group which has them.
+func mergeResults(results []*intern.Result) (*intern.Result, error) {
+	out := newSplitResult(results)
+	first := results[0]
+	for _, r := range results[1:] {
+		if len(r.UidMatrix) != len(first.UidMatrix) ||
+			len(r.ValueMatrix) != len(first.ValueMatrix) || len(r.Counts) != len(first.Counts) {
+			return &emptyResult, x.Errorf("Results from groups of split predicate don't match")
+		}
+	}
+
+	if len(first.Counts) > 0 {
+		out.Counts = make([]uint32, len(first.Counts))
+		for _, r := range results {
+			for i, c := range r.Counts {
+				out.Counts[i] += c
+			}
+		}
+	}
+	for i := range first.ValueMatrix {
+		src := first
+		for _, r := range results {
+			if len(r.ValueMatrix[i].Values) > 0 {
+				src = r
+				break
+			}
+		}
+		out.ValueMatrix = append(out.ValueMatrix, src.ValueMatrix[i])
+		if len(src.LangMatrix) == len(src.ValueMatrix) {
+			out.LangMatrix = append(out.LangMatrix, src.LangMatrix[i])
+		}
+	}
+
+	withFacets := true
+	for _, r := range results {
+		withFacets = withFacets && len(r.FacetMatrix) == len(r.UidMatrix)
+	}
+	for i := range first.UidMatrix {
+		var rows []*intern.Result
+		for _, r := range results {
+			if len(r.UidMatrix[i].Uids) > 0 {
+				rows = append(rows, r)
+			}
+		}
+		switch {
+		case len(rows) <= 1:
+			src := first
+			if len(rows) == 1 {
+				src = rows[0]
+			} else if len(first.ValueMatrix) == len(first.UidMatrix) {
+				// The facets of a value are in the row of the group which has it.
+				for _, r := range results {
+					if len(r.ValueMatrix[i].Values) > 0 {
+						src = r
+						break
+					}
+				}
+			}
+			out.UidMatrix = append(out.UidMatrix, src.UidMatrix[i])
+			if withFacets {
+				out.FacetMatrix = append(out.FacetMatrix, src.FacetMatrix[i])
+			}
+		case withFacets:
+			uids, facets := mergeFacetRows(rows, i)
+			out.UidMatrix = append(out.UidMatrix, uids)
+			out.FacetMatrix = append(out.FacetMatrix, facets)
+		default:
+			var lists []*intern.List
+			for _, r := range rows {
+				lists = append(lists, r.UidMatrix[i])
+			}
+			out.UidMatrix = append(out.UidMatrix, algo.MergeSorted(lists))
+		}
+	}
+	return out, nil
+}
+
+// mergeFacetRows merges row i of the uid matrices of the results, along with the facets of the
+// uids.
+func mergeFacetRows(results []*intern.Result, i int) (*intern.List, *intern.FacetsList) {
+	type uidFacets struct {
+		uid    uint64
+		facets *intern.Facets
+	}
+	var all []uidFacets
+	for _, r := range results {
+		uids, facets := r.UidMatrix[i].Uids, r.FacetMatrix[i].FacetsList
+		for j, uid := range uids {
+			uf := uidFacets{uid: uid}
+			if j < len(facets) {
+				uf.facets = facets[j]
+			}
+			all = append(all, uf)
+		}
+	}
+	sort.Slice(all, func(a, b int) bool { return all[a].uid < all[b].uid })
+
+	uids := &intern.List{}
+	facets := &intern.FacetsList{}
+	for j, uf := range all {
+		if j > 0 && uf.uid == all[j-1].uid {
+			continue
+		}
+		uids.Uids = append(uids.Uids, uf.uid)
+		if uf.facets == nil {
+			uf.facets = &intern.Facets{}
+		}
+		facets.FacetsList = append(facets.FacetsList, uf.facets)
+	}
+	return uids, facets
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+var splitTestTablet = &intern.Tablet{
+	Predicate: "friend",
+	GroupId:   1,
+	Splits: []*intern.TabletSplit{
+		{StartUid: 10, GroupId: 2},
+		{StartUid: 20, GroupId: 3},
+	},
+}
+
+func TestTabletGroup(t *testing.T) {
+	require.Equal(t, uint32(1), tabletGroup(splitTestTablet, 1))
+	require.Equal(t, uint32(1), tabletGroup(splitTestTablet, 9))
+	require.Equal(t, uint32(2), tabletGroup(splitTestTablet, 10))
+	require.Equal(t, uint32(2), tabletGroup(splitTestTablet, 19))
+	require.Equal(t, uint32(3), tabletGroup(splitTestTablet, 20))
+	require.Equal(t, uint32(3), tabletGroup(splitTestTablet, 1<<40))
+
+	require.Equal(t, []uint32{1, 2, 3}, tabletGroups(splitTestTablet))
+	require.Equal(t, []uint32{1}, tabletGroups(&intern.Tablet{GroupId: 1}))
+	require.True(t, servedBy(splitTestTablet, 3))
+	require.False(t, servedBy(splitTestTablet, 4))
+}
+
+func TestPartitionQuery(t *testing.T) {
+	q := &intern.Query{Attr: "friend", UidList: &intern.List{Uids: []uint64{1, 5, 12, 25, 30}}}
+	parts := partitionQuery(q, splitTestTablet)
+	require.Len(t, parts, 3)
+	require.Equal(t, uint32(1), parts[0].gid)
+	require.Equal(t, []uint64{1, 5}, parts[0].q.UidList.Uids)
+	require.Equal(t, uint32(2), parts[1].gid)
+	require.Equal(t, []uint64{12}, parts[1].q.UidList.Uids)
+	require.Equal(t, uint32(3), parts[2].gid)
+	require.Equal(t, []uint64{25, 30}, parts[2].q.UidList.Uids)
+	require.Equal(t, "friend", parts[2].q.Attr)
+	// The query itself is left as is.
+	require.Len(t, q.UidList.Uids, 5)
+}
+
+func TestConcatResults(t *testing.T) {
+	res := concatResults([]*intern.Result{
+		{UidMatrix: []*intern.List{{Uids: []uint64{7}}}, Counts: []uint32{1}},
+		{UidMatrix: []*intern.List{{Uids: []uint64{8, 9}}}, Counts: []uint32{2}},
+	})
+	require.Len(t, res.UidMatrix, 2)
+	require.Equal(t, []uint64{8, 9}, res.UidMatrix[1].Uids)
+	require.Equal(t, []uint32{1, 2}, res.Counts)
+}
+
+func TestMergeResults(t *testing.T) {
+	val := &intern.ValueList{Values: []*intern.TaskValue{{Val: []byte("a")}}}
+	res, err := mergeResults([]*intern.Result{
+		{
+			UidMatrix:   []*intern.List{{Uids: []uint64{1, 5}}, {}},
+			ValueMatrix: []*intern.ValueList{{}, {}},
+			Counts:      []uint32{2, 0},
+		},
+		{
+			UidMatrix:   []*intern.List{{Uids: []uint64{12}}, {}},
+			ValueMatrix: []*intern.ValueList{{}, val},
+			Counts:      []uint32{1, 0},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 5, 12}, res.UidMatrix[0].Uids)
+	require.Empty(t, res.UidMatrix[1].Uids)
+	require.Equal(t, []uint32{3, 0}, res.Counts)
+	require.Empty(t, res.ValueMatrix[0].Values)
+	require.Equal(t, val, res.ValueMatrix[1])
+
+	_, err = mergeResults([]*intern.Result{
+		{UidMatrix: []*intern.List{{}}},
+		{UidMatrix: []*intern.List{{}, {}}},
+	})
+	require.Error(t, err)
+}
+
+func TestMergeUidRows(t *testing.T) {
+	res := mergeUidRows([]*intern.Result{
+		{UidMatrix: []*intern.List{{Uids: []uint64{3}}, {Uids: []uint64{15}}}},
+		{UidMatrix: []*intern.List{{Uids: []uint64{7}}}},
+	})
+	require.Len(t, res.UidMatrix, 3)
+	require.Equal(t, []uint64{7}, res.UidMatrix[1].Uids)
+}
+
+func TestSplitWindowRead(t *testing.T) {
+	// The split was proposed, but group 1 didn't drop the uids of groups 2 and 3 yet.
+	splitting := *splitTestTablet
+	splitting.ReadOnly = true
+
+	// count(~friend) of two uids. Group 1 is asked for the subjects, and counts its own.
+	own := &intern.Result{UidMatrix: []*intern.List{
+		{Uids: []uint64{3, 12, 25}},
+		{Uids: []uint64{15}},
+	}}
+	filterOwnUids(own, &splitting, true)
+	require.Equal(t, []uint32{1, 0}, own.Counts)
+	res, err := mergeResults([]*intern.Result{
+		own,
+		{UidMatrix: []*intern.List{{}, {}}, Counts: []uint32{1, 1}},
+		{UidMatrix: []*intern.List{{}, {}}, Counts: []uint32{1, 0}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{3, 1}, res.Counts)
+
+	// Index lookups keep the facets of the uids kept.
+	f1, f2 := &intern.Facets{}, &intern.Facets{}
+	own = &intern.Result{
+		UidMatrix:   []*intern.List{{Uids: []uint64{3, 12}}},
+		FacetMatrix: []*intern.FacetsList{{FacetsList: []*intern.Facets{f1, f2}}},
+	}
+	filterOwnUids(own, &splitting, false)
+	require.Equal(t, []uint64{3}, own.UidMatrix[0].Uids)
+	require.Equal(t, []*intern.Facets{f1}, own.FacetMatrix[0].FacetsList)
+}
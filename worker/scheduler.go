@@ -149,7 +149,8 @@ func (s *scheduler) schedule(proposal *intern.Proposal, index uint64) (err error
 				break
 			}
 			s.waitForConflictResolution(supdate.Predicate)
-			err = s.n.processSchemaMutations(proposal.Id, index, startTs, supdate)
+			err = s.n.processSchemaMutations(proposal.Id, index, startTs,
+				proposal.Mutations.CommitTs, supdate, proposal.Mutations.DropInvalid)
 			if err != nil {
 				break
 			}
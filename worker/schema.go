@@ -53,7 +53,8 @@ func getSchema(ctx context.Context, s *intern.SchemaRequest) (*intern.SchemaResu
 	if len(s.Fields) > 0 {
 		fields = s.Fields
 	} else {
-		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list"}
+		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list",
+			"constraint", "pattern", "enum", "ttl"}
 	}
 
 	for _, attr := range predicates {
@@ -95,6 +96,22 @@ func populateSchema(attr string, fields []string) *api.SchemaNode {
 			schemaNode.Count = schema.State().HasCount(attr)
 		case "list":
 			schemaNode.List = schema.State().IsList(attr)
+		case "constraint":
+			if c := schema.State().Constraint(attr); c != nil {
+				schemaNode.Constraint = constraintRange(c)
+			}
+		case "pattern":
+			if c := schema.State().Constraint(attr); c != nil {
+				schemaNode.Pattern = c.Pattern
+			}
+		case "enum":
+			if c := schema.State().Constraint(attr); c != nil {
+				schemaNode.Enum = c.Enum
+			}
+		case "ttl":
+			if ttl := schema.State().TTL(attr); ttl > 0 {
+				schemaNode.Ttl = ttlString(ttl)
+			}
 		default:
 			//pass
 		}
@@ -158,6 +175,25 @@ func getSchemaOverNetwork(ctx context.Context, gid uint32, s *intern.SchemaReque
 // GetSchemaOverNetwork checks which group should be serving the schema
 // according to fingerprint of the predicate and sends it to that instance.
 func GetSchemaOverNetwork(ctx context.Context, schema *intern.SchemaRequest) ([]*api.SchemaNode, error) {
+	ns := x.Namespace(ctx)
+	if ns != 0 && len(schema.Predicates) > 0 {
+		sc := *schema
+		sc.Predicates = make([]string, 0, len(schema.Predicates))
+		for _, pred := range schema.Predicates {
+			sc.Predicates = append(sc.Predicates, x.NamespaceAttr(ns, pred))
+		}
+		schema = &sc
+	}
+	nodes, err := getSchemaOverNetworkAll(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+	return filterSchema(ns, nodes), nil
+}
+
+// getSchemaOverNetworkAll returns the schema of the predicates as stored, across namespaces.
+func getSchemaOverNetworkAll(ctx context.Context,
+	schema *intern.SchemaRequest) ([]*api.SchemaNode, error) {
 	if err := x.HealthCheck(); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Request rejected %v", err)
@@ -48,6 +48,21 @@ type sortresult struct {
 
 // SortOverNetwork sends sort query over the network.
 func SortOverNetwork(ctx context.Context, q *intern.SortMessage) (*intern.SortResult, error) {
+	if ns := x.Namespace(ctx); ns != 0 {
+		qc := *q
+		qc.Order = make([]*intern.Order, 0, len(q.Order))
+		for _, o := range q.Order {
+			oc := *o
+			oc.Attr = namespaceAttr(ns, o.Attr)
+			qc.Order = append(qc.Order, &oc)
+		}
+		q = &qc
+	}
+	if tablet := groups().Tablet(q.Order[0].Attr); tablet != nil && len(tablet.Splits) > 0 {
+		// Each group only has the index of its own uids.
+		return &emptySortResult, x.Errorf("Sorting by split predicate %s isn't supported",
+			q.Order[0].Attr)
+	}
 	gid := groups().BelongsTo(q.Order[0].Attr)
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("worker.Sort attr: %v groupId: %v", q.Order[0].Attr, gid)
@@ -137,8 +137,21 @@ var errConflict = errors.New("List has a pending write.")
 // the instance which stores posting list corresponding to the predicate in the
 // query.
 func ProcessTaskOverNetwork(ctx context.Context, q *intern.Query) (*intern.Result, error) {
+	if ns := x.Namespace(ctx); ns != 0 {
+		qc := *q
+		qc.Attr = x.NamespaceAttr(ns, q.Attr)
+		q = &qc
+	}
+	if tablet := groups().Tablet(q.Attr); tablet != nil && len(tablet.Splits) > 0 {
+		return processSplitTask(ctx, q, tablet)
+	}
+	return processTaskInGroup(ctx, q, groups().BelongsTo(q.Attr))
+}
+
+// processTaskInGroup processes the query in the group gid, over the network unless it's this
+// instance's group.
+func processTaskInGroup(ctx context.Context, q *intern.Query, gid uint32) (*intern.Result, error) {
 	attr := q.Attr
-	gid := groups().BelongsTo(attr)
 	if gid == 0 {
 		return &intern.Result{}, errUnservedTablet
 	}
@@ -613,6 +626,7 @@ func processTask(ctx context.Context, q *intern.Query, gid uint32) (*intern.Resu
 	if !groups().ServesTablet(q.Attr) {
 		return &emptyResult, errUnservedTablet
 	}
+	tabletLoad.read(q.Attr)
 	out, err := helpProcessTask(ctx, q, gid)
 	if err != nil {
 		return &emptyResult, err
@@ -1211,6 +1225,10 @@ func (w *grpcWorker) ServeTask(ctx context.Context, q *intern.Query) (*intern.Re
 	}
 
 	gid := groups().BelongsTo(q.Attr)
+	if groups().ServesTablet(q.Attr) {
+		// Part of a split predicate, served by this group.
+		gid = groups().groupId()
+	}
 	var numUids int
 	if q.UidList != nil {
 		numUids = len(q.UidList.Uids)
@@ -1552,6 +1570,17 @@ func handleHasFunction(ctx context.Context, q *intern.Query, out *intern.Result)
 		w++
 		tlist.Uids = append(tlist.Uids, pk.Uid)
 	}
+	if q.IncludeCached {
+		// Lists committed in memory might not have made it to disk yet.
+		cached := &intern.List{}
+		for _, key := range posting.CachedKeys(prefix, q.ReadTs) {
+			cached.Uids = append(cached.Uids, x.Parse(key).Uid)
+		}
+		if len(cached.Uids) > 0 {
+			sort.Slice(cached.Uids, func(i, j int) bool { return cached.Uids[i] < cached.Uids[j] })
+			tlist = algo.MergeSorted([]*intern.List{tlist, cached})
+		}
+	}
 
 	out.UidMatrix = append(out.UidMatrix, tlist)
 	return nil
@@ -24,6 +24,7 @@ import (
 	"log"
 	"math"
 	"net"
+	"os"
 	"sync"
 	"time"
 
@@ -57,10 +58,16 @@ func Init(ps *badger.ManagedDB) {
 	pstore = ps
 	// needs to be initialized after group config
 	pendingProposals = make(chan struct{}, Config.NumPendingProposals)
-	workerServer = grpc.NewServer(
+	if len(Config.CdcPath) > 0 {
+		x.Check(os.MkdirAll(Config.CdcPath, 0700))
+		changes.log = &changeLog{dir: Config.CdcPath}
+		go changes.writeLog()
+	}
+	grpcOpts := append(conn.ServerOptions(),
 		grpc.MaxRecvMsgSize(x.GrpcMaxSize),
 		grpc.MaxSendMsgSize(x.GrpcMaxSize),
 		grpc.MaxConcurrentStreams(math.MaxInt32))
+	workerServer = grpc.NewServer(grpcOpts...)
 }
 
 // grpcWorker struct implements the gRPC server interface.
@@ -118,5 +125,8 @@ func BlockingStop() {
 	workerServer.GracefulStop() // blocking stop server
 	groups().Node.applyAllMarks(ctx)
 	posting.StopLRUEviction()
+	if err := groups().wal.CloseArchive(); err != nil {
+		x.Printf("Error while closing WAL archive: %v\n", err)
+	}
 	groups().Node.snapshot(0)
 }
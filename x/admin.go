@@ -0,0 +1,178 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// AdminConfig guards the admin HTTP endpoints of servers and zeros. A request must come from
+// one of the allowed networks, and also carry the token and a verified client certificate
+// when those are required. Requests are written to the audit log, if set.
+type AdminConfig struct {
+	AllowedNets       []*net.IPNet
+	Token             string
+	RequireClientCert bool
+	Audit             *AuditLog
+}
+
+func RegisterAdminFlags(flag *pflag.FlagSet) {
+	flag.String("admin_allowed_cidrs", "127.0.0.0/8,::1/128",
+		"Comma separated networks which can call the admin endpoints.")
+	flag.String("admin_token_file", "",
+		"File holding a token that admin requests must send in the X-Dgraph-AdminToken header.")
+	flag.Bool("admin_client_cert", false,
+		"Require admin requests to present a client certificate verified against tls_ca_certs.")
+}
+
+func LoadAdminConfig(conf *AdminConfig, v *viper.Viper) error {
+	conf.AllowedNets = conf.AllowedNets[:0]
+	for _, cidr := range strings.Split(v.GetString("admin_allowed_cidrs"), ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return Wrapf(err, "Invalid network in admin_allowed_cidrs")
+		}
+		conf.AllowedNets = append(conf.AllowedNets, ipnet)
+	}
+
+	conf.Token = ""
+	if path := v.GetString("admin_token_file"); path != "" {
+		token, err := ioutil.ReadFile(path)
+		if err != nil {
+			return Wrapf(err, "Error while reading admin token file")
+		}
+		if conf.Token = strings.TrimSpace(string(token)); conf.Token == "" {
+			return Errorf("Admin token file %s is empty", path)
+		}
+	}
+	conf.RequireClientCert = v.GetBool("admin_client_cert")
+	return nil
+}
+
+func (c *AdminConfig) allowedIP(ip net.IP) bool {
+	for _, ipnet := range c.AllowedNets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize returns who sent the request, or an error if it isn't allowed.
+func (c *AdminConfig) authorize(r *http.Request) (string, error) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return "", Errorf("Invalid remote address: %v", r.RemoteAddr)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !c.allowedIP(ip) {
+		return "", Errorf("Request from IP: %v", host)
+	}
+
+	caller := "ip=" + host
+	if c.RequireClientCert {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+			return "", Errorf("Request from IP: %v has no verified client certificate", host)
+		}
+		caller += fmt.Sprintf(" cert=%q", r.TLS.VerifiedChains[0][0].Subject.CommonName)
+	}
+	if c.Token != "" {
+		token := r.Header.Get("X-Dgraph-AdminToken")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) != 1 {
+			return "", Errorf("Request from IP: %v has an invalid admin token", host)
+		}
+		caller += " token=valid"
+	}
+	return caller, nil
+}
+
+// statusRecorder keeps the status written by an admin handler, for the audit log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// AdminHandler only runs h for requests allowed by the config, and logs each of them.
+func (c *AdminConfig) AdminHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entry := AuditEntry{
+			Time:      time.Now(),
+			Client:    r.RemoteAddr,
+			Operation: "admin",
+			Request:   map[string]string{"method": r.Method, "uri": r.URL.RequestURI()},
+		}
+		caller, err := c.authorize(r)
+		if err != nil {
+			Printf("Rejected admin request %s %s: %v\n", r.Method, r.URL.Path, err)
+			w.WriteHeader(http.StatusUnauthorized)
+			SetStatus(w, ErrorUnauthorized, err.Error())
+			if c.Audit != nil {
+				entry.SetOutcome(err)
+				c.Audit.Log(&entry)
+			}
+			return
+		}
+		Printf("Admin request %s %s from %s\n", r.Method, r.URL.RequestURI(), caller)
+		if c.Audit == nil {
+			h(w, r)
+			return
+		}
+
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+			entry.User = r.TLS.VerifiedChains[0][0].Subject.CommonName
+		}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h(rec, r)
+		if rec.status >= http.StatusBadRequest {
+			err = Errorf("Responded with status %d", rec.status)
+		}
+		entry.SetOutcome(err)
+		c.Audit.Log(&entry)
+	}
+}
+
+// GuardDebug serves h, with every path under /debug/ guarded like the admin endpoints. Handlers
+// such as those of net/http/pprof and expvar register themselves on the default mux, so they
+// can't be wrapped one by one.
+func (c *AdminConfig) GuardDebug(h http.Handler) http.Handler {
+	guarded := c.AdminHandler(h.ServeHTTP)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(path.Clean("/"+r.URL.Path)+"/", "/debug/") {
+			guarded(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
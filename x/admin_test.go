@@ -0,0 +1,101 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package x
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func adminConfig(t *testing.T, args ...string) *AdminConfig {
+	flag := pflag.NewFlagSet("admin", pflag.ContinueOnError)
+	RegisterAdminFlags(flag)
+	require.NoError(t, flag.Parse(args))
+	v := viper.New()
+	require.NoError(t, v.BindPFlags(flag))
+	var conf AdminConfig
+	require.NoError(t, LoadAdminConfig(&conf, v))
+	return &conf
+}
+
+func adminRequest(conf *AdminConfig, remote, token string) int {
+	r := httptest.NewRequest(http.MethodGet, "/admin/export", nil)
+	r.RemoteAddr = remote
+	if token != "" {
+		r.Header.Set("X-Dgraph-AdminToken", token)
+	}
+	w := httptest.NewRecorder()
+	conf.AdminHandler(func(w http.ResponseWriter, r *http.Request) {})(w, r)
+	return w.Code
+}
+
+func TestAdminAllowedNets(t *testing.T) {
+	conf := adminConfig(t)
+	require.Equal(t, http.StatusOK, adminRequest(conf, "127.0.0.1:4000", ""))
+	require.Equal(t, http.StatusOK, adminRequest(conf, "[::1]:4000", ""))
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "10.0.0.1:4000", ""))
+
+	conf = adminConfig(t, "--admin_allowed_cidrs", "10.0.0.0/8, 192.168.1.0/24")
+	require.Equal(t, http.StatusOK, adminRequest(conf, "10.1.2.3:4000", ""))
+	require.Equal(t, http.StatusOK, adminRequest(conf, "192.168.1.7:4000", ""))
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "127.0.0.1:4000", ""))
+}
+
+func TestAdminToken(t *testing.T) {
+	conf := adminConfig(t)
+	conf.Token = "secret"
+	require.Equal(t, http.StatusOK, adminRequest(conf, "127.0.0.1:4000", "secret"))
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "127.0.0.1:4000", ""))
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "127.0.0.1:4000", "secrets"))
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "10.0.0.1:4000", "secret"))
+}
+
+func TestAdminClientCert(t *testing.T) {
+	conf := adminConfig(t, "--admin_client_cert")
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "127.0.0.1:4000", ""))
+}
+
+func TestAdminInvalidCidr(t *testing.T) {
+	flag := pflag.NewFlagSet("admin", pflag.ContinueOnError)
+	RegisterAdminFlags(flag)
+	require.NoError(t, flag.Parse([]string{"--admin_allowed_cidrs", "10.0.0.1"}))
+	v := viper.New()
+	require.NoError(t, v.BindPFlags(flag))
+	require.Error(t, LoadAdminConfig(&AdminConfig{}, v))
+}
+
+func TestGuardDebug(t *testing.T) {
+	conf := adminConfig(t)
+	h := conf.GuardDebug(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	code := func(uri string) int {
+		r := httptest.NewRequest(http.MethodGet, uri, nil)
+		r.RemoteAddr = "10.0.0.1:4000"
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+	require.Equal(t, http.StatusUnauthorized, code("/debug/pprof/heap"))
+	require.Equal(t, http.StatusUnauthorized, code("/debug/pprof"))
+	require.Equal(t, http.StatusUnauthorized, code("/debug/vars"))
+	require.Equal(t, http.StatusUnauthorized, code("/query/../debug/store"))
+	require.Equal(t, http.StatusOK, code("/health"))
+	require.Equal(t, http.StatusOK, code("/debugger"))
+}
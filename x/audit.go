@@ -0,0 +1,201 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	auditFile       = "audit.log"
+	auditPrefix     = "audit-"
+	auditSuffix     = ".log"
+	auditTimeFormat = "20060102T150405.000000000"
+)
+
+// RedactedPassword replaces passwords in the requests written to the audit log.
+const RedactedPassword = "****"
+
+// AuditEntry records who made a request, what it was and how it ended.
+type AuditEntry struct {
+	Time      time.Time   `json:"time"`
+	Client    string      `json:"client,omitempty"`
+	User      string      `json:"user,omitempty"`
+	Operation string      `json:"operation"`
+	Request   interface{} `json:"request,omitempty"`
+	StartTs   uint64      `json:"start_ts,omitempty"`
+	CommitTs  uint64      `json:"commit_ts,omitempty"`
+	Outcome   string      `json:"outcome"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// SetOutcome marks the entry as failed if err is set.
+func (e *AuditEntry) SetOutcome(err error) {
+	e.Outcome = "ok"
+	if err != nil {
+		e.Outcome = "error"
+		e.Error = err.Error()
+	}
+}
+
+// AuditLog writes entries as JSON lines into audit.log in its directory. Once that file grows
+// past the max size it's renamed with the time of the rotation, and only the latest of those
+// rotated files are kept.
+type AuditLog struct {
+	// Replace the values of password predicates, and checkpwd arguments, in logged requests.
+	RedactPasswords bool
+
+	sync.Mutex
+	dir      string
+	maxSize  int64
+	maxFiles int
+	f        *os.File
+	size     int64
+}
+
+func RegisterAuditFlags(flag *pflag.FlagSet) {
+	flag.String("audit_dir", "", "Directory to write the audit log of requests to.")
+	flag.Int64("audit_max_size_mb", 100, "Size in MB after which the audit log is rotated.")
+	flag.Int("audit_max_files", 10,
+		"Number of rotated audit log files to keep. Zero keeps all of them.")
+	flag.Bool("audit_redact_passwords", true,
+		"Leave passwords out of the requests written to the audit log.")
+}
+
+// LoadAuditLog returns nil if the audit log isn't enabled.
+func LoadAuditLog(v *viper.Viper) (*AuditLog, error) {
+	dir := v.GetString("audit_dir")
+	if dir == "" {
+		return nil, nil
+	}
+	if v.GetInt64("audit_max_size_mb") <= 0 || v.GetInt("audit_max_files") < 0 {
+		return nil, Errorf("audit_max_size_mb must be positive, and audit_max_files can't be " +
+			"negative")
+	}
+	a, err := NewAuditLog(dir, v.GetInt64("audit_max_size_mb")<<20, v.GetInt("audit_max_files"))
+	if err != nil {
+		return nil, err
+	}
+	a.RedactPasswords = v.GetBool("audit_redact_passwords")
+	return a, nil
+}
+
+func NewAuditLog(dir string, maxSize int64, maxFiles int) (*AuditLog, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, Wrapf(err, "Error while creating audit log directory")
+	}
+	a := &AuditLog{dir: dir, maxSize: maxSize, maxFiles: maxFiles}
+	if err := a.open(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *AuditLog) open() error {
+	f, err := os.OpenFile(filepath.Join(a.dir, auditFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND,
+		0600)
+	if err != nil {
+		return Wrapf(err, "Error while opening audit log")
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	a.f, a.size = f, fi.Size()
+	return nil
+}
+
+// Log writes the entry. Errors are printed, and don't fail the request.
+func (a *AuditLog) Log(e *AuditEntry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		Printf("Error while encoding audit entry: %v\n", err)
+		return
+	}
+	data = append(data, '\n')
+
+	a.Lock()
+	defer a.Unlock()
+	if a.f == nil {
+		return
+	}
+	if a.size > 0 && a.size+int64(len(data)) > a.maxSize {
+		if err := a.rotate(); err != nil {
+			Printf("Error while rotating audit log: %v\n", err)
+		}
+		if a.f == nil {
+			return
+		}
+	}
+	n, err := a.f.Write(data)
+	a.size += int64(n)
+	if err != nil {
+		Printf("Error while writing audit log: %v\n", err)
+	}
+}
+
+func (a *AuditLog) rotate() error {
+	if err := a.f.Close(); err != nil {
+		return err
+	}
+	a.f = nil
+	rotated := auditPrefix + time.Now().UTC().Format(auditTimeFormat) + auditSuffix
+	err := os.Rename(filepath.Join(a.dir, auditFile), filepath.Join(a.dir, rotated))
+	// Keep logging to audit.log even if it couldn't be renamed.
+	if oerr := a.open(); oerr != nil {
+		return oerr
+	}
+	if err != nil {
+		return err
+	}
+	if a.maxFiles == 0 {
+		return nil
+	}
+	// The names sort by the time of rotation.
+	files, err := filepath.Glob(filepath.Join(a.dir, auditPrefix+"*"+auditSuffix))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+	for len(files) > a.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+func (a *AuditLog) Close() error {
+	a.Lock()
+	defer a.Unlock()
+	if a.f == nil {
+		return nil
+	}
+	err := a.f.Close()
+	a.f = nil
+	return err
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package x
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readAudit(t *testing.T, path string) []AuditEntry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var entries []AuditEntry
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var e AuditEntry
+		require.NoError(t, json.Unmarshal(s.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	require.NoError(t, s.Err())
+	return entries
+}
+
+func TestAuditLogRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	a, err := NewAuditLog(dir, 200, 2)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		e := &AuditEntry{Time: time.Now(), Operation: "query", Request: i}
+		e.SetOutcome(nil)
+		a.Log(e)
+		// Rotated files are named by the time of rotation.
+		time.Sleep(time.Millisecond)
+	}
+	require.NoError(t, a.Close())
+
+	rotated, err := filepath.Glob(filepath.Join(dir, "audit-*.log"))
+	require.NoError(t, err)
+	require.Len(t, rotated, 2)
+	entries := readAudit(t, filepath.Join(dir, "audit.log"))
+	require.NotEmpty(t, entries)
+	require.Equal(t, float64(9), entries[len(entries)-1].Request)
+	require.Equal(t, "ok", entries[0].Outcome)
+
+	// Reopening appends to the current file.
+	a, err = NewAuditLog(dir, 1<<20, 2)
+	require.NoError(t, err)
+	a.Log(&AuditEntry{Operation: "alter", Outcome: "error", Error: "failed"})
+	require.NoError(t, a.Close())
+	require.Len(t, readAudit(t, filepath.Join(dir, "audit.log")), len(entries)+1)
+}
+
+func TestAuditAdmin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := adminConfig(t)
+	conf.Audit, err = NewAuditLog(dir, 1<<20, 0)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, adminRequest(conf, "127.0.0.1:4000", ""))
+	require.Equal(t, http.StatusUnauthorized, adminRequest(conf, "10.0.0.1:4000", ""))
+	r := httptest.NewRequest(http.MethodPost, "/removeNode?id=2&group=1", nil)
+	r.RemoteAddr = "127.0.0.1:4000"
+	conf.AdminHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})(httptest.NewRecorder(), r)
+	require.NoError(t, conf.Audit.Close())
+
+	entries := readAudit(t, filepath.Join(dir, "audit.log"))
+	require.Len(t, entries, 3)
+	require.Equal(t, "127.0.0.1:4000", entries[0].Client)
+	require.Equal(t, "admin", entries[0].Operation)
+	require.Equal(t, "ok", entries[0].Outcome)
+	require.Equal(t, "10.0.0.1:4000", entries[1].Client)
+	require.Equal(t, "error", entries[1].Outcome)
+	require.Equal(t, map[string]interface{}{"method": "POST", "uri": "/removeNode?id=2&group=1"},
+		entries[2].Request)
+	require.Equal(t, "error", entries[2].Outcome)
+}
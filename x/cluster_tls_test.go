@@ -0,0 +1,161 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package x
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCert returns a certificate signed by parent, or a self-signed CA if parent is nil.
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func writeClusterTLS(t *testing.T, dir, name string, node, ca *testCert) *ClusterTLS {
+	keyDer, err := x509.MarshalECPrivateKey(node.key)
+	require.NoError(t, err)
+	files := map[string][]byte{
+		name + ".crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: node.der}),
+		name + ".key": pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+		name + ".ca":  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.der}),
+	}
+	for file, data := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, file), data, 0600))
+	}
+	v := viper.New()
+	v.Set("cluster_tls_cert", filepath.Join(dir, name+".crt"))
+	v.Set("cluster_tls_key", filepath.Join(dir, name+".key"))
+	v.Set("cluster_tls_ca_certs", filepath.Join(dir, name+".ca"))
+	c, err := LoadClusterTLS(v)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	return c
+}
+
+// handshake connects a client with the client config of one node to a server with the server
+// config of another, and returns the errors on both sides.
+func handshake(t *testing.T, client, server *ClusterTLS) (error, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	errCh := make(chan error, 1)
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer c.Close()
+		conn := tls.Server(c, server.ServerConfig())
+		if err = conn.Handshake(); err == nil {
+			// Also waits for the client to check the certificate.
+			_, err = conn.Read(make([]byte, 1))
+		}
+		errCh <- err
+	}()
+	c, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	conn := tls.Client(c, client.ClientConfig())
+	if err = conn.Handshake(); err == nil {
+		_, err = conn.Write([]byte{1})
+	}
+	c.Close()
+	return err, <-errCh
+}
+
+func TestClusterTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cluster_tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCert(t, "cluster ca", nil)
+	rogueCa := newTestCert(t, "rogue ca", nil)
+	alpha := writeClusterTLS(t, dir, "alpha", newTestCert(t, "alpha", ca), ca)
+	zero := writeClusterTLS(t, dir, "zero", newTestCert(t, "zero", ca), ca)
+	rogue := writeClusterTLS(t, dir, "rogue", newTestCert(t, "rogue", rogueCa), ca)
+
+	cerr, serr := handshake(t, alpha, zero)
+	require.NoError(t, cerr)
+	require.NoError(t, serr)
+
+	// The server rejects a node whose certificate isn't signed by the cluster CA.
+	_, serr = handshake(t, rogue, zero)
+	require.Error(t, serr)
+	// And so does the client.
+	cerr, _ = handshake(t, alpha, rogue)
+	require.Error(t, cerr)
+
+	// After a reload, the rogue node is signed by the cluster CA.
+	writeClusterTLS(t, dir, "rogue", newTestCert(t, "rogue", ca), ca)
+	require.NoError(t, rogue.Reload())
+	cerr, serr = handshake(t, rogue, zero)
+	require.NoError(t, cerr)
+	require.NoError(t, serr)
+}
+
+func TestClusterTLSOptions(t *testing.T) {
+	c, err := LoadClusterTLS(viper.New())
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	v := viper.New()
+	v.Set("cluster_tls_cert", "node.crt")
+	_, err = LoadClusterTLS(v)
+	require.Error(t, err)
+}
@@ -0,0 +1,334 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	encVersion    = byte(1)
+	keyIdLen      = 4
+	nonceLen      = 12
+	encHeaderLen  = 1 + keyIdLen + nonceLen
+	encKeyLen     = 32
+	encFrameLen   = 64 << 10
+	encMarkerFile = "ENCRYPTION"
+	encMarkerText = "dgraph encryption check"
+)
+
+// EncryptedFileSuffix is appended to the names of encrypted export and backup files.
+const EncryptedFileSuffix = ".enc"
+
+var encStreamMagic = []byte("DGRAPHENC1")
+
+// KeyRing holds the AES-256 keys used to encrypt data at rest. Data is encrypted with the
+// active key, and decrypted with whichever key it was encrypted with, so keys can be rotated by
+// adding a new one and keeping the old ones until all the data is encrypted again.
+type KeyRing struct {
+	active uint32
+	aeads  map[uint32]cipher.AEAD
+}
+
+// LoadKeyRing reads a key file, holding one hex encoded 32 byte key per line. The last key is
+// the active one. Empty lines and lines starting with # are ignored.
+func LoadKeyRing(path string) (*KeyRing, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, Wrapf(err, "Error while reading encryption key file")
+	}
+	k := &KeyRing{aeads: make(map[uint32]cipher.AEAD)}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := hex.DecodeString(line)
+		if err != nil || len(key) != encKeyLen {
+			return nil, Errorf("Line %d of encryption key file %s isn't a hex encoded %d byte key",
+				i+1, path, encKeyLen)
+		}
+		if err := k.add(key); err != nil {
+			return nil, err
+		}
+	}
+	if len(k.aeads) == 0 {
+		return nil, Errorf("Encryption key file %s holds no key", path)
+	}
+	return k, nil
+}
+
+func (k *KeyRing) add(key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(key)
+	k.active = binary.BigEndian.Uint32(sum[:keyIdLen])
+	k.aeads[k.active] = aead
+	return nil
+}
+
+// seal returns the version, the id of the active key, a random nonce and the ciphertext.
+func (k *KeyRing) seal(plain, aad []byte) []byte {
+	out := make([]byte, encHeaderLen, encHeaderLen+len(plain)+16)
+	out[0] = encVersion
+	binary.BigEndian.PutUint32(out[1:], k.active)
+	_, err := io.ReadFull(rand.Reader, out[1+keyIdLen:encHeaderLen])
+	Check(err)
+	return k.aeads[k.active].Seal(out, out[1+keyIdLen:encHeaderLen], plain, aad)
+}
+
+func (k *KeyRing) open(data, aad []byte) ([]byte, error) {
+	if len(data) < encHeaderLen || data[0] != encVersion {
+		return nil, Errorf("Data isn't encrypted, or in an unknown format")
+	}
+	aead, ok := k.aeads[binary.BigEndian.Uint32(data[1:])]
+	if !ok {
+		return nil, Errorf("Data is encrypted with a key missing from the encryption key file")
+	}
+	plain, err := aead.Open(nil, data[1+keyIdLen:encHeaderLen], data[encHeaderLen:], aad)
+	if err != nil {
+		return nil, Errorf("Data can't be decrypted. It's corrupt, or the key is wrong")
+	}
+	return plain, nil
+}
+
+// Encrypt returns the value encrypted with the active key. Empty values stay empty.
+func (k *KeyRing) Encrypt(plain []byte) []byte {
+	if len(plain) == 0 {
+		return plain
+	}
+	return k.seal(plain, nil)
+}
+
+// Decrypt returns the value encrypted by Encrypt.
+func (k *KeyRing) Decrypt(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	return k.open(data, nil)
+}
+
+// Encrypts the values of the posting store and the WAL, when set.
+var valueKeys *KeyRing
+
+// SetValueEncryption makes EncryptValue and DecryptValue use the keys. Nil disables encryption.
+func SetValueEncryption(k *KeyRing) {
+	valueKeys = k
+}
+
+// ValueEncryption returns the keys set by SetValueEncryption.
+func ValueEncryption() *KeyRing {
+	return valueKeys
+}
+
+// EncryptValue encrypts a value before it's written to Badger, if encryption is enabled.
+func EncryptValue(plain []byte) []byte {
+	if valueKeys == nil {
+		return plain
+	}
+	return valueKeys.Encrypt(plain)
+}
+
+// DecryptValue decrypts a value read from Badger, if encryption is enabled. Without keys, data
+// is returned as is, so callers keeping the value past the transaction have to copy it.
+func DecryptValue(data []byte) ([]byte, error) {
+	if valueKeys == nil {
+		return data, nil
+	}
+	return valueKeys.Decrypt(data)
+}
+
+// CheckEncryptedDir makes sure that the Badger directory is encrypted with the keys, or isn't
+// encrypted if k is nil. A directory without data is marked as encrypted. Directories holding
+// data of the other kind have to be converted with dgraph encrypt first.
+func CheckEncryptedDir(dir string, k *KeyRing) error {
+	marker := filepath.Join(dir, encMarkerFile)
+	data, err := ioutil.ReadFile(marker)
+	switch {
+	case err == nil && k == nil:
+		return Errorf("Directory %s is encrypted. Set --encryption_key_file to read it", dir)
+	case err == nil:
+		plain, err := k.open(data, nil)
+		if err != nil || string(plain) != encMarkerText {
+			return Errorf("Directory %s is encrypted with a key missing from the encryption "+
+				"key file", dir)
+		}
+		return nil
+	case !os.IsNotExist(err):
+		return err
+	case k == nil:
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "MANIFEST")); err == nil {
+		return Errorf("Directory %s holds unencrypted data. Encrypt it with dgraph encrypt "+
+			"before setting --encryption_key_file", dir)
+	}
+	return WriteEncryptedMarker(dir, k)
+}
+
+// WriteEncryptedMarker marks the directory as encrypted with the active key.
+func WriteEncryptedMarker(dir string, k *KeyRing) error {
+	return ioutil.WriteFile(filepath.Join(dir, encMarkerFile),
+		k.seal([]byte(encMarkerText), nil), 0600)
+}
+
+// IsEncryptedDir returns whether the directory was marked as encrypted.
+func IsEncryptedDir(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, encMarkerFile))
+	return err == nil
+}
+
+// Streams are split in frames, each sealed with its index, and whether it's the last one, so
+// frames can't be reordered or dropped.
+func frameAad(idx uint64, last bool) []byte {
+	var aad [9]byte
+	binary.BigEndian.PutUint64(aad[:8], idx)
+	if last {
+		aad[8] = 1
+	}
+	return aad[:]
+}
+
+type encWriter struct {
+	k   *KeyRing
+	w   io.Writer
+	buf []byte
+	idx uint64
+}
+
+// NewWriter returns a writer encrypting everything written to it into w. It must be closed to
+// write the last frame. Closing doesn't close w.
+func (k *KeyRing) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	if _, err := w.Write(encStreamMagic); err != nil {
+		return nil, err
+	}
+	return &encWriter{k: k, w: w, buf: make([]byte, 0, encFrameLen)}, nil
+}
+
+func (e *encWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		if len(e.buf) == encFrameLen {
+			if err := e.flush(false); err != nil {
+				return 0, err
+			}
+		}
+		c := copy(e.buf[len(e.buf):encFrameLen], p)
+		e.buf = e.buf[:len(e.buf)+c]
+		p = p[c:]
+	}
+	return n, nil
+}
+
+func (e *encWriter) flush(last bool) error {
+	frame := e.k.seal(e.buf, frameAad(e.idx, last))
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(frame)))
+	if _, err := e.w.Write(l[:]); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(frame); err != nil {
+		return err
+	}
+	e.idx++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+func (e *encWriter) Close() error {
+	return e.flush(true)
+}
+
+type encReader struct {
+	k    *KeyRing
+	r    *bufio.Reader
+	buf  []byte
+	idx  uint64
+	done bool
+}
+
+// NewReader returns a reader decrypting the stream written by NewWriter. Reading fails if the
+// stream was modified or truncated.
+func (k *KeyRing) NewReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, encFrameLen+encHeaderLen+64)
+	magic := make([]byte, len(encStreamMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || !bytes.Equal(magic, encStreamMagic) {
+		return nil, Errorf("Stream isn't encrypted, or in an unknown format")
+	}
+	return &encReader{k: k, r: br}, nil
+}
+
+func (e *encReader) Read(p []byte) (int, error) {
+	for len(e.buf) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		if err := e.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, e.buf)
+	e.buf = e.buf[n:]
+	return n, nil
+}
+
+func (e *encReader) next() error {
+	var l [4]byte
+	if _, err := io.ReadFull(e.r, l[:]); err != nil {
+		return Errorf("Encrypted stream is truncated")
+	}
+	n := binary.BigEndian.Uint32(l[:])
+	if n > encFrameLen+encHeaderLen+64 {
+		return Errorf("Encrypted stream is corrupt")
+	}
+	frame := make([]byte, n)
+	if _, err := io.ReadFull(e.r, frame); err != nil {
+		return Errorf("Encrypted stream is truncated")
+	}
+	plain, err := e.k.open(frame, frameAad(e.idx, false))
+	if err != nil {
+		if plain, err = e.k.open(frame, frameAad(e.idx, true)); err != nil {
+			return err
+		}
+		e.done = true
+		if _, err := e.r.Peek(1); err != io.EOF {
+			return Errorf("Encrypted stream has data after its last frame")
+		}
+	}
+	e.idx++
+	e.buf = plain
+	return nil
+}
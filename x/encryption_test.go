@@ -0,0 +1,166 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package x
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKey1 = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	testKey2 = "f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff000102030405060708090a0b0c0d0e0f"
+)
+
+func writeKeyRing(t *testing.T, dir string, keys ...string) *KeyRing {
+	path := filepath.Join(dir, "keys")
+	data := "# encryption keys\n" + strings.Join(keys, "\n") + "\n"
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+	k, err := LoadKeyRing(path)
+	require.NoError(t, err)
+	return k
+}
+
+func TestEncryptValue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	old := writeKeyRing(t, dir, testKey1)
+	data := old.Encrypt([]byte("value"))
+	require.NotContains(t, string(data), "value")
+	plain, err := old.Decrypt(data)
+	require.NoError(t, err)
+	require.Equal(t, "value", string(plain))
+	require.Empty(t, old.Encrypt(nil))
+
+	// After rotation, values encrypted with either key can be read.
+	rotated := writeKeyRing(t, dir, testKey1, testKey2)
+	plain, err = rotated.Decrypt(data)
+	require.NoError(t, err)
+	require.Equal(t, "value", string(plain))
+	plain, err = old.Decrypt(rotated.Encrypt([]byte("new")))
+	require.Error(t, err)
+
+	// Neither a wrong key nor tampering go unnoticed.
+	other := writeKeyRing(t, dir, testKey2)
+	_, err = other.Decrypt(data)
+	require.Error(t, err)
+	data[len(data)-1] ^= 1
+	_, err = old.Decrypt(data)
+	require.Error(t, err)
+}
+
+func TestDecryptValueUnencrypted(t *testing.T) {
+	defer SetValueEncryption(ValueEncryption())
+	SetValueEncryption(nil)
+	data := []byte("value")
+	plain, err := DecryptValue(data)
+	require.NoError(t, err)
+	require.Equal(t, &data[0], &plain[0])
+}
+
+func TestLoadKeyRing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "keys")
+	for _, data := range []string{"", "# no key\n", "abcd\n", "not hex\n"} {
+		require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+		_, err := LoadKeyRing(path)
+		require.Error(t, err, "%q", data)
+	}
+	_, err = LoadKeyRing(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
+
+func TestCheckEncryptedDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	k1 := writeKeyRing(t, dir, testKey1)
+	k2 := writeKeyRing(t, dir, testKey2)
+	rotated := writeKeyRing(t, dir, testKey1, testKey2)
+
+	empty := filepath.Join(dir, "empty")
+	require.NoError(t, os.Mkdir(empty, 0700))
+	require.NoError(t, CheckEncryptedDir(empty, k1))
+	require.True(t, IsEncryptedDir(empty))
+	require.NoError(t, CheckEncryptedDir(empty, k1))
+	require.NoError(t, CheckEncryptedDir(empty, rotated))
+	require.Error(t, CheckEncryptedDir(empty, k2))
+	require.Error(t, CheckEncryptedDir(empty, nil))
+
+	plain := filepath.Join(dir, "plain")
+	require.NoError(t, os.Mkdir(plain, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(plain, "MANIFEST"), nil, 0600))
+	require.NoError(t, CheckEncryptedDir(plain, nil))
+	require.Error(t, CheckEncryptedDir(plain, k1))
+	require.False(t, IsEncryptedDir(plain))
+}
+
+func TestEncryptStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	k := writeKeyRing(t, dir, testKey1)
+
+	encrypt := func(data []byte) []byte {
+		var buf bytes.Buffer
+		w, err := k.NewWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	decrypt := func(data []byte) ([]byte, error) {
+		r, err := k.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(r)
+	}
+
+	for _, size := range []int{0, 10, encFrameLen, 3*encFrameLen + 7} {
+		data := bytes.Repeat([]byte("x"), size)
+		enc := encrypt(data)
+		out, err := decrypt(enc)
+		require.NoError(t, err)
+		require.Equal(t, data, out)
+	}
+
+	enc := encrypt(bytes.Repeat([]byte("x"), 2*encFrameLen+1))
+	// Dropping the last frame.
+	_, err = decrypt(enc[:len(enc)-(4+encHeaderLen+1+16)])
+	require.Error(t, err)
+	// Cutting a frame short.
+	_, err = decrypt(enc[:len(enc)-1])
+	require.Error(t, err)
+	// Appending data.
+	_, err = decrypt(append(enc, 0))
+	require.Error(t, err)
+	// Plain data.
+	_, err = decrypt([]byte("plain"))
+	require.Error(t, err)
+}
@@ -20,6 +20,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -294,3 +296,30 @@ func Parse(key []byte) *ParsedKey {
 	}
 	return p
 }
+
+// NamespaceSeparator separates the namespace from the predicate in the attribute of a predicate
+// outside the default namespace. Predicate names can't contain it.
+const NamespaceSeparator = "|"
+
+// NamespaceAttr returns the attribute under which the predicate of namespace ns is stored,
+// which also prefixes its keys. The default namespace, zero, leaves the predicate as is.
+// Attributes which already have a namespace are returned unchanged.
+func NamespaceAttr(ns uint64, attr string) string {
+	if ns == 0 || strings.Contains(attr, NamespaceSeparator) {
+		return attr
+	}
+	return strconv.FormatUint(ns, 10) + NamespaceSeparator + attr
+}
+
+// ParseNamespaceAttr splits an attribute into its namespace and predicate.
+func ParseNamespaceAttr(attr string) (uint64, string) {
+	i := strings.Index(attr, NamespaceSeparator)
+	if i < 0 {
+		return 0, attr
+	}
+	ns, err := strconv.ParseUint(attr[:i], 10, 64)
+	if err != nil {
+		return 0, attr
+	}
+	return ns, attr[i+len(NamespaceSeparator):]
+}
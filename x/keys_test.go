@@ -91,3 +91,21 @@ func TestSchemaKey(t *testing.T) {
 		require.Equal(t, sattr, pk.Attr)
 	}
 }
+
+func TestNamespaceAttr(t *testing.T) {
+	require.Equal(t, "name", NamespaceAttr(0, "name"))
+	attr := NamespaceAttr(7, "name")
+	require.Equal(t, "7|name", attr)
+	// Already namespaced attributes are left alone.
+	require.Equal(t, attr, NamespaceAttr(7, attr))
+
+	ns, pred := ParseNamespaceAttr(attr)
+	require.Equal(t, uint64(7), ns)
+	require.Equal(t, "name", pred)
+	ns, pred = ParseNamespaceAttr("name")
+	require.Equal(t, uint64(0), ns)
+	require.Equal(t, "name", pred)
+
+	pk := Parse(DataKey(attr, 1))
+	require.Equal(t, attr, pk.Attr)
+}
@@ -35,6 +35,8 @@ var (
 	CacheHit      *expvar.Int
 	CacheMiss     *expvar.Int
 	CacheRace     *expvar.Int
+	// Events applied by a standby from its primary.
+	ReplicatedEvents *expvar.Int
 
 	// value at particular point of time
 	PendingQueries   *expvar.Int
@@ -52,6 +54,7 @@ var (
 	ServerHealth     *expvar.Int
 	MaxPlSize        *expvar.Int
 	MaxPlLength      *expvar.Int
+	ReplicationLag   *expvar.Int
 
 	PredicateStats *expvar.Map
 	Conf           *expvar.Map
@@ -87,6 +90,8 @@ func init() {
 	CacheRace = expvar.NewInt("dgraph_cache_race_total")
 	MaxPlSize = expvar.NewInt("dgraph_max_list_bytes")
 	MaxPlLength = expvar.NewInt("dgraph_max_list_length")
+	ReplicationLag = expvar.NewInt("dgraph_replication_lag_ms")
+	ReplicatedEvents = expvar.NewInt("dgraph_replicated_events_total")
 
 	go func() {
 		ticker := time.NewTicker(5 * time.Second)
@@ -169,6 +174,16 @@ func init() {
 			"dgraph_num_queries_total",
 			nil, nil,
 		),
+		"dgraph_replication_lag_ms": prometheus.NewDesc(
+			"dgraph_replication_lag_ms",
+			"dgraph_replication_lag_ms",
+			nil, nil,
+		),
+		"dgraph_replicated_events_total": prometheus.NewDesc(
+			"dgraph_replicated_events_total",
+			"dgraph_replicated_events_total",
+			nil, nil,
+		),
 		"dgraph_server_health_status": prometheus.NewDesc(
 			"dgraph_server_health_status",
 			"dgraph_server_health_status",
@@ -58,6 +58,10 @@ const (
 	// The attr used to store list of predicates for a node.
 	PredicateListAttr = "_predicate_"
 
+	// Prefix of conflict keys written by increments. Zero records them as commits of the key,
+	// but doesn't check them for conflicts, so increments don't abort each other.
+	IncrementKeyPrefix = "+"
+
 	PortInternal = 7080
 	PortHTTP     = 8080
 	PortGrpc     = 9080
@@ -104,8 +108,9 @@ func AddCorsHeaders(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
 	w.Header().Set("Access-Control-Allow-Headers",
 		"Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, X-Auth-Token, "+
-			"Cache-Control, X-Requested-With, X-Dgraph-CommitNow, X-Dgraph-LinRead, X-Dgraph-Vars"+
-			"X-Dgraph-IgnoreIndexConflict")
+			"Cache-Control, X-Requested-With, X-Dgraph-CommitNow, X-Dgraph-LinRead, X-Dgraph-Vars, "+
+			"X-Dgraph-IgnoreIndexConflict, X-Dgraph-AsOf, X-Dgraph-ReadOnly, "+
+			"X-Dgraph-BestEffort, X-Dgraph-AccessToken")
 	w.Header().Set("Access-Control-Allow-Credentials", "true")
 	w.Header().Set("Connection", "close")
 }
@@ -266,6 +271,35 @@ func NewTrace(title string, ctx context.Context) (trace.Trace, context.Context)
 	return tr, ctx
 }
 
+type namespaceKey struct{}
+
+// WithNamespace returns a context for requests to the given namespace. The worker functions
+// taking a context store and read the predicates of the namespace.
+func WithNamespace(ctx context.Context, ns uint64) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, ns)
+}
+
+// Namespace returns the namespace of the request, zero being the default one.
+func Namespace(ctx context.Context) uint64 {
+	ns, _ := ctx.Value(namespaceKey{}).(uint64)
+	return ns
+}
+
+type predicateFilterKey struct{}
+
+// WithPredicateFilter returns a context in which the predicates the server finds on behalf of
+// the request, like those of expand(), S * * deletions and subscriptions, are left out unless
+// allowed returns true for them.
+func WithPredicateFilter(ctx context.Context, allowed func(pred string) bool) context.Context {
+	return context.WithValue(ctx, predicateFilterKey{}, allowed)
+}
+
+// PredicateAllowed returns whether the predicate can be returned to the request, or changed by it.
+func PredicateAllowed(ctx context.Context, pred string) bool {
+	allowed, ok := ctx.Value(predicateFilterKey{}).(func(string) bool)
+	return !ok || allowed(pred)
+}
+
 type BytesBuffer struct {
 	data [][]byte
 	off  int